@@ -9,6 +9,8 @@ import (
 	"github.com/iotaledger/hive.go/app/components/shutdown"
 	dashboardmetrics "github.com/iotaledger/iota-core/components/dashboard_metrics"
 	"github.com/iotaledger/iota-core/components/debugapi"
+	"github.com/iotaledger/iota-core/components/grpcapi"
+	"github.com/iotaledger/iota-core/components/indexer"
 	"github.com/iotaledger/iota-core/components/inx"
 	"github.com/iotaledger/iota-core/components/metricstracker"
 	"github.com/iotaledger/iota-core/components/p2p"
@@ -17,6 +19,7 @@ import (
 	"github.com/iotaledger/iota-core/components/restapi"
 	coreapi "github.com/iotaledger/iota-core/components/restapi/core"
 	"github.com/iotaledger/iota-core/components/restapi/management"
+	"github.com/iotaledger/iota-core/components/webhooks"
 	"github.com/iotaledger/iota-core/pkg/toolset"
 )
 
@@ -51,6 +54,9 @@ Command line flags:
 			dashboardmetrics.Component,
 			prometheus.Component,
 			inx.Component,
+			indexer.Component,
+			webhooks.Component,
+			grpcapi.Component,
 		),
 	)
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/iotaledger/hive.go/runtime/event"
 	"github.com/iotaledger/hive.go/runtime/workerpool"
 	"github.com/iotaledger/inx-app/pkg/httpserver"
+	"github.com/iotaledger/iota-core/pkg/network/protocols/core"
 	"github.com/iotaledger/iota-core/pkg/protocol"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/notarization"
@@ -34,6 +35,8 @@ const (
 	RouteCommitmentBySlotBlockIDs = "/commitments/by-slot/:" + api.ParameterSlot + "/blocks"
 
 	RouteCommitmentBySlotTransactionIDs = "/commitments/by-slot/:" + api.ParameterSlot + "/transactions"
+
+	RouteNetworkTraces = "/network/traces"
 )
 
 const (
@@ -65,6 +68,7 @@ type dependencies struct {
 	dig.In
 
 	Protocol         *protocol.Protocol
+	NetworkTracer    *core.Tracer
 	AppInfo          *app.Info
 	RestRouteManager *restapipkg.RestRouteManager
 }
@@ -230,5 +234,14 @@ func configure() error {
 		return httpserver.JSONResponse(c, http.StatusOK, resp)
 	})
 
+	routeGroup.GET(RouteNetworkTraces, func(c echo.Context) error {
+		resp, err := networkTraces(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	})
+
 	return nil
 }
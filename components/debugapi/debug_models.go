@@ -5,6 +5,7 @@ import (
 
 	"github.com/iotaledger/hive.go/lo"
 	"github.com/iotaledger/iota-core/pkg/core/account"
+	"github.com/iotaledger/iota-core/pkg/network/protocols/core"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
 	iotago "github.com/iotaledger/iota.go/v4"
 )
@@ -49,6 +50,11 @@ type (
 		ActiveSeats    []uint32     `serix:"lenPrefix=uint8"`
 	}
 
+	NetworkTracesResponse struct {
+		// The traces of the packets that were received from and sent to neighbors, from the oldest to the newest one.
+		Traces []*core.PacketTrace `json:"traces"`
+	}
+
 	BlockChangesResponse struct {
 		// The index of the requested commitment.
 		Index iotago.SlotIndex `json:"index"`
@@ -0,0 +1,45 @@
+package debugapi
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+	"github.com/iotaledger/iota-core/pkg/network/protocols/core"
+)
+
+const (
+	// ParameterPeerID is used to filter the network traces by peer ID.
+	ParameterPeerID = "peerId"
+	// ParameterID is used to filter the network traces by block or commitment ID.
+	ParameterID = "id"
+	// ParameterType is used to filter the network traces by packet type.
+	ParameterType = "type"
+	// ParameterLimit is used to limit the number of returned network traces.
+	ParameterLimit = "limit"
+)
+
+func networkTraces(c echo.Context) (*NetworkTracesResponse, error) {
+	if deps.NetworkTracer == nil {
+		return nil, ierrors.WithMessage(echo.ErrServiceUnavailable, "network tracing is disabled")
+	}
+
+	filter := &core.TraceFilter{
+		PeerID: c.QueryParam(ParameterPeerID),
+		ID:     c.QueryParam(ParameterID),
+		Type:   c.QueryParam(ParameterType),
+	}
+
+	if c.QueryParam(ParameterLimit) != "" {
+		limit, err := httpserver.ParseUint32QueryParam(c, ParameterLimit)
+		if err != nil {
+			return nil, err
+		}
+
+		filter.Limit = int(limit)
+	}
+
+	return &NetworkTracesResponse{
+		Traces: deps.NetworkTracer.Traces(filter),
+	}, nil
+}
@@ -0,0 +1,102 @@
+package grpcapi
+
+import (
+	"context"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/host"
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/hive.go/app"
+	"github.com/iotaledger/iota-core/components/protocol"
+	"github.com/iotaledger/iota-core/pkg/daemon"
+	"github.com/iotaledger/iota-core/pkg/grpcapi"
+	"github.com/iotaledger/iota-core/pkg/grpcapi/coreapi"
+	"github.com/iotaledger/iota-core/pkg/jwt"
+	"github.com/iotaledger/iota-core/pkg/requesthandler"
+	"github.com/iotaledger/iota-core/pkg/restapi"
+)
+
+func init() {
+	Component = &app.Component{
+		Name:     "GRPCAPI",
+		DepsFunc: func(cDeps dependencies) { deps = cDeps },
+		Params:   params,
+		Provide:  provide,
+		Run:      run,
+		IsEnabled: func(_ *dig.Container) bool {
+			return ParamsGRPCAPI.Enabled
+		},
+	}
+}
+
+// methodScopes defines which scopes a scoped JWT needs to call the methods of the gRPC API.
+// The scopes are the same as the ones of the corresponding REST API routes.
+var methodScopes = []*restapi.ScopeRule{
+	{Route: coreapi.CoreAPI_SubmitBlock_FullMethodName, Scopes: []string{jwt.ScopeBlocksSubmit}},
+	{Route: "/coreapi.CoreAPI/*", Scopes: []string{jwt.ScopeCoreRead}},
+}
+
+var (
+	Component *app.Component
+	deps      dependencies
+)
+
+type dependencies struct {
+	dig.In
+	AppInfo        *app.Info
+	RequestHandler *requesthandler.RequestHandler
+	BaseToken      *protocol.BaseToken
+	GRPCAPIServer  *Server
+}
+
+func provide(c *dig.Container) error {
+	type serverDeps struct {
+		dig.In
+		Host           host.Host
+		NodePrivateKey crypto.PrivKey `name:"nodePrivateKey"`
+	}
+
+	if err := c.Provide(func(deps serverDeps) *Server {
+		salt := ParamsGRPCAPI.JWTAuth.Salt
+		if len(salt) == 0 {
+			Component.LogPanicf("parameter %s should not be empty", Component.App().Config().GetParameterPath(&(ParamsGRPCAPI.JWTAuth.Salt)))
+		}
+
+		// API tokens do not expire.
+		jwtAuth, err := jwt.NewAuth(salt,
+			0,
+			deps.Host.ID().String(),
+			deps.NodePrivateKey,
+		)
+		if err != nil {
+			Component.LogPanicf("JWT auth initialization failed: %s", err)
+		}
+
+		authenticator, err := grpcapi.NewAuthenticator(jwtAuth, ParamsGRPCAPI.PublicMethods, ParamsGRPCAPI.ProtectedMethods, methodScopes...)
+		if err != nil {
+			Component.LogPanicf("invalid gRPC API methods: %s", err)
+		}
+
+		return newServer(authenticator)
+	}); err != nil {
+		Component.LogPanic(err.Error())
+	}
+
+	return nil
+}
+
+func run() error {
+	if err := Component.Daemon().BackgroundWorker("gRPC API", func(ctx context.Context) {
+		Component.LogInfo("Starting gRPC API ... done")
+		deps.GRPCAPIServer.Start()
+		<-ctx.Done()
+		Component.LogInfo("Stopping gRPC API ...")
+		deps.GRPCAPIServer.Stop()
+		Component.LogInfo("Stopping gRPC API ... done")
+	}, daemon.PriorityGRPCAPI); err != nil {
+		Component.LogPanicf("failed to start worker: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package grpcapi
+
+import (
+	"github.com/iotaledger/hive.go/app"
+)
+
+// ParametersGRPCAPI contains the definition of the parameters used by the gRPC API.
+type ParametersGRPCAPI struct {
+	// Enabled defines whether the gRPC API is enabled.
+	Enabled bool `default:"false" usage:"whether the gRPC API is enabled"`
+	// the bind address on which the gRPC API listens on
+	BindAddress string `default:"0.0.0.0:14266" usage:"the bind address on which the gRPC API listens on"`
+	// the full gRPC method names which can be called without authorization. Wildcards using * are allowed
+	PublicMethods []string `usage:"the full gRPC method names which can be called without authorization. Wildcards using * are allowed"`
+	// the full gRPC method names which need to be called with authorization. Wildcards using * are allowed
+	ProtectedMethods []string `usage:"the full gRPC method names which need to be called with authorization. Wildcards using * are allowed"`
+	// MaxPageSize defines the maximum number of results per page.
+	MaxPageSize uint32 `default:"100" usage:"the maximum number of results per page"`
+
+	JWTAuth struct {
+		// salt used inside the JWT tokens for the gRPC API. Change this to a different value to invalidate JWT tokens not matching this new value
+		Salt string `default:"IOTA" usage:"salt used inside the JWT tokens for the gRPC API. Change this to a different value to invalidate JWT tokens not matching this new value"`
+	} `name:"jwtAuth"`
+}
+
+var ParamsGRPCAPI = &ParametersGRPCAPI{
+	PublicMethods: []string{
+		"/coreapi.CoreAPI/Read*",
+	},
+	ProtectedMethods: []string{
+		"/coreapi.CoreAPI/*",
+	},
+}
+
+var params = &app.ComponentParams{
+	Params: map[string]any{
+		"grpcAPI": ParamsGRPCAPI,
+	},
+	Masked: []string{"grpcAPI.jwtAuth.salt"},
+}
@@ -0,0 +1,98 @@
+package grpcapi
+
+import (
+	"net"
+	"time"
+
+	grpcprometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/iota-core/pkg/grpcapi"
+	"github.com/iotaledger/iota-core/pkg/grpcapi/coreapi"
+)
+
+func newServer(authenticator *grpcapi.Authenticator) *Server {
+	grpcServer := grpc.NewServer(
+		grpc.ChainStreamInterceptor(grpcprometheus.StreamServerInterceptor, authenticator.StreamServerInterceptor()),
+		grpc.ChainUnaryInterceptor(grpcprometheus.UnaryServerInterceptor, authenticator.UnaryServerInterceptor()),
+		grpc.KeepaliveParams(keepalive.ServerParameters{
+			Time:    20 * time.Second,
+			Timeout: 5 * time.Second,
+		}),
+	)
+
+	s := &Server{grpcServer: grpcServer}
+	coreapi.RegisterCoreAPIServer(grpcServer, s)
+
+	return s
+}
+
+// Server implements the public gRPC API, which only exposes the read-only functionality of the node and the block submission.
+type Server struct {
+	coreapi.UnimplementedCoreAPIServer
+	grpcServer *grpc.Server
+}
+
+func (s *Server) Start() {
+	go func() {
+		listener, err := net.Listen("tcp", ParamsGRPCAPI.BindAddress)
+		if err != nil {
+			Component.LogFatalf("failed to listen: %v", err)
+		}
+		defer listener.Close()
+
+		Component.LogInfof("You can now access the gRPC API using: %s", ParamsGRPCAPI.BindAddress)
+		if err := s.grpcServer.Serve(listener); err != nil {
+			Component.LogFatalf("failed to serve: %v", err)
+		}
+	}()
+}
+
+func (s *Server) Stop() {
+	s.grpcServer.Stop()
+}
+
+// rawResponse encodes the response object the same way the binary responses of the REST API are encoded.
+func rawResponse(obj any, err error) (*coreapi.RawResponse, error) {
+	if err != nil {
+		return nil, grpcapi.StatusFromError(err)
+	}
+
+	data, err := deps.RequestHandler.CommittedAPI().Encode(obj)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode response: %s", err)
+	}
+
+	return &coreapi.RawResponse{
+		Data: data,
+	}, nil
+}
+
+// parseID parses an identifier that has to consist of exactly the given bytes.
+func parseID[T any](name string, data []byte, fromBytes func([]byte) (T, int, error)) (T, error) {
+	id, consumedBytes, err := fromBytes(data)
+	if err == nil && consumedBytes != len(data) {
+		err = ierrors.Errorf("expected %d bytes, got %d bytes", consumedBytes, len(data))
+	}
+
+	if err != nil {
+		var empty T
+
+		return empty, status.Errorf(codes.InvalidArgument, "invalid %s: %s", name, err)
+	}
+
+	return id, nil
+}
+
+// checkNodeSynced returns an error if the node is not synced, the same methods need a synced node as the routes of the REST API.
+func checkNodeSynced() error {
+	if !deps.RequestHandler.IsNodeSynced() {
+		return status.Error(codes.Unavailable, "node is not synced")
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package grpcapi
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/iota-core/pkg/grpcapi/coreapi"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func parseAccountAddress(accountID []byte) (*iotago.AccountAddress, error) {
+	parsedAccountID, err := parseID("account ID", accountID, iotago.AccountIDFromBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	accountAddress := iotago.AccountAddress(parsedAccountID)
+
+	return &accountAddress, nil
+}
+
+// parseEpochCursor parses a cursor of the validators in the form "epoch,index".
+func parseEpochCursor(cursor string) (iotago.EpochIndex, uint32, error) {
+	epochPart, indexPart, found := strings.Cut(cursor, ",")
+	if !found {
+		return 0, 0, ierrors.Errorf("cursor %s is not in the form epoch,index", cursor)
+	}
+
+	epoch, err := strconv.ParseUint(epochPart, 10, 32)
+	if err != nil {
+		return 0, 0, ierrors.Wrapf(err, "invalid epoch %s", epochPart)
+	}
+
+	index, err := strconv.ParseUint(indexPart, 10, 32)
+	if err != nil {
+		return 0, 0, ierrors.Wrapf(err, "invalid index %s", indexPart)
+	}
+
+	return iotago.EpochIndex(epoch), uint32(index), nil
+}
+
+func (s *Server) ReadCongestion(_ context.Context, req *coreapi.CongestionRequest) (*coreapi.RawResponse, error) {
+	if err := checkNodeSynced(); err != nil {
+		return nil, err
+	}
+
+	accountAddress, err := parseAccountAddress(req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	// the latest commitment is used if no commitment ID is given
+	commitmentID := iotago.EmptyCommitmentID
+	if len(req.GetCommitmentId()) > 0 {
+		if commitmentID, err = parseID("commitment ID", req.GetCommitmentId(), iotago.CommitmentIDFromBytes); err != nil {
+			return nil, err
+		}
+	}
+
+	return rawResponse(deps.RequestHandler.CongestionByAccountAddress(accountAddress, iotago.WorkScore(req.GetWorkScore()), commitmentID))
+}
+
+func (s *Server) ReadValidators(_ context.Context, req *coreapi.ValidatorsRequest) (*coreapi.RawResponse, error) {
+	if err := checkNodeSynced(); err != nil {
+		return nil, err
+	}
+
+	pageSize := ParamsGRPCAPI.MaxPageSize
+	if req.GetPageSize() > 0 {
+		pageSize = lo.Min(req.GetPageSize(), ParamsGRPCAPI.MaxPageSize)
+	}
+
+	latestCommittedSlot := deps.RequestHandler.GetLatestCommitment().Slot()
+	currentEpoch := deps.RequestHandler.APIProvider().APIForSlot(latestCommittedSlot).TimeProvider().EpochFromSlot(latestCommittedSlot)
+	requestedEpoch := currentEpoch
+
+	// no cursor provided will be the first request
+	var cursorIndex uint32
+	if len(req.GetCursor()) != 0 {
+		var err error
+		if requestedEpoch, cursorIndex, err = parseEpochCursor(req.GetCursor()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cursor: %s", err)
+		}
+	}
+
+	if requestedEpoch > currentEpoch || requestedEpoch <= deps.RequestHandler.GetNodeStatus().PruningEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, "epoch %d is larger than current epoch or already pruned", requestedEpoch)
+	}
+
+	return rawResponse(deps.RequestHandler.Validators(requestedEpoch, cursorIndex, pageSize))
+}
+
+func (s *Server) ReadValidator(_ context.Context, req *coreapi.AccountId) (*coreapi.RawResponse, error) {
+	if err := checkNodeSynced(); err != nil {
+		return nil, err
+	}
+
+	accountAddress, err := parseAccountAddress(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return rawResponse(deps.RequestHandler.ValidatorByAccountAddress(accountAddress))
+}
+
+func (s *Server) ReadRewards(_ context.Context, req *coreapi.RewardsRequest) (*coreapi.RawResponse, error) {
+	if err := checkNodeSynced(); err != nil {
+		return nil, err
+	}
+
+	outputID, err := parseID("output ID", req.GetOutputId(), iotago.OutputIDFromBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	var slot []iotago.SlotIndex
+	if req.Slot != nil {
+		slot = append(slot, iotago.SlotIndex(req.GetSlot()))
+	}
+
+	return rawResponse(deps.RequestHandler.RewardsByOutputID(outputID, slot...))
+}
+
+func (s *Server) ReadCommittee(_ context.Context, req *coreapi.CommitteeRequest) (*coreapi.RawResponse, error) {
+	if err := checkNodeSynced(); err != nil {
+		return nil, err
+	}
+
+	// by default we return current epoch
+	currentEpoch := deps.RequestHandler.CommittedAPI().TimeProvider().CurrentEpoch()
+	epoch := currentEpoch
+
+	if req.Epoch != nil {
+		epoch = iotago.EpochIndex(req.GetEpoch())
+		if epoch > currentEpoch {
+			return nil, status.Errorf(codes.InvalidArgument, "provided epoch %d is from the future, current epoch: %d", epoch, currentEpoch)
+		}
+	}
+
+	return rawResponse(deps.RequestHandler.SelectedCommittee(epoch))
+}
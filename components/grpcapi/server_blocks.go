@@ -0,0 +1,79 @@
+package grpcapi
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotaledger/iota-core/pkg/grpcapi"
+	"github.com/iotaledger/iota-core/pkg/grpcapi/coreapi"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func parseBlockID(blockID *coreapi.BlockId) (iotago.BlockID, error) {
+	return parseID("block ID", blockID.GetId(), iotago.BlockIDFromBytes)
+}
+
+func (s *Server) ReadBlock(_ context.Context, req *coreapi.BlockId) (*coreapi.RawResponse, error) {
+	blockID, err := parseBlockID(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return rawResponse(deps.RequestHandler.BlockFromBlockID(blockID))
+}
+
+func (s *Server) ReadBlockMetadata(_ context.Context, req *coreapi.BlockId) (*coreapi.RawResponse, error) {
+	if err := checkNodeSynced(); err != nil {
+		return nil, err
+	}
+
+	blockID, err := parseBlockID(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return rawResponse(deps.RequestHandler.BlockMetadataFromBlockID(blockID))
+}
+
+func (s *Server) ReadBlockWithMetadata(_ context.Context, req *coreapi.BlockId) (*coreapi.RawResponse, error) {
+	if err := checkNodeSynced(); err != nil {
+		return nil, err
+	}
+
+	blockID, err := parseBlockID(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return rawResponse(deps.RequestHandler.BlockWithMetadataFromBlockID(blockID))
+}
+
+func (s *Server) ReadBlockIssuance(_ context.Context, _ *coreapi.NoParams) (*coreapi.RawResponse, error) {
+	if err := checkNodeSynced(); err != nil {
+		return nil, err
+	}
+
+	return rawResponse(deps.RequestHandler.BlockIssuance())
+}
+
+func (s *Server) SubmitBlock(ctx context.Context, rawBlock *coreapi.RawBlock) (*coreapi.BlockId, error) {
+	if err := checkNodeSynced(); err != nil {
+		return nil, err
+	}
+
+	block, _, err := iotago.BlockFromBytes(deps.RequestHandler.APIProvider())(rawBlock.GetData())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to parse block: %s", err)
+	}
+
+	blockID, err := deps.RequestHandler.SubmitBlockAndAwaitRetainer(ctx, block)
+	if err != nil {
+		return nil, grpcapi.StatusFromError(err)
+	}
+
+	return &coreapi.BlockId{
+		Id: blockID[:],
+	}, nil
+}
@@ -0,0 +1,61 @@
+package grpcapi
+
+import (
+	"context"
+
+	"github.com/iotaledger/iota-core/pkg/grpcapi"
+	"github.com/iotaledger/iota-core/pkg/grpcapi/coreapi"
+	"github.com/iotaledger/iota-core/pkg/model"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func parseCommitmentID(commitmentID *coreapi.CommitmentId) (iotago.CommitmentID, error) {
+	return parseID("commitment ID", commitmentID.GetId(), iotago.CommitmentIDFromBytes)
+}
+
+func commitmentResponse(commitment *model.Commitment, err error) (*coreapi.RawResponse, error) {
+	if err != nil {
+		return nil, grpcapi.StatusFromError(err)
+	}
+
+	return rawResponse(commitment.Commitment(), nil)
+}
+
+func (s *Server) ReadCommitment(_ context.Context, req *coreapi.CommitmentId) (*coreapi.RawResponse, error) {
+	commitmentID, err := parseCommitmentID(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return commitmentResponse(deps.RequestHandler.GetCommitmentByID(commitmentID))
+}
+
+func (s *Server) ReadCommitmentBySlot(_ context.Context, req *coreapi.SlotRequest) (*coreapi.RawResponse, error) {
+	return commitmentResponse(deps.RequestHandler.GetCommitmentBySlot(iotago.SlotIndex(req.GetSlot())))
+}
+
+func (s *Server) ReadUTXOChanges(_ context.Context, req *coreapi.CommitmentId) (*coreapi.RawResponse, error) {
+	commitmentID, err := parseCommitmentID(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return rawResponse(deps.RequestHandler.GetUTXOChangesByCommitmentID(commitmentID))
+}
+
+func (s *Server) ReadUTXOChangesBySlot(_ context.Context, req *coreapi.SlotRequest) (*coreapi.RawResponse, error) {
+	return rawResponse(deps.RequestHandler.GetUTXOChangesBySlot(iotago.SlotIndex(req.GetSlot())))
+}
+
+func (s *Server) ReadUTXOChangesFull(_ context.Context, req *coreapi.CommitmentId) (*coreapi.RawResponse, error) {
+	commitmentID, err := parseCommitmentID(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return rawResponse(deps.RequestHandler.GetUTXOChangesFullByCommitmentID(commitmentID))
+}
+
+func (s *Server) ReadUTXOChangesFullBySlot(_ context.Context, req *coreapi.SlotRequest) (*coreapi.RawResponse, error) {
+	return rawResponse(deps.RequestHandler.GetUTXOChangesFullBySlot(iotago.SlotIndex(req.GetSlot())))
+}
@@ -0,0 +1,30 @@
+package grpcapi
+
+import (
+	"context"
+
+	"github.com/iotaledger/iota-core/pkg/grpcapi/coreapi"
+	"github.com/iotaledger/iota.go/v4/api"
+)
+
+func (s *Server) ReadInfo(_ context.Context, _ *coreapi.NoParams) (*coreapi.RawResponse, error) {
+	return rawResponse(&api.InfoResponse{
+		Name:               deps.AppInfo.Name,
+		Version:            deps.AppInfo.Version,
+		Status:             deps.RequestHandler.GetNodeStatus(),
+		ProtocolParameters: deps.RequestHandler.GetProtocolParameters(),
+		BaseToken: &api.InfoResBaseToken{
+			Name:         deps.BaseToken.Name,
+			TickerSymbol: deps.BaseToken.TickerSymbol,
+			Unit:         deps.BaseToken.Unit,
+			Subunit:      deps.BaseToken.Subunit,
+			Decimals:     deps.BaseToken.Decimals,
+		},
+	}, nil)
+}
+
+func (s *Server) ReadNetworkHealth(_ context.Context, _ *coreapi.NoParams) (*coreapi.NetworkHealthResponse, error) {
+	return &coreapi.NetworkHealthResponse{
+		IsNetworkHealthy: deps.RequestHandler.IsNetworkHealthy(),
+	}, nil
+}
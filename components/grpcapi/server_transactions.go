@@ -0,0 +1,75 @@
+package grpcapi
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotaledger/iota-core/pkg/grpcapi"
+	"github.com/iotaledger/iota-core/pkg/grpcapi/coreapi"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func parseTransactionID(transactionID *coreapi.TransactionId) (iotago.TransactionID, error) {
+	return parseID("transaction ID", transactionID.GetId(), iotago.TransactionIDFromBytes)
+}
+
+func (s *Server) ReadTransaction(_ context.Context, req *coreapi.TransactionId) (*coreapi.RawResponse, error) {
+	transactionID, err := parseTransactionID(req)
+	if err != nil {
+		return nil, err
+	}
+
+	blockID, err := deps.RequestHandler.BlockIDFromTransactionID(transactionID)
+	if err != nil {
+		return nil, grpcapi.StatusFromError(err)
+	}
+
+	block, err := deps.RequestHandler.ModelBlockFromBlockID(blockID)
+	if err != nil {
+		return nil, grpcapi.StatusFromError(err)
+	}
+
+	signedTransaction, isTransaction := block.SignedTransaction()
+	if !isTransaction {
+		return nil, status.Errorf(codes.Internal, "block %s does not contain a transaction", blockID)
+	}
+
+	return rawResponse(signedTransaction.Transaction, nil)
+}
+
+func (s *Server) ReadTransactionMetadata(_ context.Context, req *coreapi.TransactionId) (*coreapi.RawResponse, error) {
+	if err := checkNodeSynced(); err != nil {
+		return nil, err
+	}
+
+	transactionID, err := parseTransactionID(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return rawResponse(deps.RequestHandler.TransactionMetadataFromTransactionID(transactionID))
+}
+
+func (s *Server) ReadTransactionIncludedBlock(_ context.Context, req *coreapi.TransactionId) (*coreapi.RawResponse, error) {
+	transactionID, err := parseTransactionID(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return rawResponse(deps.RequestHandler.BlockFromTransactionID(transactionID))
+}
+
+func (s *Server) ReadTransactionIncludedBlockMetadata(_ context.Context, req *coreapi.TransactionId) (*coreapi.RawResponse, error) {
+	if err := checkNodeSynced(); err != nil {
+		return nil, err
+	}
+
+	transactionID, err := parseTransactionID(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return rawResponse(deps.RequestHandler.BlockMetadataFromTransactionID(transactionID))
+}
@@ -0,0 +1,47 @@
+package grpcapi
+
+import (
+	"context"
+
+	"github.com/iotaledger/iota-core/pkg/grpcapi/coreapi"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func parseOutputID(outputID *coreapi.OutputId) (iotago.OutputID, error) {
+	return parseID("output ID", outputID.GetId(), iotago.OutputIDFromBytes)
+}
+
+func (s *Server) ReadOutput(_ context.Context, req *coreapi.OutputId) (*coreapi.RawResponse, error) {
+	outputID, err := parseOutputID(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return rawResponse(deps.RequestHandler.OutputFromOutputID(outputID))
+}
+
+func (s *Server) ReadOutputMetadata(_ context.Context, req *coreapi.OutputId) (*coreapi.RawResponse, error) {
+	if err := checkNodeSynced(); err != nil {
+		return nil, err
+	}
+
+	outputID, err := parseOutputID(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return rawResponse(deps.RequestHandler.OutputMetadataFromOutputID(outputID))
+}
+
+func (s *Server) ReadOutputWithMetadata(_ context.Context, req *coreapi.OutputId) (*coreapi.RawResponse, error) {
+	if err := checkNodeSynced(); err != nil {
+		return nil, err
+	}
+
+	outputID, err := parseOutputID(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return rawResponse(deps.RequestHandler.OutputWithMetadataFromOutputID(outputID))
+}
@@ -0,0 +1,154 @@
+package indexer
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/hive.go/app"
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/runtime/event"
+	"github.com/iotaledger/hive.go/runtime/workerpool"
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+	"github.com/iotaledger/iota-core/pkg/daemon"
+	"github.com/iotaledger/iota-core/pkg/protocol"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/notarization"
+	restapipkg "github.com/iotaledger/iota-core/pkg/restapi"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/api"
+)
+
+func init() {
+	Component = &app.Component{
+		Name:      "Indexer",
+		DepsFunc:  func(cDeps dependencies) { deps = cDeps },
+		Params:    params,
+		Configure: configure,
+		Run:       run,
+		IsEnabled: func(_ *dig.Container) bool {
+			return ParamsIndexer.Enabled
+		},
+	}
+}
+
+var (
+	Component *app.Component
+	deps      dependencies
+
+	// indexerWorkerPool applies all changes to the indexer, a single worker makes sure that they are applied in order.
+	indexerWorkerPool *workerpool.WorkerPool
+)
+
+type dependencies struct {
+	dig.In
+
+	Protocol         *protocol.Protocol
+	RestRouteManager *restapipkg.RestRouteManager
+}
+
+func configure() error {
+	if ParamsIndexer.MaxPageSize == 0 {
+		Component.LogPanicf("parameter %s has to be greater than 0", Component.App().Config().GetParameterPath(&(ParamsIndexer.MaxPageSize)))
+	}
+
+	indexerWorkerPool = workerpool.New("Indexer", workerpool.WithWorkerCount(1))
+
+	deps.Protocol.Events.Engine.Notarization.SlotCommitted.Hook(func(scd *notarization.SlotCommittedDetails) {
+		onSlotCommitted(scd)
+	}, event.WithWorkerPool(indexerWorkerPool))
+
+	deps.Protocol.Events.Engine.SlotGadget.SlotFinalized.Hook(func(slot iotago.SlotIndex) {
+		onSlotFinalized(slot)
+	}, event.WithWorkerPool(indexerWorkerPool))
+
+	routeGroup := deps.RestRouteManager.AddRoute(api.IndexerPluginName)
+
+	routeGroup.GET(api.IndexerEndpointOutputs, func(c echo.Context) error {
+		return queryOutputs(c, outputsFilters)
+	}, checkIndexerReady())
+
+	routeGroup.GET(api.IndexerEndpointOutputsBasic, func(c echo.Context) error {
+		return queryOutputs(c, basicOutputsFilters)
+	}, checkIndexerReady())
+
+	routeGroup.GET(api.IndexerEndpointOutputsAccounts, func(c echo.Context) error {
+		return queryOutputs(c, accountOutputsFilters)
+	}, checkIndexerReady())
+
+	routeGroup.GET(api.EndpointWithEchoParameters(api.IndexerEndpointOutputsAccountByAddress), func(c echo.Context) error {
+		return queryChainOutput(c, accountIDFromAddressParam)
+	}, checkIndexerReady())
+
+	routeGroup.GET(api.IndexerEndpointOutputsAnchors, func(c echo.Context) error {
+		return queryOutputs(c, anchorOutputsFilters)
+	}, checkIndexerReady())
+
+	routeGroup.GET(api.EndpointWithEchoParameters(api.IndexerEndpointOutputsAnchorByAddress), func(c echo.Context) error {
+		return queryChainOutput(c, anchorIDFromAddressParam)
+	}, checkIndexerReady())
+
+	routeGroup.GET(api.IndexerEndpointOutputsFoundries, func(c echo.Context) error {
+		return queryOutputs(c, foundryOutputsFilters)
+	}, checkIndexerReady())
+
+	routeGroup.GET(api.EndpointWithEchoParameters(api.IndexerEndpointOutputsFoundryByID), func(c echo.Context) error {
+		return queryChainOutput(c, foundryIDFromParam)
+	}, checkIndexerReady())
+
+	routeGroup.GET(api.IndexerEndpointOutputsNFTs, func(c echo.Context) error {
+		return queryOutputs(c, nftOutputsFilters)
+	}, checkIndexerReady())
+
+	routeGroup.GET(api.EndpointWithEchoParameters(api.IndexerEndpointOutputsNFTByAddress), func(c echo.Context) error {
+		return queryChainOutput(c, nftIDFromAddressParam)
+	}, checkIndexerReady())
+
+	routeGroup.GET(api.IndexerEndpointOutputsDelegations, func(c echo.Context) error {
+		return queryOutputs(c, delegationOutputsFilters)
+	}, checkIndexerReady())
+
+	routeGroup.GET(api.EndpointWithEchoParameters(api.IndexerEndpointOutputsDelegationByID), func(c echo.Context) error {
+		return queryChainOutput(c, delegationIDFromParam)
+	}, checkIndexerReady())
+
+	return nil
+}
+
+func run() error {
+	return Component.Daemon().BackgroundWorker("Indexer", func(ctx context.Context) {
+		indexerWorkerPool.Start()
+
+		// the indexer of the new main engine is synchronized with its ledger state after an engine switch.
+		unsubscribe := deps.Protocol.Engines.Main.OnUpdate(func(_ *engine.Engine, mainEngine *engine.Engine) {
+			indexerWorkerPool.Submit(func() {
+				onMainEngineChanged(mainEngine)
+			})
+		})
+
+		<-ctx.Done()
+
+		Component.LogInfo("Gracefully shutting down the indexer...")
+		unsubscribe()
+		indexerWorkerPool.Shutdown()
+		indexerWorkerPool.ShutdownComplete.Wait()
+	}, daemon.PriorityIndexer)
+}
+
+func checkIndexerReady() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if !isIndexerReady() {
+				return ierrors.WithMessage(echo.ErrServiceUnavailable, "indexer is not ready")
+			}
+
+			return next(c)
+		}
+	}
+}
+
+func responseByHeader(c echo.Context, obj any) error {
+	return httpserver.SendResponseByHeader(c, deps.Protocol.CommittedAPI(), obj, http.StatusOK)
+}
@@ -0,0 +1,349 @@
+package indexer
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+	"github.com/iotaledger/iota-core/pkg/indexer"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/api"
+)
+
+// query parameters of the indexer API, see the query types in the api package of iota.go.
+const (
+	QueryParameterAddress                     = "address"
+	QueryParameterUnlockableByAddress         = "unlockableByAddress"
+	QueryParameterHasNativeToken              = "hasNativeToken"
+	QueryParameterNativeToken                 = "nativeToken"
+	QueryParameterHasStorageDepositReturn     = "hasStorageDepositReturn"
+	QueryParameterStorageDepositReturnAddress = "storageDepositReturnAddress"
+	QueryParameterHasTimelock                 = "hasTimelock"
+	QueryParameterTimelockedBefore            = "timelockedBefore"
+	QueryParameterTimelockedAfter             = "timelockedAfter"
+	QueryParameterHasExpiration               = "hasExpiration"
+	QueryParameterExpiresBefore               = "expiresBefore"
+	QueryParameterExpiresAfter                = "expiresAfter"
+	QueryParameterExpirationReturnAddress     = "expirationReturnAddress"
+	QueryParameterSender                      = "sender"
+	QueryParameterIssuer                      = "issuer"
+	QueryParameterTag                         = "tag"
+	QueryParameterStateController             = "stateController"
+	QueryParameterGovernor                    = "governor"
+	QueryParameterAccountAddress              = "accountAddress"
+	QueryParameterValidator                   = "validator"
+	QueryParameterCreatedBefore               = "createdBefore"
+	QueryParameterCreatedAfter                = "createdAfter"
+
+	// maxTagLength is the maximum length of a tag feature.
+	maxTagLength = 64
+)
+
+// filterParser collects the filters of the query parameters that are set in a request.
+type filterParser struct {
+	c       echo.Context
+	filters []indexer.Filter
+	err     error
+}
+
+func newFilterParser(c echo.Context, outputTypes ...iotago.OutputType) *filterParser {
+	return &filterParser{
+		c:       c,
+		filters: []indexer.Filter{indexer.FilterOutputType(outputTypes...)},
+	}
+}
+
+// skip returns true if the query parameter is not set or a previous query parameter was invalid.
+func (p *filterParser) skip(paramName string) bool {
+	return p.err != nil || len(p.c.QueryParam(paramName)) == 0
+}
+
+func (p *filterParser) address(paramName string, filterFunc func(iotago.Address) indexer.Filter) *filterParser {
+	if p.skip(paramName) {
+		return p
+	}
+
+	address, err := httpserver.ParseBech32AddressQueryParam(p.c, deps.Protocol.CommittedAPI().ProtocolParameters().Bech32HRP(), paramName)
+	if err != nil {
+		p.err = err
+
+		return p
+	}
+	p.filters = append(p.filters, filterFunc(address))
+
+	return p
+}
+
+func (p *filterParser) bool(paramName string, filterFunc func(bool) indexer.Filter) *filterParser {
+	if p.skip(paramName) {
+		return p
+	}
+
+	value, err := httpserver.ParseBoolQueryParam(p.c, paramName)
+	if err != nil {
+		p.err = ierrors.Wrapf(httpserver.ErrInvalidParameter, "invalid param: %s, error: %s", paramName, err)
+
+		return p
+	}
+	p.filters = append(p.filters, filterFunc(value))
+
+	return p
+}
+
+func (p *filterParser) slot(paramName string, filterFunc func(iotago.SlotIndex) indexer.Filter) *filterParser {
+	if p.skip(paramName) {
+		return p
+	}
+
+	slot, err := httpserver.ParseSlotQueryParam(p.c, paramName)
+	if err != nil {
+		p.err = err
+
+		return p
+	}
+	p.filters = append(p.filters, filterFunc(slot))
+
+	return p
+}
+
+func (p *filterParser) nativeToken(paramName string) *filterParser {
+	if p.skip(paramName) {
+		return p
+	}
+
+	nativeTokenIDBytes, err := httpserver.ParseHexQueryParam(p.c, paramName, iotago.NativeTokenIDLength)
+	if err != nil {
+		p.err = err
+
+		return p
+	}
+
+	if len(nativeTokenIDBytes) != iotago.NativeTokenIDLength {
+		p.err = ierrors.Wrapf(httpserver.ErrInvalidParameter, "invalid native token ID length: %d", len(nativeTokenIDBytes))
+
+		return p
+	}
+
+	var nativeTokenID iotago.NativeTokenID
+	copy(nativeTokenID[:], nativeTokenIDBytes)
+	p.filters = append(p.filters, indexer.FilterNativeToken(nativeTokenID))
+
+	return p
+}
+
+func (p *filterParser) tag(paramName string) *filterParser {
+	if p.skip(paramName) {
+		return p
+	}
+
+	tag, err := httpserver.ParseHexQueryParam(p.c, paramName, maxTagLength)
+	if err != nil {
+		p.err = err
+
+		return p
+	}
+	p.filters = append(p.filters, indexer.FilterTag(tag))
+
+	return p
+}
+
+func (p *filterParser) created() *filterParser {
+	return p.
+		slot(QueryParameterCreatedBefore, indexer.FilterCreatedBefore).
+		slot(QueryParameterCreatedAfter, indexer.FilterCreatedAfter)
+}
+
+func (p *filterParser) nativeTokens() *filterParser {
+	return p.
+		bool(QueryParameterHasNativeToken, indexer.FilterHasNativeToken).
+		nativeToken(QueryParameterNativeToken)
+}
+
+func (p *filterParser) unlockConditions() *filterParser {
+	return p.
+		bool(QueryParameterHasStorageDepositReturn, indexer.FilterHasStorageDepositReturn).
+		address(QueryParameterStorageDepositReturnAddress, indexer.FilterStorageDepositReturnAddress).
+		bool(QueryParameterHasTimelock, indexer.FilterHasTimelock).
+		slot(QueryParameterTimelockedBefore, indexer.FilterTimelockedBefore).
+		slot(QueryParameterTimelockedAfter, indexer.FilterTimelockedAfter).
+		bool(QueryParameterHasExpiration, indexer.FilterHasExpiration).
+		slot(QueryParameterExpiresBefore, indexer.FilterExpiresBefore).
+		slot(QueryParameterExpiresAfter, indexer.FilterExpiresAfter).
+		address(QueryParameterExpirationReturnAddress, indexer.FilterExpirationReturnAddress)
+}
+
+func (p *filterParser) result() ([]indexer.Filter, error) {
+	return p.filters, p.err
+}
+
+func outputsFilters(c echo.Context) ([]indexer.Filter, error) {
+	return newFilterParser(c, iotago.OutputBasic, iotago.OutputAccount, iotago.OutputAnchor, iotago.OutputFoundry, iotago.OutputNFT, iotago.OutputDelegation).
+		nativeTokens().
+		address(QueryParameterUnlockableByAddress, indexer.FilterUnlockableByAddress).
+		created().
+		result()
+}
+
+func basicOutputsFilters(c echo.Context) ([]indexer.Filter, error) {
+	return newFilterParser(c, iotago.OutputBasic).
+		nativeTokens().
+		address(QueryParameterAddress, indexer.FilterAddress).
+		address(QueryParameterUnlockableByAddress, indexer.FilterUnlockableByAddress).
+		unlockConditions().
+		address(QueryParameterSender, indexer.FilterSender).
+		tag(QueryParameterTag).
+		created().
+		result()
+}
+
+func accountOutputsFilters(c echo.Context) ([]indexer.Filter, error) {
+	return newFilterParser(c, iotago.OutputAccount).
+		address(QueryParameterAddress, indexer.FilterAddress).
+		address(QueryParameterUnlockableByAddress, indexer.FilterUnlockableByAddress).
+		address(QueryParameterIssuer, indexer.FilterIssuer).
+		address(QueryParameterSender, indexer.FilterSender).
+		created().
+		result()
+}
+
+func anchorOutputsFilters(c echo.Context) ([]indexer.Filter, error) {
+	return newFilterParser(c, iotago.OutputAnchor).
+		address(QueryParameterUnlockableByAddress, indexer.FilterUnlockableByAddress).
+		address(QueryParameterStateController, indexer.FilterStateController).
+		address(QueryParameterGovernor, indexer.FilterGovernor).
+		address(QueryParameterIssuer, indexer.FilterIssuer).
+		address(QueryParameterSender, indexer.FilterSender).
+		created().
+		result()
+}
+
+func foundryOutputsFilters(c echo.Context) ([]indexer.Filter, error) {
+	return newFilterParser(c, iotago.OutputFoundry).
+		nativeTokens().
+		address(QueryParameterAccountAddress, indexer.FilterAccountAddress).
+		created().
+		result()
+}
+
+func nftOutputsFilters(c echo.Context) ([]indexer.Filter, error) {
+	return newFilterParser(c, iotago.OutputNFT).
+		address(QueryParameterAddress, indexer.FilterAddress).
+		address(QueryParameterUnlockableByAddress, indexer.FilterUnlockableByAddress).
+		unlockConditions().
+		address(QueryParameterIssuer, indexer.FilterIssuer).
+		address(QueryParameterSender, indexer.FilterSender).
+		tag(QueryParameterTag).
+		created().
+		result()
+}
+
+func delegationOutputsFilters(c echo.Context) ([]indexer.Filter, error) {
+	return newFilterParser(c, iotago.OutputDelegation).
+		address(QueryParameterAddress, indexer.FilterAddress).
+		address(QueryParameterValidator, indexer.FilterValidator).
+		created().
+		result()
+}
+
+// queryOutputs returns a page of the unspent outputs that match the filters of the query parameters of the request.
+func queryOutputs(c echo.Context, filtersFunc func(c echo.Context) ([]indexer.Filter, error)) error {
+	filters, err := filtersFunc(c)
+	if err != nil {
+		return err
+	}
+
+	var cursor *string
+	if cursorParam := c.QueryParam(api.ParameterCursor); len(cursorParam) > 0 {
+		cursor = &cursorParam
+	}
+
+	return sendIndexerResponse(c, httpserver.ParsePageSizeQueryParam(c, api.ParameterPageSize, ParamsIndexer.MaxPageSize), cursor, filters...)
+}
+
+// queryChainOutput returns the unspent output of the chain that is identified by the parameters of the request.
+func queryChainOutput(c echo.Context, chainIDFunc func(c echo.Context) (iotago.ChainID, error)) error {
+	chainID, err := chainIDFunc(c)
+	if err != nil {
+		return err
+	}
+
+	return sendIndexerResponse(c, 1, nil, indexer.FilterChainID(chainID))
+}
+
+func sendIndexerResponse(c echo.Context, pageSize uint32, cursor *string, filters ...indexer.Filter) error {
+	_, idx := current()
+	if idx == nil {
+		return ierrors.WithMessage(echo.ErrServiceUnavailable, "indexer is not ready")
+	}
+
+	result, err := idx.Outputs(pageSize, cursor, filters...)
+	if err != nil {
+		if ierrors.Is(err, indexer.ErrInvalidCursor) {
+			return ierrors.Wrapf(httpserver.ErrInvalidParameter, "invalid param: %s, error: %s", api.ParameterCursor, err)
+		}
+
+		return ierrors.WithMessagef(echo.ErrInternalServerError, "failed to query the indexer: %s", err)
+	}
+
+	response := &api.IndexerResponse{
+		CommittedSlot: result.LedgerIndex,
+		PageSize:      result.PageSize,
+		Items:         iotago.HexOutputIDsFromOutputIDs(result.OutputIDs...),
+	}
+
+	if result.Cursor != nil {
+		response.Cursor = *result.Cursor
+	}
+
+	return responseByHeader(c, response)
+}
+
+func accountIDFromAddressParam(c echo.Context) (iotago.ChainID, error) {
+	address, err := httpserver.ParseBech32AddressParam(c, deps.Protocol.CommittedAPI().ProtocolParameters().Bech32HRP(), api.ParameterBech32Address)
+	if err != nil {
+		return nil, err
+	}
+
+	accountAddress, isAccountAddress := address.(*iotago.AccountAddress)
+	if !isAccountAddress {
+		return nil, ierrors.Wrapf(httpserver.ErrInvalidParameter, "address %s is not an account address", c.Param(api.ParameterBech32Address))
+	}
+
+	return accountAddress.AccountID(), nil
+}
+
+func anchorIDFromAddressParam(c echo.Context) (iotago.ChainID, error) {
+	address, err := httpserver.ParseBech32AddressParam(c, deps.Protocol.CommittedAPI().ProtocolParameters().Bech32HRP(), api.ParameterBech32Address)
+	if err != nil {
+		return nil, err
+	}
+
+	anchorAddress, isAnchorAddress := address.(*iotago.AnchorAddress)
+	if !isAnchorAddress {
+		return nil, ierrors.Wrapf(httpserver.ErrInvalidParameter, "address %s is not an anchor address", c.Param(api.ParameterBech32Address))
+	}
+
+	return anchorAddress.AnchorID(), nil
+}
+
+func nftIDFromAddressParam(c echo.Context) (iotago.ChainID, error) {
+	address, err := httpserver.ParseBech32AddressParam(c, deps.Protocol.CommittedAPI().ProtocolParameters().Bech32HRP(), api.ParameterBech32Address)
+	if err != nil {
+		return nil, err
+	}
+
+	nftAddress, isNFTAddress := address.(*iotago.NFTAddress)
+	if !isNFTAddress {
+		return nil, ierrors.Wrapf(httpserver.ErrInvalidParameter, "address %s is not an NFT address", c.Param(api.ParameterBech32Address))
+	}
+
+	return nftAddress.NFTID(), nil
+}
+
+func foundryIDFromParam(c echo.Context) (iotago.ChainID, error) {
+	return httpserver.ParseFoundryIDParam(c, api.ParameterFoundryID)
+}
+
+func delegationIDFromParam(c echo.Context) (iotago.ChainID, error) {
+	return httpserver.ParseDelegationIDParam(c, api.ParameterDelegationID)
+}
@@ -0,0 +1,22 @@
+package indexer
+
+import (
+	"github.com/iotaledger/hive.go/app"
+)
+
+// ParametersIndexer contains the definition of configuration parameters used by the indexer.
+type ParametersIndexer struct {
+	// Enabled whether the indexer component is enabled.
+	Enabled bool `default:"false" usage:"whether the indexer component is enabled"`
+	// MaxPageSize the maximum number of results that may be returned for each page.
+	MaxPageSize uint32 `default:"1000" usage:"the maximum number of results that may be returned for each page"`
+}
+
+// ParamsIndexer is the default configuration parameters for the indexer component.
+var ParamsIndexer = &ParametersIndexer{}
+
+var params = &app.ComponentParams{
+	Params: map[string]any{
+		"indexer": ParamsIndexer,
+	},
+}
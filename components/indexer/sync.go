@@ -0,0 +1,160 @@
+package indexer
+
+import (
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/runtime/syncutils"
+	"github.com/iotaledger/iota-core/pkg/indexer"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/notarization"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/utxoledger"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+var (
+	// currentEngine is the main engine the current indexer belongs to.
+	currentEngine *engine.Engine
+	// currentIndexer is the indexer of the main engine, it is nil while the indexer is synchronized with the ledger state.
+	currentIndexer      *indexer.Indexer
+	currentIndexerMutex syncutils.RWMutex
+)
+
+func setCurrentIndexer(mainEngine *engine.Engine, idx *indexer.Indexer) {
+	currentIndexerMutex.Lock()
+	defer currentIndexerMutex.Unlock()
+
+	currentEngine, currentIndexer = mainEngine, idx
+}
+
+func current() (*engine.Engine, *indexer.Indexer) {
+	currentIndexerMutex.RLock()
+	defer currentIndexerMutex.RUnlock()
+
+	return currentEngine, currentIndexer
+}
+
+func isIndexerReady() bool {
+	_, idx := current()
+
+	return idx != nil
+}
+
+// onMainEngineChanged switches the indexer to the database of the new main engine and synchronizes it with the ledger state.
+// Every engine has its own clone of the indexer database, which needs to be rolled back after chain switching.
+func onMainEngineChanged(mainEngine *engine.Engine) {
+	setCurrentIndexer(nil, nil)
+
+	if mainEngine == nil {
+		return
+	}
+
+	idx := indexer.New(mainEngine.Storage.IndexerDatabaseExecFunc())
+	if err := syncWithLedger(mainEngine, idx); err != nil {
+		Component.LogErrorf("failed to synchronize the indexer with the ledger state: %s", err)
+
+		return
+	}
+
+	setCurrentIndexer(mainEngine, idx)
+}
+
+// syncWithLedger brings the indexer to the latest committed slot of the given engine.
+// If the indexer can't be rolled back or caught up, the whole ledger state is imported again.
+func syncWithLedger(mainEngine *engine.Engine, idx *indexer.Indexer) error {
+	ledgerIndex := mainEngine.Storage.Settings().LatestCommitment().Slot()
+
+	indexerLedgerIndex, initialized, err := idx.LedgerIndex()
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case !initialized:
+		// the ledger state was never imported.
+	case indexerLedgerIndex > ledgerIndex:
+		if err = idx.Rollback(ledgerIndex); err == nil {
+			Component.LogInfof("rolled back the indexer from slot %d to slot %d", indexerLedgerIndex, ledgerIndex)
+
+			return nil
+		}
+
+		Component.LogWarnf("failed to roll back the indexer, importing the ledger state again: %s", err)
+	default:
+		if err = catchUp(mainEngine, idx, indexerLedgerIndex, ledgerIndex); err == nil {
+			return nil
+		}
+
+		Component.LogWarnf("failed to catch up the indexer, importing the ledger state again: %s", err)
+	}
+
+	Component.LogInfof("importing the ledger state of slot %d into the indexer...", ledgerIndex)
+
+	if err = idx.Import(ledgerIndex, func(consumer func(output *utxoledger.Output) bool) error {
+		return mainEngine.Ledger.ForEachUnspentOutputAtSlot(ledgerIndex, consumer)
+	}); err != nil {
+		return err
+	}
+
+	Component.LogInfof("importing the ledger state of slot %d into the indexer... done", ledgerIndex)
+
+	return nil
+}
+
+// catchUp applies the slot diffs of the ledger after the given ledger index of the indexer up to the given target slot.
+func catchUp(mainEngine *engine.Engine, idx *indexer.Indexer, indexerLedgerIndex iotago.SlotIndex, targetSlot iotago.SlotIndex) error {
+	for slot := indexerLedgerIndex + 1; slot <= targetSlot; slot++ {
+		slotDiff, err := mainEngine.Ledger.SlotDiffs(slot)
+		if err != nil {
+			return ierrors.Wrapf(err, "failed to load slot diff %d", slot)
+		}
+
+		if err := idx.CommitSlot(slot, slotDiff.Outputs, slotDiff.Spents); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// onSlotCommitted applies the outputs that were created and consumed in a committed slot to the indexer.
+func onSlotCommitted(scd *notarization.SlotCommittedDetails) {
+	mainEngine, idx := current()
+	if idx == nil {
+		return
+	}
+
+	slot := scd.Commitment.Slot()
+
+	if err := func() error {
+		ledgerIndex, _, err := idx.LedgerIndex()
+		if err != nil {
+			return err
+		}
+
+		// the slot was already applied while synchronizing the indexer with the ledger state.
+		if slot <= ledgerIndex {
+			return nil
+		}
+
+		if err := catchUp(mainEngine, idx, ledgerIndex, slot-1); err != nil {
+			return err
+		}
+
+		return idx.CommitSlot(slot, scd.OutputsCreated, scd.OutputsConsumed)
+	}(); err != nil {
+		Component.LogErrorf("failed to apply slot %d to the indexer, synchronizing it with the ledger state again: %s", slot, err)
+
+		onMainEngineChanged(mainEngine)
+	}
+}
+
+// onSlotFinalized removes the spent outputs of finalized slots from the indexer.
+func onSlotFinalized(slot iotago.SlotIndex) {
+	_, idx := current()
+	if idx == nil {
+		return
+	}
+
+	if err := idx.Prune(slot); err != nil {
+		Component.LogWarnf("failed to prune the indexer: %s", err)
+	}
+}
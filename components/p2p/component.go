@@ -3,6 +3,7 @@ package p2p
 import (
 	"context"
 
+	"github.com/labstack/gommon/bytes"
 	"github.com/libp2p/go-libp2p"
 	"github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/libp2p/go-libp2p/core/host"
@@ -16,6 +17,8 @@ import (
 	"github.com/iotaledger/hive.go/app/configuration"
 	hivep2p "github.com/iotaledger/hive.go/crypto/p2p"
 	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/runtime/event"
+	"github.com/iotaledger/hive.go/runtime/options"
 	"github.com/iotaledger/iota-core/pkg/daemon"
 	"github.com/iotaledger/iota-core/pkg/network"
 	"github.com/iotaledger/iota-core/pkg/network/p2p"
@@ -60,6 +63,19 @@ func provide(c *dig.Container) error {
 			}
 
 			return deps.PeeringConfig.StoreFile(*deps.PeeringConfigFilePath, 0o600, []string{"p2p"})
+		}, func() ([]*p2p.PeerConfig, error) {
+			// read the peering config from scratch, so that peers removed from the file are not kept from the previous load.
+			peeringConfig := configuration.New()
+			if err := peeringConfig.LoadFile(*deps.PeeringConfigFilePath); err != nil {
+				return nil, err
+			}
+
+			var peers []*p2p.PeerConfig
+			if err := peeringConfig.Unmarshal(CfgPeers, &peers); err != nil {
+				return nil, ierrors.Wrap(err, "invalid peer config")
+			}
+
+			return peers, nil
 		})
 
 		// peers from peering config
@@ -118,13 +134,29 @@ func provide(c *dig.Container) error {
 		Component.LogPanic(err.Error())
 	}
 
+	if err := c.Provide(func() *network.PeerFilter {
+		peerFilter, err := network.NewPeerFilter(
+			ParamsP2P.PeerFilter.AllowedPeerIDs,
+			ParamsP2P.PeerFilter.DeniedPeerIDs,
+			ParamsP2P.PeerFilter.AllowedCIDRs,
+			ParamsP2P.PeerFilter.DeniedCIDRs,
+		)
+		if err != nil {
+			Component.LogPanicf("unable to initialize peer filter: %s", err)
+		}
+
+		return peerFilter
+	}); err != nil {
+		Component.LogPanic(err.Error())
+	}
+
 	type p2pResult struct {
 		dig.Out
 		NodePrivateKey crypto.PrivKey `name:"nodePrivateKey"`
 		Host           host.Host
 	}
 
-	if err := c.Provide(func() p2pResult {
+	if err := c.Provide(func(peerFilter *network.PeerFilter) p2pResult {
 		res := p2pResult{}
 
 		// make sure nobody copies around the peer store since it contains the private key of the node
@@ -159,6 +191,8 @@ func provide(c *dig.Container) error {
 			libp2p.ConnectionManager(connManager),
 			libp2p.NATPortMap(),
 			libp2p.DisableRelay(),
+			// Reject all connections to peers that are denied by the peer filter.
+			libp2p.ConnectionGater(p2p.NewConnectionGater(peerFilter)),
 			// Define a custom address factory to inject external addresses to the DHT advertisements.
 			libp2p.AddrsFactory(externalAddresses(ParamsP2P.Autopeering.ExternalMultiAddresses, ParamsP2P.Autopeering.AllowLocalIPs)),
 		)
@@ -184,6 +218,7 @@ func provide(c *dig.Container) error {
 		dig.In
 		Host       host.Host
 		P2PMetrics *p2p.Metrics
+		PeerFilter *network.PeerFilter
 	}
 
 	return c.Provide(func(inDeps p2pManagerDeps) network.Manager {
@@ -191,7 +226,18 @@ func provide(c *dig.Container) error {
 			inDeps.P2PMetrics.OutgoingBlocks.Add(1)
 		}
 
-		return p2p.NewManager(Component.Logger, inDeps.Host, ParamsP2P.Autopeering.MaxPeers, ParamsP2P.Autopeering.AllowLocalIPs, onBlockSentCallback)
+		banList, err := p2p.NewBanList(ParamsP2P.Reputation.BanListFilePath)
+		if err != nil {
+			Component.LogPanicf("unable to load ban list: %s", err)
+		}
+
+		reputation := p2p.NewReputation(banList,
+			p2p.WithBanThreshold(ParamsP2P.Reputation.BanThreshold),
+			p2p.WithBanDuration(ParamsP2P.Reputation.BanDuration),
+			p2p.WithScoreHalfLife(ParamsP2P.Reputation.ScoreHalfLife),
+		)
+
+		return p2p.NewManager(Component.Logger, inDeps.Host, ParamsP2P.Autopeering.MaxPeers, ParamsP2P.Autopeering.AllowLocalIPs, inDeps.PeerFilter, reputation, newRateLimiter(inDeps.P2PMetrics), onBlockSentCallback, append(topologyOptions(), gossipOptions()...)...)
 	})
 }
 
@@ -205,6 +251,11 @@ func configure() error {
 		Component.LogInfof("neighbor removed: %s / %s", neighbor.Peer().PeerAddresses, neighbor.Peer().ID)
 	})
 
+	// lower the reputation of misbehaving peers, so that they get banned eventually
+	deps.Protocol.Events.PeerMisbehaved.Hook(func(misbehavedEvent *protocol.PeerMisbehavedEvent) {
+		deps.NetworkManager.ReportMisbehavior(misbehavedEvent.Peer, misbehavedEvent.Misbehavior, misbehavedEvent.Reason)
+	}, event.WithWorkerPool(Component.WorkerPool))
+
 	return nil
 }
 
@@ -300,3 +351,80 @@ func externalAddresses(additionalMultiaddresses []string, allowLocalNetworks boo
 		return publicFilter(append(addresses, externalMultiAddrs...))
 	}
 }
+
+// newRateLimiter creates the RateLimiter for the packets received from neighbors based on the configuration.
+func newRateLimiter(metrics *p2p.Metrics) *p2p.RateLimiter {
+	if !ParamsP2P.RateLimit.Enabled {
+		return nil
+	}
+
+	bandwidth, err := bytes.Parse(ParamsP2P.RateLimit.Bandwidth)
+	if err != nil {
+		Component.LogPanicf("parameter %s invalid", Component.App().Config().GetParameterPath(&(ParamsP2P.RateLimit.Bandwidth)))
+	}
+
+	bandwidthBurst, err := bytes.Parse(ParamsP2P.RateLimit.BandwidthBurst)
+	if err != nil {
+		Component.LogPanicf("parameter %s invalid", Component.App().Config().GetParameterPath(&(ParamsP2P.RateLimit.BandwidthBurst)))
+	}
+
+	return p2p.NewRateLimiter(
+		p2p.RateLimit{Rate: float64(bandwidth), Burst: int(bandwidthBurst)},
+		map[p2p.PacketType]p2p.RateLimit{
+			p2p.PacketTypeBlock:                 {Rate: ParamsP2P.RateLimit.Blocks.Rate, Burst: ParamsP2P.RateLimit.Blocks.Burst},
+			p2p.PacketTypeBlockRequest:          {Rate: ParamsP2P.RateLimit.BlockRequests.Rate, Burst: ParamsP2P.RateLimit.BlockRequests.Burst},
+			p2p.PacketTypeSlotCommitmentRequest: {Rate: ParamsP2P.RateLimit.SlotCommitmentRequests.Rate, Burst: ParamsP2P.RateLimit.SlotCommitmentRequests.Burst},
+			p2p.PacketTypeAttestationsRequest:   {Rate: ParamsP2P.RateLimit.AttestationsRequests.Rate, Burst: ParamsP2P.RateLimit.AttestationsRequests.Burst},
+			p2p.PacketTypeWarpSyncRequest:       {Rate: ParamsP2P.RateLimit.WarpSyncRequests.Rate, Burst: ParamsP2P.RateLimit.WarpSyncRequests.Burst},
+		},
+		func(_ peer.ID, packetType p2p.PacketType) {
+			metrics.RateLimitedPackets[packetType].Add(1)
+		},
+	)
+}
+
+// topologyOptions returns the options of the network manager for the configured sentry-node topology.
+func topologyOptions() []options.Option[p2p.Manager] {
+	role, err := p2p.ParseTopologyRole(ParamsP2P.Topology.Role)
+	if err != nil {
+		Component.LogPanicf("parameter %s invalid: %s", Component.App().Config().GetParameterPath(&(ParamsP2P.Topology.Role)), err)
+	}
+
+	privatePeerIDs := make([]peer.ID, 0, len(ParamsP2P.Topology.PrivatePeerIDs))
+	for _, privatePeerID := range ParamsP2P.Topology.PrivatePeerIDs {
+		peerID, err := peer.Decode(privatePeerID)
+		if err != nil {
+			Component.LogPanicf("parameter %s invalid: %s", Component.App().Config().GetParameterPath(&(ParamsP2P.Topology.PrivatePeerIDs)), err)
+		}
+
+		privatePeerIDs = append(privatePeerIDs, peerID)
+	}
+
+	switch role {
+	case p2p.TopologyRoleValidator:
+		Component.LogInfo("Running as validator behind sentry nodes, only the manual peers are allowed as neighbors")
+	case p2p.TopologyRoleSentry:
+		Component.LogInfof("Running as sentry node for %d private peers", len(privatePeerIDs))
+	}
+
+	if role != p2p.TopologyRoleSentry && len(privatePeerIDs) > 0 {
+		Component.LogWarnf("%s is only used by sentry nodes", Component.App().Config().GetParameterPath(&(ParamsP2P.Topology.PrivatePeerIDs)))
+	}
+
+	return []options.Option[p2p.Manager]{
+		p2p.WithTopologyRole(role),
+		p2p.WithPrivatePeers(privatePeerIDs...),
+	}
+}
+
+// gossipOptions returns the options of the network manager for the packets sent to neighbors.
+func gossipOptions() []options.Option[p2p.Manager] {
+	if ParamsP2P.Gossip.BatchSize < 0 {
+		Component.LogPanicf("parameter %s invalid", Component.App().Config().GetParameterPath(&(ParamsP2P.Gossip.BatchSize)))
+	}
+
+	return []options.Option[p2p.Manager]{
+		p2p.WithCompression(ParamsP2P.Gossip.Compression),
+		p2p.WithBatchSize(ParamsP2P.Gossip.BatchSize),
+	}
+}
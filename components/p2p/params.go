@@ -1,6 +1,8 @@
 package p2p
 
 import (
+	"time"
+
 	"github.com/iotaledger/hive.go/app"
 )
 
@@ -40,6 +42,87 @@ type ParametersP2P struct {
 		// ExternalMultiAddress defines additional p2p multiaddresses to be advertised via DHT.
 		ExternalMultiAddresses []string `default:"" usage:"external reacheable multi addresses advertised to the network"`
 	}
+
+	Topology struct {
+		// Role defines the role of the node in a sentry-node topology.
+		Role string `default:"none" usage:"the role of the node in a sentry-node topology ('none', 'validator' or 'sentry'). A validator only connects to its manual peers (the sentries) and never runs autopeering"`
+		// PrivatePeerIDs defines the peer IDs of the validators behind this sentry, whose addresses are never shared with other peers.
+		PrivatePeerIDs []string `default:"" usage:"the peer IDs of the validators behind this sentry, whose addresses are never shared with other peers"`
+	}
+
+	PeerFilter struct {
+		// AllowedPeerIDs defines the peer IDs the node is allowed to connect to. If set, all other peers are rejected.
+		AllowedPeerIDs []string `default:"" usage:"the peer IDs the node is allowed to connect to, all other peers are rejected if set"`
+		// DeniedPeerIDs defines the peer IDs the node must not connect to.
+		DeniedPeerIDs []string `default:"" usage:"the peer IDs the node must not connect to"`
+		// AllowedCIDRs defines the networks the node is allowed to connect to. If set, all other addresses are rejected.
+		AllowedCIDRs []string `default:"" usage:"the networks (CIDR notation) the node is allowed to connect to, all other addresses are rejected if set"`
+		// DeniedCIDRs defines the networks the node must not connect to.
+		DeniedCIDRs []string `default:"" usage:"the networks (CIDR notation) the node must not connect to"`
+	}
+
+	Reputation struct {
+		// BanThreshold defines the misbehavior score at which a peer gets disconnected and banned.
+		BanThreshold float64 `default:"100" usage:"the misbehavior score at which a peer gets disconnected and banned"`
+		// BanDuration defines how long a misbehaving peer is banned.
+		BanDuration time.Duration `default:"1h" usage:"how long a misbehaving peer is banned"`
+		// ScoreHalfLife defines the duration after which the misbehavior score of a peer is halved.
+		ScoreHalfLife time.Duration `default:"10m" usage:"the duration after which the misbehavior score of a peer is halved"`
+		// BanListFilePath defines the file path to the list of banned peers.
+		BanListFilePath string `default:"testnet/p2p/banlist.json" usage:"the file path to the list of banned peers"`
+	}
+
+	RateLimit struct {
+		// Enabled defines whether the packets received from neighbors are rate limited.
+		// The limits also apply to the responses to the requests of the node, so they need to leave room for syncing.
+		Enabled bool `default:"false" usage:"whether the packets received from neighbors are rate limited"`
+		// Bandwidth defines the amount of (compressed) data a neighbor is allowed to send per second.
+		Bandwidth string `default:"8MB" usage:"the amount of data a neighbor is allowed to send per second (0 to disable)"`
+		// BandwidthBurst defines the amount of data a neighbor is allowed to send at once.
+		BandwidthBurst string `default:"16MB" usage:"the amount of data a neighbor is allowed to send at once"`
+
+		Blocks struct {
+			// Rate defines the number of blocks a neighbor is allowed to send per second.
+			Rate float64 `default:"200" usage:"the number of blocks a neighbor is allowed to send per second (0 to disable)"`
+			// Burst defines the number of blocks a neighbor is allowed to send at once.
+			Burst int `default:"500" usage:"the number of blocks a neighbor is allowed to send at once"`
+		}
+
+		BlockRequests struct {
+			// Rate defines the number of block requests a neighbor is allowed to send per second.
+			Rate float64 `default:"500" usage:"the number of block requests a neighbor is allowed to send per second (0 to disable)"`
+			// Burst defines the number of block requests a neighbor is allowed to send at once.
+			Burst int `default:"2000" usage:"the number of block requests a neighbor is allowed to send at once"`
+		}
+
+		SlotCommitmentRequests struct {
+			// Rate defines the number of slot commitment requests a neighbor is allowed to send per second.
+			Rate float64 `default:"50" usage:"the number of slot commitment requests a neighbor is allowed to send per second (0 to disable)"`
+			// Burst defines the number of slot commitment requests a neighbor is allowed to send at once.
+			Burst int `default:"200" usage:"the number of slot commitment requests a neighbor is allowed to send at once"`
+		}
+
+		AttestationsRequests struct {
+			// Rate defines the number of attestations requests a neighbor is allowed to send per second.
+			Rate float64 `default:"20" usage:"the number of attestations requests a neighbor is allowed to send per second (0 to disable)"`
+			// Burst defines the number of attestations requests a neighbor is allowed to send at once.
+			Burst int `default:"100" usage:"the number of attestations requests a neighbor is allowed to send at once"`
+		}
+
+		WarpSyncRequests struct {
+			// Rate defines the number of warp-sync requests a neighbor is allowed to send per second.
+			Rate float64 `default:"20" usage:"the number of warp-sync requests a neighbor is allowed to send per second (0 to disable)"`
+			// Burst defines the number of warp-sync requests a neighbor is allowed to send at once.
+			Burst int `default:"100" usage:"the number of warp-sync requests a neighbor is allowed to send at once"`
+		}
+	}
+
+	Gossip struct {
+		// Compression defines whether the packets sent to neighbors are compressed.
+		Compression bool `default:"true" usage:"whether the packets sent to neighbors that support it are compressed"`
+		// BatchSize defines the maximum number of queued packets that are sent to a neighbor at once.
+		BatchSize int `default:"32" usage:"the maximum number of queued packets that are sent to neighbors that support it as a single batch (0 to disable)"`
+	}
 }
 
 // ParametersPeers contains the definition of the parameters used by peers.
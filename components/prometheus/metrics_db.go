@@ -10,6 +10,8 @@ const (
 	sizeBytesPermanent          = "size_bytes_permanent"
 	sizeBytesPrunable           = "size_bytes_prunable"
 	sizeBytesTxRetainerDatabase = "size_bytes_tx_retainer_database"
+	sizeBytesIndexerDatabase    = "size_bytes_indexer_database"
+	sizeBytesArchive            = "size_bytes_archive"
 )
 
 var DBMetrics = collector.NewCollection(dbNamespace,
@@ -34,4 +36,18 @@ var DBMetrics = collector.NewCollection(dbNamespace,
 			return float64(deps.Protocol.Engines.Main.Get().Storage.TransactionRetainerDatabaseSize()), nil
 		}),
 	)),
+	collector.WithMetric(collector.NewMetric(sizeBytesIndexerDatabase,
+		collector.WithType(collector.Gauge),
+		collector.WithHelp("DB size in bytes for indexer SQL database."),
+		collector.WithCollectFunc(func() (metricValue float64, labelValues []string) {
+			return float64(deps.Protocol.Engines.Main.Get().Storage.IndexerDatabaseSize()), nil
+		}),
+	)),
+	collector.WithMetric(collector.NewMetric(sizeBytesArchive,
+		collector.WithType(collector.Gauge),
+		collector.WithHelp("DB size in bytes for the archive of pruned epochs."),
+		collector.WithCollectFunc(func() (metricValue float64, labelValues []string) {
+			return float64(deps.Protocol.Engines.Main.Get().Storage.ArchiveDatabaseSize()), nil
+		}),
+	)),
 )
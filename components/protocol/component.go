@@ -3,6 +3,8 @@ package protocol
 import (
 	"context"
 	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/labstack/gommon/bytes"
 	"github.com/libp2p/go-libp2p/core/peer"
@@ -11,10 +13,14 @@ import (
 	"github.com/iotaledger/hive.go/app"
 	"github.com/iotaledger/hive.go/db"
 	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/runtime/options"
 	"github.com/iotaledger/hive.go/runtime/workerpool"
+	"github.com/iotaledger/iota-core/components/indexer"
+	"github.com/iotaledger/iota-core/pkg/core/requester"
 	"github.com/iotaledger/iota-core/pkg/daemon"
 	"github.com/iotaledger/iota-core/pkg/model"
 	"github.com/iotaledger/iota-core/pkg/network"
+	"github.com/iotaledger/iota-core/pkg/network/protocols/core"
 	"github.com/iotaledger/iota-core/pkg/protocol"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/attestation/slotattestation"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/filter/presolidfilter/presolidblockfilter"
@@ -25,6 +31,7 @@ import (
 	"github.com/iotaledger/iota-core/pkg/storage"
 	"github.com/iotaledger/iota-core/pkg/storage/database"
 	"github.com/iotaledger/iota-core/pkg/storage/prunable"
+	"github.com/iotaledger/iota-core/pkg/storage/snapshot"
 	iotago "github.com/iotaledger/iota.go/v4"
 )
 
@@ -43,12 +50,19 @@ func init() {
 var (
 	Component *app.Component
 	deps      dependencies
+
+	// archive is the cold store of pruned epochs that is shared by all engine instances of an archive node.
+	archive *prunable.Archive
+
+	// networkTracer records the packets that are received from and sent to neighbors (nil if tracing is disabled).
+	networkTracer *core.Tracer
 )
 
 type dependencies struct {
 	dig.In
 
-	Protocol *protocol.Protocol
+	Protocol          *protocol.Protocol
+	SnapshotDirectory *snapshot.Directory
 }
 
 type jsonProtocolParameters struct {
@@ -91,6 +105,7 @@ func initConfigParams(c *dig.Container) error {
 		BaseToken          *BaseToken
 		ProtocolParameters []iotago.ProtocolParameters
 		SnapshotFilePath   string `name:"snapshotFilePath"`
+		SnapshotDirectory  *snapshot.Directory
 	}
 
 	if err := c.Provide(func() cfgResult {
@@ -104,6 +119,7 @@ func initConfigParams(c *dig.Container) error {
 			BaseToken:          &ParamsProtocol.BaseToken,
 			ProtocolParameters: readProtocolParameters(),
 			SnapshotFilePath:   ParamsProtocol.Snapshot.Path,
+			SnapshotDirectory:  snapshot.NewDirectory(ParamsProtocol.Snapshot.Scheduler.Directory),
 		}
 	}); err != nil {
 		Component.LogPanic(err.Error())
@@ -119,6 +135,11 @@ func provide(c *dig.Container) error {
 		DatabaseEngine     db.Engine `name:"databaseEngine"`
 		ProtocolParameters []iotago.ProtocolParameters
 		NetworkManager     network.Manager
+		NetworkTracer      *core.Tracer
+	}
+
+	if err := c.Provide(newNetworkTracer); err != nil {
+		Component.LogPanic(err.Error())
 	}
 
 	return c.Provide(func(deps protocolDeps) *protocol.Protocol {
@@ -132,6 +153,15 @@ func provide(c *dig.Container) error {
 			Component.LogPanicf("%s has to be specified if %s is enabled", Component.App().Config().GetParameterPath(&(ParamsDatabase.Pruning.Size.TargetSize)), Component.App().Config().GetParameterPath(&(ParamsDatabase.Pruning.Size.Enabled)))
 		}
 
+		// the protocol loads the snapshot while it is provided, which is before the daemon and its background workers are started.
+		// That's why the download is canceled by the shutdown signals directly.
+		snapshotCtx, cancelSnapshotCtx := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		err = prepareSnapshot(snapshotCtx)
+		cancelSnapshotCtx()
+		if err != nil {
+			Component.LogPanicf("failed to prepare snapshot: %s", err)
+		}
+
 		return protocol.New(
 			Component.Logger,
 			workerpool.NewGroup("Protocol"),
@@ -139,6 +169,7 @@ func provide(c *dig.Container) error {
 			protocol.WithBaseDirectory(ParamsDatabase.Path),
 			protocol.WithStorageOptions(
 				storage.WithDBEngine(deps.DatabaseEngine),
+				storage.WithIndexer(indexer.ParamsIndexer.Enabled),
 				storage.WithPruningDelay(iotago.EpochIndex(ParamsDatabase.Pruning.Threshold)),
 				storage.WithPruningSizeEnable(ParamsDatabase.Pruning.Size.Enabled),
 				storage.WithPruningSizeMaxTargetSizeBytes(pruningTargetDatabaseSizeBytes),
@@ -148,9 +179,17 @@ func provide(c *dig.Container) error {
 					prunable.WithMaxOpenDBs(ParamsDatabase.MaxOpenDBs),
 				),
 			),
+			protocol.WithStorageOptions(archiveStorageOptions(deps.DatabaseEngine)...),
 			protocol.WithSnapshotPath(ParamsProtocol.Snapshot.Path),
 			protocol.WithCommitmentCheck(ParamsProtocol.CommitmentCheck),
 			protocol.WithMaxAllowedWallClockDrift(ParamsProtocol.Filter.MaxAllowedClockDrift),
+			protocol.WithNetworkProtocolOptions(
+				core.WithTracer(deps.NetworkTracer),
+			),
+			protocol.WithBlockRequesterOptions(
+				requester.WithRequestTimeout[iotago.SlotIndex, iotago.BlockID](ParamsProtocol.BlockRequester.RequestTimeout),
+				requester.WithMaxHints[iotago.SlotIndex, iotago.BlockID](ParamsProtocol.BlockRequester.MaxHints),
+			),
 			protocol.WithPreSolidFilterProvider(
 				presolidblockfilter.NewProvider(),
 			),
@@ -175,6 +214,46 @@ func provide(c *dig.Container) error {
 	})
 }
 
+// newNetworkTracer creates the tracer of the packets that are received from and sent to neighbors if tracing is enabled.
+func newNetworkTracer() *core.Tracer {
+	if !ParamsProtocol.NetworkTracing.Enabled {
+		return nil
+	}
+
+	maxFileSize, err := bytes.Parse(ParamsProtocol.NetworkTracing.MaxFileSize)
+	if err != nil {
+		Component.LogPanicf("parameter %s invalid", Component.App().Config().GetParameterPath(&(ParamsProtocol.NetworkTracing.MaxFileSize)))
+	}
+
+	networkTracer, err = core.NewTracer(
+		core.WithRingSize(ParamsProtocol.NetworkTracing.RingSize),
+		core.WithTraceFile(ParamsProtocol.NetworkTracing.FilePath),
+		core.WithMaxFileSize(maxFileSize),
+		core.WithMaxFiles(ParamsProtocol.NetworkTracing.MaxFiles),
+	)
+	if err != nil {
+		Component.LogPanicf("failed to create network tracer: %s", err)
+	}
+
+	Component.LogWarn("Network tracing is enabled, this affects the performance of the node")
+
+	return networkTracer
+}
+
+// archiveStorageOptions returns the storage options that move pruned epochs to the archive if the node is an archive node.
+func archiveStorageOptions(dbEngine db.Engine) []options.Option[storage.Storage] {
+	if !ParamsDatabase.Archive.Enabled {
+		return nil
+	}
+
+	Component.LogInfof("running as archive node, pruned epochs are moved to the archive in %s", ParamsDatabase.Archive.Path)
+	archive = storage.NewArchive(dbEngine, ParamsDatabase.Archive.Path, protocol.DatabaseVersion)
+
+	return []options.Option[storage.Storage]{
+		storage.WithArchive(archive),
+	}
+}
+
 func configure() error {
 	deps.Protocol.Network.OnBlockReceived(func(block *model.Block, _ peer.ID) {
 		Component.LogTracef("BlockReceived: %s", block.ID())
@@ -187,10 +266,16 @@ func configure() error {
 		Component.LogDebugf("SlotCommitmentReceived: %s", commitment.ID())
 	})
 
+	configureSnapshotScheduler()
+
 	return nil
 }
 
 func run() error {
+	if err := runSnapshotScheduler(); err != nil {
+		return err
+	}
+
 	return Component.Daemon().BackgroundWorker(Component.Name, func(ctx context.Context) {
 		if err := deps.Protocol.Run(ctx); err != nil {
 			if !ierrors.Is(err, context.Canceled) {
@@ -201,6 +286,16 @@ func run() error {
 		//nolint:contextcheck // context might be canceled
 		resetProtocolParameters()
 
+		if archive != nil {
+			archive.Shutdown()
+		}
+
+		if networkTracer != nil {
+			if err := networkTracer.Close(); err != nil {
+				Component.LogWarnf("failed to close the network trace file: %s", err)
+			}
+		}
+
 		Component.LogInfo("Gracefully shutting down the Protocol...")
 	}, daemon.PriorityProtocol)
 }
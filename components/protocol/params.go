@@ -14,6 +14,24 @@ type ParametersProtocol struct {
 		Path string `default:"testnet/snapshot.bin" usage:"the path of the snapshot file"`
 		// Depth defines how many slot diffs are stored in the snapshot, starting from the full ledgerstate.
 		Depth int `default:"5" usage:"defines how many slot diffs are stored in the snapshot, starting from the full ledgerstate"`
+		// DownloadURLs are the HTTP(S) URLs the snapshot file is downloaded from if it does not exist.
+		DownloadURLs []string `default:"" usage:"the HTTP(S) URLs the snapshot file is downloaded from if it does not exist (tried in order)"`
+		// ExpectedSHA256 is the expected SHA-256 hash of the snapshot file.
+		ExpectedSHA256 string `default:"" usage:"the expected hex encoded SHA-256 hash of the snapshot file (this or the expected commitment ID is required if download URLs are set)"`
+		// ExpectedCommitmentID is the expected target commitment ID of the snapshot file.
+		ExpectedCommitmentID string `default:"" usage:"the expected target commitment ID of the snapshot file (this or the expected SHA-256 hash is required if download URLs are set)"`
+
+		// Scheduler contains the configuration parameters for the automatic creation of snapshots.
+		Scheduler struct {
+			// Enabled defines whether snapshots are created automatically.
+			Enabled bool `default:"false" usage:"whether snapshots are created automatically"`
+			// Interval defines the number of finalized epochs between two automatic snapshots.
+			Interval uint64 `default:"1" usage:"the number of finalized epochs between two automatic snapshots"`
+			// Retention defines how many automatic snapshots are kept.
+			Retention int `default:"3" usage:"the number of automatic snapshots to keep (0 keeps all)"`
+			// Directory is the directory the automatic snapshots are written to.
+			Directory string `default:"testnet/snapshots" usage:"the directory the automatic snapshots are written to"`
+		}
 	}
 
 	CommitmentCheck bool `default:"true" usage:"specifies whether commitment and ledger checks should be enabled"`
@@ -23,6 +41,26 @@ type ParametersProtocol struct {
 		MaxAllowedClockDrift time.Duration `default:"5s" usage:"the maximum drift our wall clock can have to future blocks being received from the network"`
 	}
 
+	BlockRequester struct {
+		// RequestTimeout defines the time after which a missing block is requested from the next peer.
+		RequestTimeout time.Duration `default:"2s" usage:"the time after which a missing block is requested from the next peer that likely knows it"`
+		// MaxHints defines the number of peers that are remembered to likely know a missing block.
+		MaxHints int `default:"3" usage:"the number of peers that are remembered to likely know a missing block, before it is requested from all peers"`
+	}
+
+	NetworkTracing struct {
+		// Enabled defines whether the packets received from and sent to neighbors are traced.
+		Enabled bool `default:"false" usage:"whether the packets received from and sent to neighbors are traced"`
+		// RingSize defines the number of most recent packet traces that are kept in memory.
+		RingSize int `default:"10000" usage:"the number of most recent packet traces that are kept in memory and exposed via the debug API"`
+		// FilePath defines the file all packet traces are written to.
+		FilePath string `default:"" usage:"the file all packet traces are written to (optional)"`
+		// MaxFileSize defines the size at which the trace file is rotated.
+		MaxFileSize string `default:"100MB" usage:"the size at which the trace file is rotated"`
+		// MaxFiles defines the number of trace files that are kept.
+		MaxFiles int `default:"5" usage:"the number of trace files that are kept, including the current one"`
+	}
+
 	ProtocolParametersPath string `default:"testnet/protocol_parameters.json" usage:"the path of the protocol parameters file"`
 
 	BaseToken BaseToken
@@ -60,6 +98,13 @@ type ParametersDatabase struct {
 			CooldownTime time.Duration `default:"5m" usage:"cooldown time between two pruning by database size events"`
 		}
 	}
+
+	Archive struct {
+		// Enabled defines whether the data of pruned epochs is moved to the archive instead of being deleted.
+		Enabled bool `default:"false" usage:"whether the data of pruned epochs is moved to the archive instead of being deleted"`
+		// Path defines the path to the archive database folder.
+		Path string `default:"testnet/archive" usage:"the path to the archive database folder"`
+	}
 }
 
 // ParametersRetainer contains the definition of configuration parameters used by the Retainer.
@@ -0,0 +1,119 @@
+package protocol
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/runtime/ioutils"
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/iota-core/pkg/protocol"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine"
+	"github.com/iotaledger/iota-core/pkg/storage/snapshot"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// prepareSnapshot makes sure that a verified snapshot file exists before the database is initialized from it.
+// If the snapshot file does not exist yet, it is downloaded from the configured sources.
+// The download is canceled if the given context is done.
+func prepareSnapshot(ctx context.Context) error {
+	// the snapshot is only imported if the database was never initialized.
+	if _, err := protocol.MainEngineDirectory(ParamsDatabase.Path); err == nil {
+		return nil
+	}
+
+	downloaderOpts, err := snapshotDownloaderOptions()
+	if err != nil {
+		return err
+	}
+	downloader := snapshot.NewDownloader(Component.Logger, downloaderOpts...)
+
+	// a downloaded snapshot is never imported without verification.
+	if len(ParamsProtocol.Snapshot.DownloadURLs) > 0 && !downloader.HasVerification() {
+		return ierrors.Errorf("parameter %s or %s has to be specified if %s is set",
+			Component.App().Config().GetParameterPath(&(ParamsProtocol.Snapshot.ExpectedSHA256)),
+			Component.App().Config().GetParameterPath(&(ParamsProtocol.Snapshot.ExpectedCommitmentID)),
+			Component.App().Config().GetParameterPath(&(ParamsProtocol.Snapshot.DownloadURLs)),
+		)
+	}
+
+	snapshotPath := ParamsProtocol.Snapshot.Path
+
+	exists, _, err := ioutils.PathExists(snapshotPath)
+	if err != nil {
+		return ierrors.Wrapf(err, "unable to check snapshot file (%s)", snapshotPath)
+	}
+
+	if exists {
+		if len(downloaderOpts) == 0 {
+			return nil
+		}
+
+		Component.LogInfof("Verifying snapshot file (%s) ...", snapshotPath)
+
+		return downloader.Verify(snapshotPath)
+	}
+
+	if len(ParamsProtocol.Snapshot.DownloadURLs) == 0 {
+		return nil
+	}
+
+	if err = os.MkdirAll(filepath.Dir(snapshotPath), 0700); err != nil {
+		return ierrors.Wrapf(err, "unable to create snapshot directory (%s)", filepath.Dir(snapshotPath))
+	}
+
+	Component.LogInfof("Downloading snapshot file (%s) from %d source(s) ...", snapshotPath, len(ParamsProtocol.Snapshot.DownloadURLs))
+
+	if err = downloader.Download(ctx, snapshotPath, ParamsProtocol.Snapshot.DownloadURLs...); err != nil {
+		return err
+	}
+
+	Component.LogInfof("Downloading snapshot file (%s) ... done", snapshotPath)
+
+	return nil
+}
+
+// snapshotDownloaderOptions returns the verifications that are configured for the snapshot file.
+func snapshotDownloaderOptions() ([]options.Option[snapshot.Downloader], error) {
+	var opts []options.Option[snapshot.Downloader]
+
+	if expectedHash := ParamsProtocol.Snapshot.ExpectedSHA256; expectedHash != "" {
+		hash, err := hex.DecodeString(strings.TrimPrefix(expectedHash, "0x"))
+		if err != nil || len(hash) != sha256.Size {
+			return nil, ierrors.Errorf("parameter %s is not a valid SHA-256 hash", Component.App().Config().GetParameterPath(&(ParamsProtocol.Snapshot.ExpectedSHA256)))
+		}
+
+		opts = append(opts, snapshot.WithExpectedSHA256(hash))
+	}
+
+	if expectedCommitmentID := ParamsProtocol.Snapshot.ExpectedCommitmentID; expectedCommitmentID != "" {
+		commitmentID, err := iotago.CommitmentIDFromHexString(expectedCommitmentID)
+		if err != nil {
+			return nil, ierrors.Wrapf(err, "parameter %s is not a valid commitment ID", Component.App().Config().GetParameterPath(&(ParamsProtocol.Snapshot.ExpectedCommitmentID)))
+		}
+
+		opts = append(opts, snapshot.WithVerifyFunc(func(filePath string) error {
+			commitment, err := engine.ReadSnapshotCommitment(filePath)
+			if err != nil {
+				return err
+			}
+
+			snapshotCommitmentID, err := commitment.ID()
+			if err != nil {
+				return ierrors.Wrap(err, "failed to compute target commitment ID")
+			}
+
+			if snapshotCommitmentID != commitmentID {
+				return ierrors.Errorf("target commitment mismatch: expected %s, got %s", commitmentID, snapshotCommitmentID)
+			}
+
+			return nil
+		}))
+	}
+
+	return opts, nil
+}
@@ -0,0 +1,110 @@
+package protocol
+
+import (
+	"context"
+	"os"
+
+	"github.com/iotaledger/hive.go/runtime/event"
+	"github.com/iotaledger/hive.go/runtime/workerpool"
+	"github.com/iotaledger/iota-core/pkg/daemon"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+var (
+	snapshotSchedulerWorkerPool *workerpool.WorkerPool
+
+	// lastScheduledSnapshotSlot is the target slot of the latest automatic snapshot.
+	// It is only accessed by the single worker of the snapshot scheduler.
+	lastScheduledSnapshotSlot    iotago.SlotIndex
+	hasLastScheduledSnapshotSlot bool
+)
+
+// configureSnapshotScheduler hooks the snapshot scheduler to the finalization of slots if it is enabled.
+func configureSnapshotScheduler() {
+	if !ParamsProtocol.Snapshot.Scheduler.Enabled {
+		return
+	}
+
+	if ParamsProtocol.Snapshot.Scheduler.Interval == 0 {
+		Component.LogPanicf("parameter %s has to be greater than 0", Component.App().Config().GetParameterPath(&(ParamsProtocol.Snapshot.Scheduler.Interval)))
+	}
+
+	if err := os.MkdirAll(deps.SnapshotDirectory.Path(), 0700); err != nil {
+		Component.LogPanicf("unable to create snapshot directory (%s): %s", deps.SnapshotDirectory.Path(), err)
+	}
+
+	latest, exists, err := deps.SnapshotDirectory.Latest()
+	if err != nil {
+		Component.LogPanicf("unable to read snapshot directory (%s): %s", deps.SnapshotDirectory.Path(), err)
+	}
+
+	if exists {
+		lastScheduledSnapshotSlot, hasLastScheduledSnapshotSlot = latest.Slot, true
+	}
+
+	// a single worker makes sure that only one snapshot is created at a time.
+	snapshotSchedulerWorkerPool = workerpool.New("SnapshotScheduler", workerpool.WithWorkerCount(1))
+
+	deps.Protocol.Events.Engine.SlotGadget.SlotFinalized.Hook(onSlotFinalizedSnapshotScheduler, event.WithWorkerPool(snapshotSchedulerWorkerPool))
+}
+
+// runSnapshotScheduler starts the worker of the snapshot scheduler if it is enabled.
+func runSnapshotScheduler() error {
+	if snapshotSchedulerWorkerPool == nil {
+		return nil
+	}
+
+	return Component.Daemon().BackgroundWorker("SnapshotScheduler", func(ctx context.Context) {
+		snapshotSchedulerWorkerPool.Start()
+		<-ctx.Done()
+
+		Component.LogInfo("Gracefully shutting down the snapshot scheduler...")
+		snapshotSchedulerWorkerPool.Shutdown()
+		snapshotSchedulerWorkerPool.ShutdownComplete.Wait()
+	}, daemon.PrioritySnapshotScheduler)
+}
+
+// onSlotFinalizedSnapshotScheduler creates a snapshot of the last slot of the previous epoch
+// once the configured amount of epochs was finalized since the latest automatic snapshot.
+func onSlotFinalizedSnapshotScheduler(slot iotago.SlotIndex) {
+	mainEngine := deps.Protocol.Engines.Main.Get()
+	if mainEngine == nil || !mainEngine.SyncManager.IsNodeSynced() {
+		return
+	}
+
+	timeProvider := mainEngine.CommittedAPI().TimeProvider()
+
+	finalizedEpoch := timeProvider.EpochFromSlot(slot)
+	if finalizedEpoch == 0 {
+		return
+	}
+
+	// snapshots are always created for the last slot of the previous epoch.
+	if targetEpoch := finalizedEpoch - 1; hasLastScheduledSnapshotSlot && uint64(targetEpoch) < uint64(timeProvider.EpochFromSlot(lastScheduledSnapshotSlot))+ParamsProtocol.Snapshot.Scheduler.Interval {
+		return
+	}
+
+	// we try again with the next finalized slot.
+	if mainEngine.IsSnapshotting() || mainEngine.Storage.IsPruning() {
+		return
+	}
+
+	targetSlot, filePath, err := mainEngine.ExportSnapshot(deps.SnapshotDirectory.BaseFilePath(), true, true)
+	if err != nil {
+		Component.LogWarnf("automatic snapshot creation failed: %s", err)
+
+		return
+	}
+	lastScheduledSnapshotSlot, hasLastScheduledSnapshotSlot = targetSlot, true
+
+	Component.LogInfof("created automatic snapshot for slot %d (%s)", targetSlot, filePath)
+
+	removed, err := deps.SnapshotDirectory.Update(ParamsProtocol.Snapshot.Scheduler.Retention)
+	if err != nil {
+		Component.LogWarnf("failed to apply snapshot retention: %s", err)
+	}
+
+	for _, removedFilePath := range removed {
+		Component.LogInfof("removed old automatic snapshot %s", removedFilePath)
+	}
+}
@@ -60,10 +60,26 @@ func apiMiddleware() echo.MiddlewareFunc {
 		Component.LogPanicf("JWT auth initialization failed: %w", err)
 	}
 
+	scopeRules, err := restapi.NewScopeRules(routeScopes...)
+	if err != nil {
+		Component.LogFatal(err.Error())
+	}
+
+	// allowedByScopes checks whether the scopes of the JWT grant access to the route.
+	allowedByScopes := func(c echo.Context, claims *jwt.AuthClaims) bool {
+		scopes, exists := scopeRules.Scopes(c.Request().Method, strings.ToLower(c.Request().URL.Path))
+		if !exists {
+			// routes without scopes can only be called with a JWT that grants access to everything
+			return len(claims.Scopes) == 0
+		}
+
+		return len(scopes) == 0 || claims.HasAnyScope(scopes...)
+	}
+
 	jwtAllow := func(c echo.Context, subject string, claims *jwt.AuthClaims) bool {
-		// Allow all JWT created for the API if the endpoints are exposed
+		// Allow all JWT created for the API if the endpoints are exposed and the JWT has the needed scopes
 		if matchExposed(c) {
-			return claims.VerifySubject(subject)
+			return claims.VerifySubject(subject) && allowedByScopes(c, claims)
 		}
 
 		return false
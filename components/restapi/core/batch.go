@@ -0,0 +1,106 @@
+package core
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+	"github.com/iotaledger/iota-core/components/restapi"
+	"github.com/iotaledger/iota-core/pkg/requesthandler"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func blocksBatch(c echo.Context) (*BatchResponse, error) {
+	return batchQuery(c, deps.RequestHandler.CommittedAPI(), iotago.BlockIDFromHexString, deps.RequestHandler.BlocksFromBlockIDs)
+}
+
+func blockMetadataBatch(c echo.Context) (*BatchResponse, error) {
+	return batchQuery(c, deps.RequestHandler.CommittedAPI(), iotago.BlockIDFromHexString, deps.RequestHandler.BlockMetadataFromBlockIDs)
+}
+
+func outputsBatch(c echo.Context) (*BatchResponse, error) {
+	return batchQuery(c, deps.RequestHandler.CommittedAPI(), iotago.OutputIDFromHexString, deps.RequestHandler.OutputsFromOutputIDs)
+}
+
+func outputMetadataBatch(c echo.Context) (*BatchResponse, error) {
+	return batchQuery(c, deps.RequestHandler.CommittedAPI(), iotago.OutputIDFromHexString, deps.RequestHandler.OutputMetadataFromOutputIDs)
+}
+
+func transactionMetadataBatch(c echo.Context) (*BatchResponse, error) {
+	return batchQuery(c, deps.RequestHandler.CommittedAPI(), iotago.TransactionIDFromHexString, deps.RequestHandler.TransactionMetadataFromTransactionIDs)
+}
+
+// batchQuery parses the IDs of the request, looks them up and encodes the result or the error of every item with the
+// given API. The IDs are validated up front, so that a malformed request fails as a whole.
+func batchQuery[ID any, T any](c echo.Context, apiForEncoding iotago.API, parseID func(string) (ID, error), lookup func([]ID) []*requesthandler.BatchResult[T]) (*BatchResponse, error) {
+	request := &BatchRequest{}
+	if err := c.Bind(request); err != nil {
+		return nil, ierrors.WithMessagef(httpserver.ErrInvalidParameter, "invalid batch request: %s", err)
+	}
+
+	if len(request.IDs) == 0 {
+		return nil, ierrors.WithMessage(httpserver.ErrInvalidParameter, "no IDs given")
+	}
+
+	if maxResults := restapi.ParamsRestAPI.Limits.MaxResults; len(request.IDs) > maxResults {
+		return nil, ierrors.WithMessagef(httpserver.ErrInvalidParameter, "too many IDs given: %d, the limit is %d", len(request.IDs), maxResults)
+	}
+
+	ids := make([]ID, len(request.IDs))
+	for i, idHex := range request.IDs {
+		id, err := parseID(idHex)
+		if err != nil {
+			return nil, ierrors.WithMessagef(httpserver.ErrInvalidParameter, "invalid ID %s: %s", idHex, err)
+		}
+		ids[i] = id
+	}
+
+	results := lookup(ids)
+	response := &BatchResponse{
+		Items: make([]*BatchResponseItem, len(results)),
+	}
+
+	for i, result := range results {
+		item := &BatchResponseItem{
+			ID: request.IDs[i],
+		}
+		response.Items[i] = item
+
+		if result.Err != nil {
+			item.Error = newBatchResponseError(result.Err)
+
+			continue
+		}
+
+		encodedResult, err := apiForEncoding.JSONEncode(result.Result)
+		if err != nil {
+			item.Error = newBatchResponseError(ierrors.WithMessagef(echo.ErrInternalServerError, "failed to encode result: %s", err))
+
+			continue
+		}
+		item.Result = encodedResult
+	}
+
+	return response, nil
+}
+
+// newBatchResponseError uses the status code of the HTTP error the request handler returned (if any).
+func newBatchResponseError(err error) *BatchResponseError {
+	code := http.StatusInternalServerError
+	message := err.Error()
+
+	var httpErr *echo.HTTPError
+	if ierrors.As(err, &httpErr) {
+		code = httpErr.Code
+		// the code is already part of the response, so it is removed from the message.
+		message = strings.TrimPrefix(message, httpErr.Error()+": ")
+	}
+
+	return &BatchResponseError{
+		Code:    code,
+		Message: message,
+	}
+}
@@ -0,0 +1,37 @@
+package core
+
+import (
+	"encoding/json"
+)
+
+type (
+	// BatchRequest defines the request of the batch query REST API calls.
+	BatchRequest struct {
+		// IDs are the hex encoded IDs of the requested items.
+		IDs []string `json:"ids"`
+	}
+
+	// BatchResponse defines the response of the batch query REST API calls.
+	BatchResponse struct {
+		// Items are the results of the requested items, in the order of the requested IDs.
+		Items []*BatchResponseItem `json:"items"`
+	}
+
+	// BatchResponseItem is the result of a single item of a batch query.
+	BatchResponseItem struct {
+		// ID is the hex encoded ID of the item.
+		ID string `json:"id"`
+		// Result is the item, encoded in the same way as by the single item endpoint (empty if the lookup failed).
+		Result json.RawMessage `json:"result,omitempty"`
+		// Error is the reason the lookup of the item failed.
+		Error *BatchResponseError `json:"error,omitempty"`
+	}
+
+	// BatchResponseError describes why the lookup of a single item of a batch query failed.
+	BatchResponseError struct {
+		// Code is the HTTP status code the single item endpoint would have returned.
+		Code int `json:"code"`
+		// Message is the error message.
+		Message string `json:"message"`
+	}
+)
@@ -0,0 +1,129 @@
+package core
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+	"github.com/iotaledger/iota-core/components/restapi"
+	"github.com/iotaledger/iota-core/pkg/requesthandler"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/api"
+	"github.com/iotaledger/iota.go/v4/tpkg"
+)
+
+// newBatchContext returns an echo.Context of a batch request for the given IDs.
+func newBatchContext(t *testing.T, ids []string) echo.Context {
+	body, err := json.Marshal(&BatchRequest{IDs: ids})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(string(body)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+	return echo.New().NewContext(req, httptest.NewRecorder())
+}
+
+// setMaxResults sets the maximum number of results of the REST API for the duration of the test.
+func setMaxResults(t *testing.T, maxResults int) {
+	previousMaxResults := restapi.ParamsRestAPI.Limits.MaxResults
+	t.Cleanup(func() {
+		restapi.ParamsRestAPI.Limits.MaxResults = previousMaxResults
+	})
+
+	restapi.ParamsRestAPI.Limits.MaxResults = maxResults
+}
+
+// blockMetadataLookup returns the metadata of the given known blocks and a not-found error for all other blocks.
+func blockMetadataLookup(knownBlockIDs ...iotago.BlockID) func([]iotago.BlockID) []*requesthandler.BatchResult[*api.BlockMetadataResponse] {
+	return func(blockIDs []iotago.BlockID) []*requesthandler.BatchResult[*api.BlockMetadataResponse] {
+		return lo.Map(blockIDs, func(blockID iotago.BlockID) *requesthandler.BatchResult[*api.BlockMetadataResponse] {
+			for _, knownBlockID := range knownBlockIDs {
+				if blockID == knownBlockID {
+					return &requesthandler.BatchResult[*api.BlockMetadataResponse]{
+						Result: &api.BlockMetadataResponse{
+							BlockID:    blockID,
+							BlockState: api.BlockStateAccepted,
+						},
+					}
+				}
+			}
+
+			return &requesthandler.BatchResult[*api.BlockMetadataResponse]{
+				Err: ierrors.WithMessagef(echo.ErrNotFound, "block %s not found", blockID),
+			}
+		})
+	}
+}
+
+func TestBatchQuery_MaxResults(t *testing.T) {
+	setMaxResults(t, 2)
+
+	ids := []string{
+		tpkg.RandBlockID().ToHex(),
+		tpkg.RandBlockID().ToHex(),
+		tpkg.RandBlockID().ToHex(),
+	}
+
+	lookupCalled := false
+	_, err := batchQuery(newBatchContext(t, ids), tpkg.ZeroCostTestAPI, iotago.BlockIDFromHexString, func(blockIDs []iotago.BlockID) []*requesthandler.BatchResult[*api.BlockMetadataResponse] {
+		lookupCalled = true
+
+		return blockMetadataLookup()(blockIDs)
+	})
+	require.ErrorIs(t, err, httpserver.ErrInvalidParameter)
+	require.False(t, lookupCalled)
+
+	// the limit itself is allowed.
+	response, err := batchQuery(newBatchContext(t, ids[:2]), tpkg.ZeroCostTestAPI, iotago.BlockIDFromHexString, blockMetadataLookup())
+	require.NoError(t, err)
+	require.Len(t, response.Items, 2)
+
+	// empty and malformed requests are rejected as a whole.
+	_, err = batchQuery(newBatchContext(t, nil), tpkg.ZeroCostTestAPI, iotago.BlockIDFromHexString, blockMetadataLookup())
+	require.ErrorIs(t, err, httpserver.ErrInvalidParameter)
+
+	_, err = batchQuery(newBatchContext(t, []string{ids[0], "0xinvalid"}), tpkg.ZeroCostTestAPI, iotago.BlockIDFromHexString, blockMetadataLookup())
+	require.ErrorIs(t, err, httpserver.ErrInvalidParameter)
+}
+
+func TestBatchQuery_ItemErrorsInRequestOrder(t *testing.T) {
+	setMaxResults(t, 10)
+
+	knownBlockID1 := tpkg.RandBlockID()
+	knownBlockID2 := tpkg.RandBlockID()
+	unknownBlockID := tpkg.RandBlockID()
+
+	ids := []string{knownBlockID2.ToHex(), unknownBlockID.ToHex(), knownBlockID1.ToHex()}
+
+	response, err := batchQuery(newBatchContext(t, ids), tpkg.ZeroCostTestAPI, iotago.BlockIDFromHexString, blockMetadataLookup(knownBlockID1, knownBlockID2))
+	require.NoError(t, err)
+	require.Len(t, response.Items, len(ids))
+
+	// the items are in the order of the requested IDs.
+	for i, item := range response.Items {
+		require.Equal(t, ids[i], item.ID)
+	}
+
+	// a missing item doesn't fail the other ones.
+	for _, i := range []int{0, 2} {
+		require.Nil(t, response.Items[i].Error)
+
+		metadata := &api.BlockMetadataResponse{}
+		require.NoError(t, tpkg.ZeroCostTestAPI.JSONDecode(response.Items[i].Result, metadata))
+		require.Equal(t, ids[i], metadata.BlockID.ToHex())
+		require.Equal(t, api.BlockStateAccepted, metadata.BlockState)
+	}
+
+	require.Nil(t, response.Items[1].Result)
+	require.NotNil(t, response.Items[1].Error)
+	require.Equal(t, http.StatusNotFound, response.Items[1].Error.Code)
+	require.Equal(t, "block "+unknownBlockID.String()+" not found", response.Items[1].Error.Message)
+}
@@ -11,16 +11,52 @@ import (
 	"github.com/iotaledger/inx-app/pkg/httpserver"
 	"github.com/iotaledger/iota-core/components/metricstracker"
 	"github.com/iotaledger/iota-core/components/protocol"
+	"github.com/iotaledger/iota-core/components/restapi"
+	protocolpkg "github.com/iotaledger/iota-core/pkg/protocol"
 	"github.com/iotaledger/iota-core/pkg/requesthandler"
 	restapipkg "github.com/iotaledger/iota-core/pkg/restapi"
 	"github.com/iotaledger/iota.go/v4/api"
 )
 
+const (
+	// RouteOutputProof is the route to get the proofs that anchor an unspent output to the latest commitment.
+	RouteOutputProof = "/outputs/:" + api.ParameterOutputID + "/proof"
+
+	// RouteLedgerAddressBySlot is the route to get the balance and the unspent outputs of an address after the given slot was committed.
+	// The output IDs are paginated by the "pageSize" and "cursor" query parameters, the balance is only part of the first page.
+	RouteLedgerAddressBySlot = "/ledger/by-slot/:" + api.ParameterSlot + "/addresses/:" + api.ParameterBech32Address
+
+	// RouteLedgerOutputBySlot is the route to check whether an output was unspent after the given slot was committed.
+	RouteLedgerOutputBySlot = "/ledger/by-slot/:" + api.ParameterSlot + "/outputs/:" + api.ParameterOutputID
+
+	// RouteEventsWebSocket is the route to stream the events of the subscribed topics via WebSocket.
+	RouteEventsWebSocket = "/events/ws"
+
+	// RouteEventsSSE is the route to stream the events of the topics given as query parameters as Server-Sent-Events.
+	RouteEventsSSE = "/events/sse"
+
+	// RouteBatchBlocks is the route to get several blocks by their IDs.
+	RouteBatchBlocks = "/batch/blocks"
+
+	// RouteBatchBlockMetadata is the route to get the metadata of several blocks by their IDs.
+	RouteBatchBlockMetadata = "/batch/blocks/metadata"
+
+	// RouteBatchOutputs is the route to get several outputs by their IDs.
+	RouteBatchOutputs = "/batch/outputs"
+
+	// RouteBatchOutputMetadata is the route to get the metadata of several outputs by their IDs.
+	RouteBatchOutputMetadata = "/batch/outputs/metadata"
+
+	// RouteBatchTransactionMetadata is the route to get the metadata of several transactions by their IDs.
+	RouteBatchTransactionMetadata = "/batch/transactions/metadata"
+)
+
 func init() {
 	Component = &app.Component{
 		Name:      "CoreAPIV3",
 		DepsFunc:  func(cDeps dependencies) { deps = cDeps },
 		Configure: configure,
+		Run:       run,
 	}
 }
 
@@ -33,6 +69,7 @@ type dependencies struct {
 	dig.In
 
 	AppInfo          *app.Info
+	Protocol         *protocolpkg.Protocol
 	RestRouteManager *restapipkg.RestRouteManager
 	RequestHandler   *requesthandler.RequestHandler
 	MetricsTracker   *metricstracker.MetricsTracker
@@ -223,6 +260,78 @@ func configure() error {
 		return responseByHeader(c, resp)
 	}, checkNodeSynced())
 
+	routeGroup.POST(RouteBatchBlocks, func(c echo.Context) error {
+		resp, err := blocksBatch(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	})
+
+	routeGroup.POST(RouteBatchBlockMetadata, func(c echo.Context) error {
+		resp, err := blockMetadataBatch(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	}, checkNodeSynced())
+
+	routeGroup.POST(RouteBatchOutputs, func(c echo.Context) error {
+		resp, err := outputsBatch(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	})
+
+	routeGroup.POST(RouteBatchOutputMetadata, func(c echo.Context) error {
+		resp, err := outputMetadataBatch(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	}, checkNodeSynced())
+
+	routeGroup.POST(RouteBatchTransactionMetadata, func(c echo.Context) error {
+		resp, err := transactionMetadataBatch(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	}, checkNodeSynced())
+
+	routeGroup.GET(RouteOutputProof, func(c echo.Context) error {
+		resp, err := outputProofFromOutputID(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	}, checkNodeSynced())
+
+	routeGroup.GET(RouteLedgerAddressBySlot, func(c echo.Context) error {
+		resp, err := addressStateAtSlot(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	}, checkNodeSynced())
+
+	routeGroup.GET(RouteLedgerOutputBySlot, func(c echo.Context) error {
+		resp, err := outputStateAtSlot(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	}, checkNodeSynced())
+
 	routeGroup.GET(api.EndpointWithEchoParameters(api.CoreEndpointTransaction), func(c echo.Context) error {
 		resp, err := transactionFromTransactionID(c)
 		if err != nil {
@@ -304,6 +413,22 @@ func configure() error {
 		return responseByHeader(c, resp)
 	}, checkNodeSynced())
 
+	if restapi.ParamsRestAPI.EventStream.Enabled {
+		configureEventStream(routeGroup)
+	}
+
+	return nil
+}
+
+func run() error {
+	if !restapi.ParamsRestAPI.EventStream.Enabled {
+		return nil
+	}
+
+	if err := runEventStream(); err != nil {
+		Component.LogPanicf("failed to start worker: %s", err)
+	}
+
 	return nil
 }
 
@@ -0,0 +1,475 @@
+package core
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/runtime/event"
+	"github.com/iotaledger/hive.go/runtime/workerpool"
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/mempool"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/notarization"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/utxoledger"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/api"
+	"github.com/iotaledger/iota.go/v4/hexutil"
+)
+
+const (
+	// ParameterAccountID is used to identify an account by its ID.
+	ParameterAccountID = "accountId"
+
+	// EventTopicBlocksIssuer is the topic of all incoming blocks of the given issuer.
+	EventTopicBlocksIssuer = "blocks/issuer/{" + ParameterAccountID + "}"
+)
+
+// eventTopics contains the topics that can be subscribed to via the event stream.
+var eventTopics = []string{
+	api.EventAPITopicCommitmentsLatest,
+	api.EventAPITopicCommitmentsFinalized,
+	api.EventAPITopicBlocks,
+	api.EventAPITopicBlocksValidation,
+	api.EventAPITopicBlocksBasic,
+	api.EventAPITopicBlocksBasicTaggedData,
+	api.EventAPITopicBlocksBasicTaggedDataTag,
+	api.EventAPITopicBlocksBasicTransaction,
+	api.EventAPITopicBlocksBasicTransactionTaggedData,
+	api.EventAPITopicBlocksBasicTransactionTaggedDataTag,
+	EventTopicBlocksIssuer,
+	api.EventAPITopicBlockMetadata,
+	api.EventAPITopicBlockMetadataAccepted,
+	api.EventAPITopicBlockMetadataConfirmed,
+	api.EventAPITopicTransactionsIncludedBlockMetadata,
+	api.EventAPITopicTransactionMetadata,
+	api.EventAPITopicOutputs,
+	api.EventAPITopicOutputsByUnlockConditionAndAddress,
+}
+
+// eventTopicParameters contains the functions that validate the parameters of the event topics and return them in
+// the form they are published with.
+var eventTopicParameters = map[string]func(value string) (string, error){
+	api.ParameterTag: func(value string) (string, error) {
+		tag, err := hexutil.DecodeHex(value)
+		if err != nil {
+			return "", err
+		}
+
+		return hexutil.EncodeHex(tag), nil
+	},
+	ParameterAccountID: func(value string) (string, error) {
+		accountID, err := iotago.AccountIDFromHexString(value)
+		if err != nil {
+			return "", err
+		}
+
+		return accountID.ToHex(), nil
+	},
+	api.ParameterBlockID: func(value string) (string, error) {
+		blockID, err := iotago.BlockIDFromHexString(value)
+		if err != nil {
+			return "", err
+		}
+
+		return blockID.ToHex(), nil
+	},
+	api.ParameterTransactionID: func(value string) (string, error) {
+		transactionID, err := iotago.TransactionIDFromHexString(value)
+		if err != nil {
+			return "", err
+		}
+
+		return transactionID.ToHex(), nil
+	},
+	api.ParameterOutputID: func(value string) (string, error) {
+		outputID, err := iotago.OutputIDFromHexString(value)
+		if err != nil {
+			return "", err
+		}
+
+		return outputID.ToHex(), nil
+	},
+	api.ParameterCondition: func(value string) (string, error) {
+		switch api.EventAPIUnlockCondition(value) {
+		case api.EventAPIUnlockConditionAny,
+			api.EventAPIUnlockConditionAddress,
+			api.EventAPIUnlockConditionStorageReturn,
+			api.EventAPIUnlockConditionExpiration,
+			api.EventAPIUnlockConditionStateController,
+			api.EventAPIUnlockConditionGovernor,
+			api.EventAPIUnlockConditionImmutableAccount:
+			return value, nil
+		default:
+			return "", ierrors.Errorf("unknown unlock condition %s", value)
+		}
+	},
+	api.ParameterAddress: func(value string) (string, error) {
+		hrp, address, err := iotago.ParseBech32(value)
+		if err != nil {
+			return "", err
+		}
+
+		if expectedHRP := deps.RequestHandler.CommittedAPI().ProtocolParameters().Bech32HRP(); hrp != expectedHRP {
+			return "", ierrors.Errorf("invalid bech32 address prefix %s, expected %s", hrp, expectedHRP)
+		}
+
+		return address.Bech32(hrp), nil
+	},
+}
+
+// parseEventTopic checks if the given topic can be subscribed to and returns it in the form it is published with.
+func parseEventTopic(topic string) (string, error) {
+	// topics without parameters take precedence (e.g. "block-metadata/accepted" is not a block ID).
+	if slices.Contains(eventTopics, topic) && !strings.Contains(topic, "{") {
+		return topic, nil
+	}
+
+	topicSegments := strings.Split(topic, "/")
+
+	for _, eventTopic := range eventTopics {
+		eventTopicSegments := strings.Split(eventTopic, "/")
+		if len(eventTopicSegments) != len(topicSegments) {
+			continue
+		}
+
+		parsedTopic, matches, err := matchEventTopic(eventTopicSegments, topicSegments)
+		if err != nil {
+			return "", ierrors.Wrapf(err, "invalid topic %s", topic)
+		}
+
+		if matches {
+			return parsedTopic, nil
+		}
+	}
+
+	return "", ierrors.Errorf("unknown topic %s", topic)
+}
+
+// matchEventTopic checks if the segments of a topic match the segments of one of the event topics.
+func matchEventTopic(eventTopicSegments []string, topicSegments []string) (parsedTopic string, matches bool, err error) {
+	parsedSegments := make([]string, len(topicSegments))
+
+	// the constant segments need to match before the parameters are parsed, so that we don't return errors for the
+	// parameters of topics that were not meant.
+	for i, eventTopicSegment := range eventTopicSegments {
+		if !isEventTopicParameter(eventTopicSegment) && eventTopicSegment != topicSegments[i] {
+			return "", false, nil
+		}
+	}
+
+	for i, eventTopicSegment := range eventTopicSegments {
+		if !isEventTopicParameter(eventTopicSegment) {
+			parsedSegments[i] = eventTopicSegment
+
+			continue
+		}
+
+		parameter := strings.Trim(eventTopicSegment, "{}")
+		if parsedSegments[i], err = eventTopicParameters[parameter](topicSegments[i]); err != nil {
+			return "", false, ierrors.Wrapf(err, "invalid %s", parameter)
+		}
+	}
+
+	return strings.Join(parsedSegments, "/"), true, nil
+}
+
+func isEventTopicParameter(segment string) bool {
+	return strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}")
+}
+
+// hookEvents publishes the events of the engine to the event stream and returns a function that removes the hooks.
+func hookEvents(workerPool *workerpool.WorkerPool) (unhook func()) {
+	engineEvents := deps.Protocol.Events.Engine
+
+	return lo.Batch(
+		engineEvents.Notarization.LatestCommitmentUpdated.Hook(func(commitment *model.Commitment) {
+			publishCommitment(api.EventAPITopicCommitmentsLatest, commitment)
+		}, event.WithWorkerPool(workerPool)).Unhook,
+
+		engineEvents.SlotGadget.SlotFinalized.Hook(func(slot iotago.SlotIndex) {
+			if !eventBroker.HasSubscribers(api.EventAPITopicCommitmentsFinalized) {
+				return
+			}
+
+			commitment, err := deps.RequestHandler.GetCommitmentBySlot(slot)
+			if err != nil {
+				Component.LogWarnf("failed to load finalized commitment for slot %d: %s", slot, err)
+
+				return
+			}
+
+			publishCommitment(api.EventAPITopicCommitmentsFinalized, commitment)
+		}, event.WithWorkerPool(workerPool)).Unhook,
+
+		engineEvents.BlockRetainer.BlockRetained.Hook(func(block *blocks.Block) {
+			publishBlock(block.ModelBlock())
+			publishBlockMetadata(block.ModelBlock(), api.BlockStatePending)
+		}, event.WithWorkerPool(workerPool)).Unhook,
+
+		engineEvents.BlockRetainer.BlockAccepted.Hook(func(block *blocks.Block) {
+			publishBlockMetadata(block.ModelBlock(), api.BlockStateAccepted, api.EventAPITopicBlockMetadataAccepted)
+		}, event.WithWorkerPool(workerPool)).Unhook,
+
+		engineEvents.BlockRetainer.BlockConfirmed.Hook(func(block *blocks.Block) {
+			publishBlockMetadata(block.ModelBlock(), api.BlockStateConfirmed, api.EventAPITopicBlockMetadataConfirmed)
+		}, event.WithWorkerPool(workerPool)).Unhook,
+
+		engineEvents.BlockRetainer.BlockDropped.Hook(func(block *blocks.Block) {
+			publishBlockMetadata(block.ModelBlock(), api.BlockStateDropped)
+		}, event.WithWorkerPool(workerPool)).Unhook,
+
+		engineEvents.TransactionRetainer.TransactionRetained.Hook(func(transactionID iotago.TransactionID) {
+			if !eventBroker.HasSubscribers(transactionMetadataTopic(transactionID)) {
+				return
+			}
+
+			transactionMetadata, err := deps.RequestHandler.TransactionMetadataFromTransactionID(transactionID)
+			if err != nil {
+				Component.LogWarnf("failed to load metadata of transaction %s: %s", transactionID.ToHex(), err)
+
+				return
+			}
+
+			publishTransactionMetadata(transactionMetadata)
+		}, event.WithWorkerPool(workerPool)).Unhook,
+
+		engineEvents.Booker.TransactionAccepted.Hook(func(transactionMetadata mempool.TransactionMetadata) {
+			slot := transactionMetadata.EarliestIncludedAttachment().Slot()
+
+			publishTransactionMetadata(&api.TransactionMetadataResponse{
+				TransactionID:          transactionMetadata.ID(),
+				TransactionState:       api.TransactionStateAccepted,
+				EarliestAttachmentSlot: slot,
+			})
+
+			publishAcceptedOutputs(transactionMetadata, slot)
+		}, event.WithWorkerPool(workerPool)).Unhook,
+
+		engineEvents.Booker.TransactionInvalid.Hook(func(transactionMetadata mempool.TransactionMetadata, err error) {
+			publishTransactionMetadata(&api.TransactionMetadataResponse{
+				TransactionID:            transactionMetadata.ID(),
+				TransactionState:         api.TransactionStateFailed,
+				EarliestAttachmentSlot:   transactionMetadata.EarliestIncludedAttachment().Slot(),
+				TransactionFailureReason: api.DetermineTransactionFailureReason(err),
+			})
+		}, event.WithWorkerPool(workerPool)).Unhook,
+
+		engineEvents.Notarization.SlotCommitted.Hook(func(details *notarization.SlotCommittedDetails) {
+			// accepted transactions are committed in the slot of their earliest included attachment.
+			for _, transaction := range details.Mutations {
+				transactionID, err := transaction.ID()
+				if err != nil {
+					Component.LogWarnf("failed to compute ID of committed transaction: %s", err)
+
+					continue
+				}
+
+				publishTransactionMetadata(&api.TransactionMetadataResponse{
+					TransactionID:          transactionID,
+					TransactionState:       api.TransactionStateCommitted,
+					EarliestAttachmentSlot: details.Commitment.Slot(),
+				})
+			}
+
+			publishCommittedOutputs(details)
+		}, event.WithWorkerPool(workerPool)).Unhook,
+	)
+}
+
+func publishCommitment(topic string, commitment *model.Commitment) {
+	if err := eventBroker.Publish(func() ([]byte, error) {
+		return deps.RequestHandler.APIProvider().APIForSlot(commitment.Slot()).JSONEncode(commitment.Commitment())
+	}, topic); err != nil {
+		Component.LogWarnf("failed to publish commitment %s: %s", commitment.ID(), err)
+	}
+}
+
+func publishBlock(block *model.Block) {
+	topics := []string{
+		api.EventAPITopicBlocks,
+		api.EndpointWithNamedParameterValue(EventTopicBlocksIssuer, ParameterAccountID, block.ProtocolBlock().Header.IssuerID.ToHex()),
+	}
+
+	if _, isValidationBlock := block.ValidationBlock(); isValidationBlock {
+		topics = append(topics, api.EventAPITopicBlocksValidation)
+	}
+
+	if basicBlock, isBasicBlock := block.BasicBlock(); isBasicBlock {
+		topics = append(topics, api.EventAPITopicBlocksBasic)
+
+		switch payload := basicBlock.Payload.(type) {
+		case *iotago.TaggedData:
+			topics = append(topics,
+				api.EventAPITopicBlocksBasicTaggedData,
+				api.EndpointWithNamedParameterValue(api.EventAPITopicBlocksBasicTaggedDataTag, api.ParameterTag, hexutil.EncodeHex(payload.Tag)),
+			)
+
+		case *iotago.SignedTransaction:
+			topics = append(topics, api.EventAPITopicBlocksBasicTransaction)
+
+			if taggedData, isTaggedData := payload.Transaction.Payload.(*iotago.TaggedData); isTaggedData {
+				topics = append(topics,
+					api.EventAPITopicBlocksBasicTransactionTaggedData,
+					api.EndpointWithNamedParameterValue(api.EventAPITopicBlocksBasicTransactionTaggedDataTag, api.ParameterTag, hexutil.EncodeHex(taggedData.Tag)),
+				)
+			}
+		}
+	}
+
+	if err := eventBroker.Publish(func() ([]byte, error) {
+		return block.ProtocolBlock().API.JSONEncode(block.ProtocolBlock())
+	}, topics...); err != nil {
+		Component.LogWarnf("failed to publish block %s: %s", block.ID(), err)
+	}
+}
+
+func publishBlockMetadata(block *model.Block, blockState api.BlockState, additionalTopics ...string) {
+	topics := append([]string{
+		api.EndpointWithNamedParameterValue(api.EventAPITopicBlockMetadata, api.ParameterBlockID, block.ID().ToHex()),
+	}, additionalTopics...)
+
+	if signedTransaction, isTransaction := block.SignedTransaction(); isTransaction {
+		if transactionID, err := signedTransaction.Transaction.ID(); err == nil {
+			topics = append(topics, api.EndpointWithNamedParameterValue(api.EventAPITopicTransactionsIncludedBlockMetadata, api.ParameterTransactionID, transactionID.ToHex()))
+		}
+	}
+
+	if err := eventBroker.Publish(func() ([]byte, error) {
+		return deps.RequestHandler.CommittedAPI().JSONEncode(&api.BlockMetadataResponse{
+			BlockID:    block.ID(),
+			BlockState: blockState,
+		})
+	}, topics...); err != nil {
+		Component.LogWarnf("failed to publish metadata of block %s: %s", block.ID(), err)
+	}
+}
+
+func transactionMetadataTopic(transactionID iotago.TransactionID) string {
+	return api.EndpointWithNamedParameterValue(api.EventAPITopicTransactionMetadata, api.ParameterTransactionID, transactionID.ToHex())
+}
+
+func publishTransactionMetadata(transactionMetadata *api.TransactionMetadataResponse) {
+	if err := eventBroker.Publish(func() ([]byte, error) {
+		return deps.RequestHandler.CommittedAPI().JSONEncode(transactionMetadata)
+	}, transactionMetadataTopic(transactionMetadata.TransactionID)); err != nil {
+		Component.LogWarnf("failed to publish metadata of transaction %s: %s", transactionMetadata.TransactionID.ToHex(), err)
+	}
+}
+
+// publishAcceptedOutputs publishes the outputs that were created and consumed by an accepted transaction.
+func publishAcceptedOutputs(transactionMetadata mempool.TransactionMetadata, slot iotago.SlotIndex) {
+	latestCommitmentID := deps.RequestHandler.GetLatestCommitment().ID()
+
+	_ = transactionMetadata.Inputs().ForEach(func(stateMetadata mempool.StateMetadata) error {
+		// only outputs are published (inputs could also be commitments, block issuance credits, rewards, etc.)
+		if spentOutput, isOutput := stateMetadata.State().(*utxoledger.Output); isOutput {
+			outputMetadata := newEventOutputMetadata(spentOutput, spentOutput.SlotBooked(), latestCommitmentID)
+			outputMetadata.Spent = &api.OutputConsumptionMetadata{
+				Slot:          slot,
+				TransactionID: transactionMetadata.ID(),
+			}
+
+			publishOutput(spentOutput, outputMetadata)
+		}
+
+		return nil
+	})
+
+	_ = transactionMetadata.Outputs().ForEach(func(stateMetadata mempool.StateMetadata) error {
+		if output, isOutput := stateMetadata.State().(*utxoledger.Output); isOutput {
+			// the slot of the accepted transaction is used here, because the "SlotBooked" of the output is not set yet.
+			publishOutput(output, newEventOutputMetadata(output, slot, latestCommitmentID))
+		}
+
+		return nil
+	})
+}
+
+// publishCommittedOutputs publishes the outputs that were created and consumed in a committed slot.
+func publishCommittedOutputs(details *notarization.SlotCommittedDetails) {
+	commitmentID := details.Commitment.ID()
+
+	for _, spent := range details.OutputsConsumed {
+		outputMetadata := newEventOutputMetadata(spent.Output(), spent.Output().SlotBooked(), commitmentID)
+		outputMetadata.Spent = &api.OutputConsumptionMetadata{
+			Slot:          spent.SlotSpent(),
+			TransactionID: spent.TransactionIDSpent(),
+			CommitmentID:  commitmentID,
+		}
+
+		if outputMetadata.Included.Slot == details.Commitment.Slot() {
+			outputMetadata.Included.CommitmentID = commitmentID
+		} else if includedCommitment, err := deps.RequestHandler.GetCommitmentBySlot(outputMetadata.Included.Slot); err == nil {
+			outputMetadata.Included.CommitmentID = includedCommitment.ID()
+		}
+
+		publishOutput(spent.Output(), outputMetadata)
+	}
+
+	for _, output := range details.OutputsCreated {
+		outputMetadata := newEventOutputMetadata(output, details.Commitment.Slot(), commitmentID)
+		outputMetadata.Included.CommitmentID = commitmentID
+
+		publishOutput(output, outputMetadata)
+	}
+}
+
+func newEventOutputMetadata(output *utxoledger.Output, includedSlot iotago.SlotIndex, latestCommitmentID iotago.CommitmentID) *api.OutputMetadata {
+	return &api.OutputMetadata{
+		OutputID: output.OutputID(),
+		BlockID:  output.BlockID(),
+		Included: &api.OutputInclusionMetadata{
+			Slot:          includedSlot,
+			TransactionID: output.OutputID().TransactionID(),
+			CommitmentID:  iotago.EmptyCommitmentID,
+		},
+		LatestCommitmentID: latestCommitmentID,
+	}
+}
+
+func publishOutput(output *utxoledger.Output, outputMetadata *api.OutputMetadata) {
+	topics := []string{
+		api.EndpointWithNamedParameterValue(api.EventAPITopicOutputs, api.ParameterOutputID, output.OutputID().ToHex()),
+	}
+
+	hrp := deps.RequestHandler.CommittedAPI().ProtocolParameters().Bech32HRP()
+	anyConditionAddresses := make(map[string]struct{})
+	for unlockConditionType, address := range utxoledger.UnlockConditionAddresses(output.Output(), utxoledger.WithReturnAddresses(true)) {
+		bech32Address := address.Bech32(hrp)
+		topics = append(topics, outputsByUnlockConditionTopic(eventAPIUnlockConditions[unlockConditionType], bech32Address))
+
+		// the same address can be used by several unlock conditions, but the output is only published once per address.
+		if _, exists := anyConditionAddresses[bech32Address]; !exists {
+			anyConditionAddresses[bech32Address] = struct{}{}
+			topics = append(topics, outputsByUnlockConditionTopic(api.EventAPIUnlockConditionAny, bech32Address))
+		}
+	}
+
+	if err := eventBroker.Publish(func() ([]byte, error) {
+		return deps.RequestHandler.CommittedAPI().JSONEncode(&api.OutputWithMetadataResponse{
+			Output:        output.Output(),
+			OutputIDProof: output.OutputIDProof(),
+			Metadata:      outputMetadata,
+		})
+	}, topics...); err != nil {
+		Component.LogWarnf("failed to publish output %s: %s", output.OutputID().ToHex(), err)
+	}
+}
+
+func outputsByUnlockConditionTopic(condition api.EventAPIUnlockCondition, bech32Address string) string {
+	topic := api.EndpointWithNamedParameterValue(api.EventAPITopicOutputsByUnlockConditionAndAddress, api.ParameterCondition, string(condition))
+
+	return api.EndpointWithNamedParameterValue(topic, api.ParameterAddress, bech32Address)
+}
+
+// eventAPIUnlockConditions maps the types of the unlock conditions that contain an owner address to their event API names.
+var eventAPIUnlockConditions = map[iotago.UnlockConditionType]api.EventAPIUnlockCondition{
+	iotago.UnlockConditionAddress:                api.EventAPIUnlockConditionAddress,
+	iotago.UnlockConditionStorageDepositReturn:   api.EventAPIUnlockConditionStorageReturn,
+	iotago.UnlockConditionExpiration:             api.EventAPIUnlockConditionExpiration,
+	iotago.UnlockConditionStateControllerAddress: api.EventAPIUnlockConditionStateController,
+	iotago.UnlockConditionGovernorAddress:        api.EventAPIUnlockConditionGovernor,
+	iotago.UnlockConditionImmutableAccount:       api.EventAPIUnlockConditionImmutableAccount,
+}
@@ -0,0 +1,32 @@
+package core
+
+import (
+	"encoding/json"
+)
+
+const (
+	// EventStreamRequestSubscribe is the type of the request that subscribes a WebSocket client to topics.
+	EventStreamRequestSubscribe = "subscribe"
+	// EventStreamRequestUnsubscribe is the type of the request that unsubscribes a WebSocket client from topics.
+	EventStreamRequestUnsubscribe = "unsubscribe"
+)
+
+// EventStreamRequest is a request that is sent by the clients of the WebSocket event stream.
+type EventStreamRequest struct {
+	// Type is the type of the request ("subscribe" or "unsubscribe").
+	Type string `json:"type"`
+	// Topics are the topics the client wants to subscribe to or unsubscribe from.
+	Topics []string `json:"topics"`
+}
+
+// EventStreamMessage is a message that is sent to the clients of the WebSocket event stream.
+type EventStreamMessage struct {
+	// Topic is the topic of the event (empty if the message is not an event).
+	Topic string `json:"topic,omitempty"`
+	// Payload is the JSON encoded payload of the event.
+	Payload json.RawMessage `json:"payload,omitempty"`
+	// Topics contains the topics the client is subscribed to (it is sent after every request).
+	Topics []string `json:"topics,omitempty"`
+	// Error contains the reason why a request failed or why the stream was closed.
+	Error string `json:"error,omitempty"`
+}
@@ -0,0 +1,298 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/labstack/echo/v4"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/runtime/workerpool"
+	"github.com/iotaledger/iota-core/components/restapi"
+	"github.com/iotaledger/iota-core/pkg/daemon"
+	"github.com/iotaledger/iota-core/pkg/eventstream"
+)
+
+const (
+	// ParameterTopics is used to pass the topics of the event stream (as repeated or comma separated query parameter).
+	ParameterTopics = "topics"
+
+	// eventStreamWriteTimeout is the time after which writing a message to a WebSocket client fails.
+	eventStreamWriteTimeout = 10 * time.Second
+	// eventStreamMaxRequestSize is the maximum size of a request of a WebSocket client.
+	eventStreamMaxRequestSize = 64 * 1024
+)
+
+var (
+	eventBroker *eventstream.Broker
+
+	// the REST API allows requests from any origin, so the same is done for the WebSocket event stream.
+	eventStreamUpgrader = websocket.Upgrader{
+		CheckOrigin: func(_ *http.Request) bool { return true },
+	}
+)
+
+func configureEventStream(routeGroup *echo.Group) {
+	eventBroker = eventstream.NewBroker(
+		eventstream.WithMaxSubscriptions(restapi.ParamsRestAPI.EventStream.MaxClients),
+		eventstream.WithMaxTopics(restapi.ParamsRestAPI.EventStream.MaxTopicsPerClient),
+		eventstream.WithBufferSize(restapi.ParamsRestAPI.EventStream.ClientBufferSize),
+	)
+
+	routeGroup.GET(RouteEventsWebSocket, eventStreamWebSocket)
+	routeGroup.GET(RouteEventsSSE, eventStreamSSE)
+}
+
+func runEventStream() error {
+	return Component.Daemon().BackgroundWorker("EventStream", func(ctx context.Context) {
+		// a single worker is used, so that the events of a block or transaction are published in the order they happened.
+		workerPool := workerpool.New("EventStream", workerpool.WithWorkerCount(1)).Start()
+		unhook := hookEvents(workerPool)
+
+		<-ctx.Done()
+
+		unhook()
+		workerPool.Shutdown()
+		workerPool.ShutdownComplete.Wait()
+
+		eventBroker.Shutdown()
+	}, daemon.PriorityRestAPI)
+}
+
+// subscribeToEvents creates a subscription for the topics that are passed as query parameters.
+func subscribeToEvents(c echo.Context, topicsRequired bool) (*eventstream.Subscription, error) {
+	var topics []string
+	for _, topicsParam := range c.QueryParams()[ParameterTopics] {
+		for _, topic := range strings.Split(topicsParam, ",") {
+			if topic = strings.TrimSpace(topic); topic != "" {
+				topics = append(topics, topic)
+			}
+		}
+	}
+
+	if topicsRequired && len(topics) == 0 {
+		return nil, ierrors.WithMessagef(echo.ErrBadRequest, "no topics given, use the %s query parameter", ParameterTopics)
+	}
+
+	parsedTopics, err := parseEventTopics(topics)
+	if err != nil {
+		return nil, ierrors.WithMessage(echo.ErrBadRequest, err.Error())
+	}
+
+	subscription, err := eventBroker.Subscribe()
+	if err != nil {
+		return nil, ierrors.WithMessage(echo.ErrServiceUnavailable, err.Error())
+	}
+
+	if err := subscription.Subscribe(parsedTopics...); err != nil {
+		subscription.Close()
+
+		return nil, ierrors.WithMessage(echo.ErrBadRequest, err.Error())
+	}
+
+	return subscription, nil
+}
+
+func parseEventTopics(topics []string) ([]string, error) {
+	parsedTopics := make([]string, len(topics))
+	for i, topic := range topics {
+		parsedTopic, err := parseEventTopic(topic)
+		if err != nil {
+			return nil, err
+		}
+
+		parsedTopics[i] = parsedTopic
+	}
+
+	return parsedTopics, nil
+}
+
+// eventStreamSSE streams the events of the topics given as query parameters as Server-Sent-Events.
+func eventStreamSSE(c echo.Context) error {
+	subscription, err := subscribeToEvents(c, true)
+	if err != nil {
+		return err
+	}
+	defer subscription.Close()
+
+	response := c.Response()
+	response.Header().Set(echo.HeaderContentType, "text/event-stream")
+	response.Header().Set(echo.HeaderCacheControl, "no-cache")
+	response.Header().Set(echo.HeaderConnection, "keep-alive")
+	response.WriteHeader(http.StatusOK)
+	response.Flush()
+
+	keepAliveTicker := time.NewTicker(restapi.ParamsRestAPI.EventStream.KeepAliveInterval)
+	defer keepAliveTicker.Stop()
+
+	for {
+		select {
+		case <-c.Request().Context().Done():
+			return nil
+
+		case <-subscription.Closed():
+			if err := subscription.Err(); err != nil {
+				_ = writeServerSentEvent(response, "error", []byte(err.Error()))
+			}
+
+			return nil
+
+		case <-keepAliveTicker.C:
+			// lines starting with a colon are comments that are ignored by the clients.
+			if _, err := fmt.Fprint(response, ": keep-alive\n\n"); err != nil {
+				return nil
+			}
+			response.Flush()
+
+		case event := <-subscription.Events():
+			if err := writeServerSentEvent(response, event.Topic, event.Payload); err != nil {
+				return nil
+			}
+		}
+	}
+}
+
+func writeServerSentEvent(response *echo.Response, eventName string, data []byte) error {
+	var sseEvent strings.Builder
+	sseEvent.WriteString("event: " + eventName + "\n")
+
+	// every line of the data needs its own prefix, so that payloads with line breaks don't end the event.
+	for _, line := range strings.Split(string(data), "\n") {
+		sseEvent.WriteString("data: " + line + "\n")
+	}
+	sseEvent.WriteString("\n")
+
+	if _, err := response.Write([]byte(sseEvent.String())); err != nil {
+		return err
+	}
+	response.Flush()
+
+	return nil
+}
+
+// eventStreamWebSocket streams the events to a WebSocket client, which can change its topics by sending requests.
+func eventStreamWebSocket(c echo.Context) error {
+	subscription, err := subscribeToEvents(c, false)
+	if err != nil {
+		return err
+	}
+	defer subscription.Close()
+
+	conn, err := eventStreamUpgrader.Upgrade(c.Response(), c.Request(), nil)
+	if err != nil {
+		// the upgrader already responded with an error.
+		return nil
+	}
+	defer conn.Close()
+
+	keepAliveInterval := restapi.ParamsRestAPI.EventStream.KeepAliveInterval
+
+	// the client needs to answer the pings of the keep-alive, otherwise the connection is considered dead.
+	conn.SetReadLimit(eventStreamMaxRequestSize)
+	_ = conn.SetReadDeadline(time.Now().Add(2 * keepAliveInterval))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(2 * keepAliveInterval))
+	})
+
+	// the client gets the topics it is subscribed to right away, so it knows that the subscription is active.
+	_ = conn.SetWriteDeadline(time.Now().Add(eventStreamWriteTimeout))
+	if err := conn.WriteJSON(&EventStreamMessage{Topics: subscription.Topics()}); err != nil {
+		return nil
+	}
+
+	// the requests are read in a separate goroutine, as the connection supports one concurrent reader and writer.
+	responses := make(chan *EventStreamMessage, 1)
+	go readEventStreamRequests(conn, subscription, responses)
+
+	keepAliveTicker := time.NewTicker(keepAliveInterval)
+	defer keepAliveTicker.Stop()
+
+	for {
+		var message *EventStreamMessage
+
+		select {
+		case <-c.Request().Context().Done():
+			writeWebSocketClose(conn, websocket.CloseGoingAway, "node is shutting down")
+
+			return nil
+
+		case <-subscription.Closed():
+			if err := subscription.Err(); err != nil {
+				writeWebSocketClose(conn, websocket.CloseTryAgainLater, err.Error())
+			}
+
+			return nil
+
+		case <-keepAliveTicker.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(eventStreamWriteTimeout)); err != nil {
+				return nil
+			}
+
+			continue
+
+		case message = <-responses:
+
+		case event := <-subscription.Events():
+			message = &EventStreamMessage{
+				Topic:   event.Topic,
+				Payload: event.Payload,
+			}
+		}
+
+		_ = conn.SetWriteDeadline(time.Now().Add(eventStreamWriteTimeout))
+		if err := conn.WriteJSON(message); err != nil {
+			return nil
+		}
+	}
+}
+
+// readEventStreamRequests handles the requests of a WebSocket client until the connection is closed.
+func readEventStreamRequests(conn *websocket.Conn, subscription *eventstream.Subscription, responses chan<- *EventStreamMessage) {
+	// closing the subscription stops the writer once the client is gone.
+	defer subscription.Close()
+
+	for {
+		request := new(EventStreamRequest)
+		if err := conn.ReadJSON(request); err != nil {
+			return
+		}
+
+		response := &EventStreamMessage{}
+		if err := handleEventStreamRequest(subscription, request); err != nil {
+			response.Error = err.Error()
+		}
+		response.Topics = subscription.Topics()
+
+		select {
+		case responses <- response:
+		case <-subscription.Closed():
+			return
+		}
+	}
+}
+
+func handleEventStreamRequest(subscription *eventstream.Subscription, request *EventStreamRequest) error {
+	topics, err := parseEventTopics(request.Topics)
+	if err != nil {
+		return err
+	}
+
+	switch request.Type {
+	case EventStreamRequestSubscribe:
+		return subscription.Subscribe(topics...)
+	case EventStreamRequestUnsubscribe:
+		subscription.Unsubscribe(topics...)
+
+		return nil
+	default:
+		return ierrors.Errorf("unknown request type %s", request.Type)
+	}
+}
+
+func writeWebSocketClose(conn *websocket.Conn, closeCode int, reason string) {
+	_ = conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(closeCode, reason), time.Now().Add(eventStreamWriteTimeout))
+}
@@ -0,0 +1,90 @@
+package core
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+	"github.com/iotaledger/iota-core/components/restapi"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/api"
+)
+
+func addressStateAtSlot(c echo.Context) (*AddressStateAtSlotResponse, error) {
+	slot, err := httpserver.ParseSlotParam(c, api.ParameterSlot)
+	if err != nil {
+		return nil, err
+	}
+
+	hrp := deps.RequestHandler.CommittedAPI().ProtocolParameters().Bech32HRP()
+	address, err := httpserver.ParseBech32AddressParam(c, hrp, api.ParameterBech32Address)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := httpserver.ParsePageSizeQueryParam(c, api.ParameterPageSize, uint32(restapi.ParamsRestAPI.Limits.MaxResults))
+
+	// no cursor provided will be the first request
+	var cursor iotago.OutputID
+	firstPage := len(c.QueryParam(api.ParameterCursor)) == 0
+	if !firstPage {
+		if cursor, err = iotago.OutputIDFromHexString(c.QueryParam(api.ParameterCursor)); err != nil {
+			return nil, ierrors.Wrapf(httpserver.ErrInvalidParameter, "invalid cursor %s: %s", c.QueryParam(api.ParameterCursor), err)
+		}
+	}
+
+	outputs, nextCursor, err := deps.RequestHandler.UnspentOutputsByAddressAtSlot(address, slot, cursor, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &AddressStateAtSlotResponse{
+		Slot:      slot,
+		Address:   address.Bech32(hrp),
+		OutputIDs: make([]string, 0, len(outputs)),
+		PageSize:  pageSize,
+	}
+
+	// the balance covers the outputs of all pages, so it is only computed once for the first page.
+	if firstPage {
+		balance, storedMana, err := deps.RequestHandler.BalanceByAddressAtSlot(address, slot)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Balance, resp.StoredMana = &balance, &storedMana
+	}
+
+	for _, output := range outputs {
+		resp.OutputIDs = append(resp.OutputIDs, output.OutputID().ToHex())
+	}
+
+	if nextCursor != nil {
+		resp.Cursor = nextCursor.ToHex()
+	}
+
+	return resp, nil
+}
+
+func outputStateAtSlot(c echo.Context) (*OutputStateAtSlotResponse, error) {
+	slot, err := httpserver.ParseSlotParam(c, api.ParameterSlot)
+	if err != nil {
+		return nil, err
+	}
+
+	outputID, err := httpserver.ParseOutputIDParam(c, api.ParameterOutputID)
+	if err != nil {
+		return nil, ierrors.Wrapf(err, "failed to parse output ID %s", c.Param(api.ParameterOutputID))
+	}
+
+	unspent, err := deps.RequestHandler.IsOutputUnspentAtSlot(outputID, slot)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OutputStateAtSlotResponse{
+		Slot:     slot,
+		OutputID: outputID.ToHex(),
+		Unspent:  unspent,
+	}, nil
+}
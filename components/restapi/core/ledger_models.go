@@ -0,0 +1,68 @@
+package core
+
+import (
+	"encoding/json"
+
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+type (
+	// AddressStateAtSlotResponse defines the response of a GET address state at slot REST API call.
+	AddressStateAtSlotResponse struct {
+		// Slot is the slot after which the ledger state was reconstructed.
+		Slot iotago.SlotIndex `json:"slot"`
+		// Address is the bech32 encoded address.
+		Address string `json:"address"`
+		// Balance is the sum of the base tokens of the unspent outputs owned by the address, it is only set on the first page.
+		Balance *iotago.BaseToken `json:"balance,string,omitempty"`
+		// StoredMana is the sum of the stored mana of the unspent outputs owned by the address, it is only set on the first page.
+		StoredMana *iotago.Mana `json:"storedMana,string,omitempty"`
+		// OutputIDs are the hex encoded IDs of the unspent outputs owned by the address on this page.
+		OutputIDs []string `json:"outputIds"`
+		// PageSize is the maximum number of output IDs per page.
+		PageSize uint32 `json:"pageSize"`
+		// Cursor is the hex encoded output ID to request the next page with, it is empty if there are no more output IDs.
+		Cursor string `json:"cursor,omitempty"`
+	}
+
+	// OutputStateAtSlotResponse defines the response of a GET output state at slot REST API call.
+	OutputStateAtSlotResponse struct {
+		// Slot is the slot after which the ledger state was reconstructed.
+		Slot iotago.SlotIndex `json:"slot"`
+		// OutputID is the hex encoded ID of the output.
+		OutputID string `json:"outputId"`
+		// Unspent is true if the output existed and was unspent after the slot was committed.
+		Unspent bool `json:"unspent"`
+	}
+
+	// StateTreeProofResponse defines the sparse merkle proof of an output ID in the state tree.
+	// The tree uses SHA-256 as hash function and stores the leaf values without hashing them.
+	StateTreeProofResponse struct {
+		// StateRoot is the hex encoded root of the state tree.
+		StateRoot string `json:"stateRoot"`
+		// Key is the hex encoded output ID of the proven leaf.
+		Key string `json:"key"`
+		// Value is the hex encoded value of the proven leaf, which is the slot the output was created in.
+		Value string `json:"value"`
+		// SideNodes are the hex encoded sibling nodes on the path from the leaf to the root.
+		SideNodes []string `json:"sideNodes"`
+		// SiblingData is the hex encoded data of the sibling node of the leaf.
+		SiblingData string `json:"siblingData,omitempty"`
+	}
+
+	// OutputProofResponse defines the response of a GET output proof REST API call.
+	// The output is proven to its output ID by the output ID proof, the output ID to the state root by the state tree proof
+	// and the state root to the roots ID of the commitment by the state root proof.
+	OutputProofResponse struct {
+		// Output is the proven output.
+		Output json.RawMessage `json:"output"`
+		// OutputIDProof proves that the output belongs to its output ID.
+		OutputIDProof json.RawMessage `json:"outputIdProof"`
+		// StateTreeProof proves the inclusion of the output ID in the state root.
+		StateTreeProof *StateTreeProofResponse `json:"stateTreeProof"`
+		// StateRootProof proves the inclusion of the state root in the roots ID of the commitment.
+		StateRootProof json.RawMessage `json:"stateRootProof"`
+		// Commitment is the commitment the proofs are anchored to.
+		Commitment json.RawMessage `json:"commitment"`
+	}
+)
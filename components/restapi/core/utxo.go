@@ -6,6 +6,7 @@ import (
 	"github.com/iotaledger/hive.go/ierrors"
 	"github.com/iotaledger/inx-app/pkg/httpserver"
 	"github.com/iotaledger/iota.go/v4/api"
+	"github.com/iotaledger/iota.go/v4/hexutil"
 )
 
 func outputFromOutputID(c echo.Context) (*api.OutputResponse, error) {
@@ -34,3 +35,61 @@ func outputWithMetadataFromOutputID(c echo.Context) (*api.OutputWithMetadataResp
 
 	return deps.RequestHandler.OutputWithMetadataFromOutputID(outputID)
 }
+
+func outputProofFromOutputID(c echo.Context) (*OutputProofResponse, error) {
+	outputID, err := httpserver.ParseOutputIDParam(c, api.ParameterOutputID)
+	if err != nil {
+		return nil, ierrors.Wrapf(err, "failed to parse output ID %s", c.Param(api.ParameterOutputID))
+	}
+
+	outputProof, err := deps.RequestHandler.OutputProof(outputID)
+	if err != nil {
+		return nil, err
+	}
+
+	apiForSlot := deps.RequestHandler.APIProvider().APIForSlot(outputProof.Commitment.Slot())
+
+	outputJSON, err := apiForSlot.JSONEncode(outputProof.Output.Output())
+	if err != nil {
+		return nil, ierrors.WithMessagef(echo.ErrInternalServerError, "failed to encode output %s: %w", outputID.ToHex(), err)
+	}
+
+	outputIDProofJSON, err := apiForSlot.JSONEncode(outputProof.Output.OutputIDProof())
+	if err != nil {
+		return nil, ierrors.WithMessagef(echo.ErrInternalServerError, "failed to encode output ID proof of output %s: %w", outputID.ToHex(), err)
+	}
+
+	stateRootProofJSON, err := outputProof.StateRootProof.JSONEncode()
+	if err != nil {
+		return nil, ierrors.WithMessagef(echo.ErrInternalServerError, "failed to encode state root proof: %w", err)
+	}
+
+	commitmentJSON, err := apiForSlot.JSONEncode(outputProof.Commitment.Commitment())
+	if err != nil {
+		return nil, ierrors.WithMessagef(echo.ErrInternalServerError, "failed to encode commitment %s: %w", outputProof.Commitment.ID(), err)
+	}
+
+	sideNodes := make([]string, 0, len(outputProof.StateTreeProof.Proof.SideNodes))
+	for _, sideNode := range outputProof.StateTreeProof.Proof.SideNodes {
+		sideNodes = append(sideNodes, hexutil.EncodeHex(sideNode))
+	}
+
+	var siblingData string
+	if len(outputProof.StateTreeProof.Proof.SiblingData) > 0 {
+		siblingData = hexutil.EncodeHex(outputProof.StateTreeProof.Proof.SiblingData)
+	}
+
+	return &OutputProofResponse{
+		Output:        outputJSON,
+		OutputIDProof: outputIDProofJSON,
+		StateTreeProof: &StateTreeProofResponse{
+			StateRoot:   outputProof.StateTreeProof.StateRoot.ToHex(),
+			Key:         outputID.ToHex(),
+			Value:       hexutil.EncodeHex(outputProof.StateTreeProof.Value),
+			SideNodes:   sideNodes,
+			SiblingData: siblingData,
+		},
+		StateRootProof: stateRootProofJSON,
+		Commitment:     commitmentJSON,
+	}, nil
+}
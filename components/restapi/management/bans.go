@@ -0,0 +1,90 @@
+package management
+
+import (
+	"net"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+	"github.com/iotaledger/iota-core/pkg/network"
+	"github.com/iotaledger/iota-core/pkg/network/p2p"
+)
+
+// listBans returns all active bans.
+func listBans() *BansResponse {
+	return &BansResponse{
+		Bans: deps.NetworkManager.Bans(),
+	}
+}
+
+// banPeer bans the peer ID and/or IP address given in the request.
+func banPeer(c echo.Context) (*network.Ban, error) {
+	request := &BanPeerRequest{}
+	if err := c.Bind(request); err != nil {
+		return nil, ierrors.WithMessagef(httpserver.ErrInvalidParameter, "invalid banPeerRequest: %s", err)
+	}
+
+	if request.PeerID == "" && request.IP == "" {
+		return nil, ierrors.WithMessage(httpserver.ErrInvalidParameter, "either peerId or ip has to be specified")
+	}
+
+	var peerID peer.ID
+	if request.PeerID != "" {
+		var err error
+		if peerID, err = peer.Decode(request.PeerID); err != nil {
+			return nil, ierrors.WithMessagef(httpserver.ErrInvalidParameter, "invalid peerId: %s", err)
+		}
+	}
+
+	var ips []net.IP
+	if request.IP != "" {
+		ip := net.ParseIP(request.IP)
+		if ip == nil {
+			return nil, ierrors.WithMessagef(httpserver.ErrInvalidParameter, "invalid ip: %s", request.IP)
+		}
+
+		ips = append(ips, ip)
+	}
+
+	var duration time.Duration
+	if request.Duration != "" {
+		var err error
+		if duration, err = time.ParseDuration(request.Duration); err != nil || duration <= 0 {
+			return nil, ierrors.WithMessagef(httpserver.ErrInvalidParameter, "invalid duration: %s", request.Duration)
+		}
+	}
+
+	reason := request.Reason
+	if reason == "" {
+		reason = "banned via management API"
+	}
+
+	ban, err := deps.NetworkManager.BanPeer(peerID, ips, duration, reason)
+	if err != nil {
+		if ierrors.Is(err, network.ErrLoopbackPeer) {
+			return nil, ierrors.WithMessage(httpserver.ErrInvalidParameter, "the node can not ban itself")
+		}
+
+		return nil, ierrors.WithMessagef(echo.ErrInternalServerError, "failed to ban peer: %s", err)
+	}
+
+	return ban, nil
+}
+
+// unbanPeer lifts the ban with the peer ID or IP address given in the request.
+func unbanPeer(c echo.Context) error {
+	banID := c.Param(ParameterBanID)
+
+	if err := deps.NetworkManager.Unban(banID); err != nil {
+		if ierrors.Is(err, p2p.ErrBanNotFound) {
+			return ierrors.WithMessagef(echo.ErrNotFound, "ban not found: %s", banID)
+		}
+
+		return ierrors.WithMessagef(echo.ErrInternalServerError, "failed to lift ban: %s", err)
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package management
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
@@ -8,24 +9,68 @@ import (
 
 	"github.com/iotaledger/hive.go/app"
 	"github.com/iotaledger/inx-app/pkg/httpserver"
+	"github.com/iotaledger/iota-core/pkg/daemon"
 	"github.com/iotaledger/iota-core/pkg/network"
 	"github.com/iotaledger/iota-core/pkg/network/p2p"
 	"github.com/iotaledger/iota-core/pkg/protocol"
 	restapipkg "github.com/iotaledger/iota-core/pkg/restapi"
+	"github.com/iotaledger/iota-core/pkg/storage/snapshot"
+	"github.com/iotaledger/iota-core/pkg/webhooks"
 	"github.com/iotaledger/iota.go/v4/api"
 )
 
+const (
+	// RouteSnapshots is the route to list the snapshot files created by the snapshot scheduler.
+	RouteSnapshots = "/snapshots"
+
+	// RoutePeersReload is the route to reload the peering config and apply the changes without restarting the node.
+	RoutePeersReload = "/peers/reload"
+
+	// ParameterBanID is used to identify a ban by its peer ID or IP address.
+	ParameterBanID = "banID"
+
+	// RouteBans is the route to list and create bans of peers.
+	RouteBans = "/bans"
+
+	// RouteBan is the route to lift the ban of a peer.
+	RouteBan = RouteBans + "/:" + ParameterBanID
+
+	// ParameterJobID is used to identify a management job.
+	ParameterJobID = "jobID"
+
+	// RouteJobs is the route to list the pruning and snapshot jobs.
+	RouteJobs = "/jobs"
+
+	// RouteJob is the route to get or cancel a pruning or snapshot job.
+	RouteJob = RouteJobs + "/:" + ParameterJobID
+
+	// ParameterWebhookID is used to identify a webhook.
+	ParameterWebhookID = "webhookID"
+
+	// RouteWebhooks is the route to list and register transaction webhooks.
+	RouteWebhooks = "/webhooks"
+
+	// RouteWebhook is the route to get or remove a transaction webhook.
+	RouteWebhook = RouteWebhooks + "/:" + ParameterWebhookID
+
+	// RouteWebhookDeliveries is the route to list the pending deliveries of a transaction webhook.
+	RouteWebhookDeliveries = RouteWebhook + "/deliveries"
+)
+
 func init() {
 	Component = &app.Component{
 		Name:      "ManagementAPIV1",
 		DepsFunc:  func(cDeps dependencies) { deps = cDeps },
 		Configure: configure,
+		Run:       run,
 	}
 }
 
 var (
 	Component *app.Component
 	deps      dependencies
+
+	jobs *jobManager
 )
 
 type dependencies struct {
@@ -36,9 +81,13 @@ type dependencies struct {
 	PeeringConfigManager *p2p.ConfigManager
 	NetworkManager       network.Manager
 	SnapshotFilePath     string `name:"snapshotFilePath"`
+	SnapshotDirectory    *snapshot.Directory
+	WebhooksManager      *webhooks.Manager `optional:"true"`
 }
 
 func configure() error {
+	jobs = newJobManager()
+
 	routeGroup := deps.RestRouteManager.AddRoute(api.ManagementPluginName)
 
 	routeGroup.GET(api.EndpointWithEchoParameters(api.ManagementEndpointPeer), func(c echo.Context) error {
@@ -58,6 +107,24 @@ func configure() error {
 		return c.NoContent(http.StatusNoContent)
 	})
 
+	routeGroup.PATCH(api.EndpointWithEchoParameters(api.ManagementEndpointPeer), func(c echo.Context) error {
+		resp, err := updatePeer(c)
+		if err != nil {
+			return err
+		}
+
+		return responseByHeader(c, resp, http.StatusOK)
+	})
+
+	routeGroup.POST(RoutePeersReload, func(c echo.Context) error {
+		resp, err := reloadPeers()
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	})
+
 	routeGroup.GET(api.ManagementEndpointPeers, func(c echo.Context) error {
 		return responseByHeader(c, listPeers(), http.StatusOK)
 	})
@@ -71,13 +138,34 @@ func configure() error {
 		return responseByHeader(c, resp, http.StatusOK)
 	})
 
+	routeGroup.GET(RouteBans, func(c echo.Context) error {
+		return httpserver.JSONResponse(c, http.StatusOK, listBans())
+	})
+
+	routeGroup.POST(RouteBans, func(c echo.Context) error {
+		resp, err := banPeer(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	})
+
+	routeGroup.DELETE(RouteBan, func(c echo.Context) error {
+		if err := unbanPeer(c); err != nil {
+			return err
+		}
+
+		return c.NoContent(http.StatusNoContent)
+	})
+
 	routeGroup.POST(api.ManagementEndpointDatabasePrune, func(c echo.Context) error {
 		resp, err := pruneDatabase(c)
 		if err != nil {
 			return err
 		}
 
-		return responseByHeader(c, resp, http.StatusOK)
+		return httpserver.JSONResponse(c, http.StatusAccepted, resp)
 	})
 
 	routeGroup.POST(api.ManagementEndpointSnapshotsCreate, func(c echo.Context) error {
@@ -86,12 +174,98 @@ func configure() error {
 			return err
 		}
 
-		return responseByHeader(c, resp, http.StatusOK)
+		return httpserver.JSONResponse(c, http.StatusAccepted, resp)
+	})
+
+	routeGroup.GET(RouteSnapshots, func(c echo.Context) error {
+		resp, err := listSnapshots(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	})
+
+	routeGroup.GET(RouteJobs, func(c echo.Context) error {
+		return httpserver.JSONResponse(c, http.StatusOK, listJobs())
+	})
+
+	routeGroup.GET(RouteJob, func(c echo.Context) error {
+		resp, err := getJob(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	})
+
+	routeGroup.DELETE(RouteJob, func(c echo.Context) error {
+		resp, err := cancelJob(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
 	})
 
+	// the webhooks component is optional.
+	if deps.WebhooksManager != nil {
+		configureWebhookRoutes(routeGroup)
+	}
+
 	return nil
 }
 
+func configureWebhookRoutes(routeGroup *echo.Group) {
+	routeGroup.GET(RouteWebhooks, func(c echo.Context) error {
+		return httpserver.JSONResponse(c, http.StatusOK, listWebhooks())
+	})
+
+	routeGroup.POST(RouteWebhooks, func(c echo.Context) error {
+		resp, err := addWebhook(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusCreated, resp)
+	})
+
+	routeGroup.GET(RouteWebhook, func(c echo.Context) error {
+		resp, err := getWebhook(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	})
+
+	routeGroup.DELETE(RouteWebhook, func(c echo.Context) error {
+		if err := removeWebhook(c); err != nil {
+			return err
+		}
+
+		return c.NoContent(http.StatusNoContent)
+	})
+
+	routeGroup.GET(RouteWebhookDeliveries, func(c echo.Context) error {
+		resp, err := listWebhookDeliveries(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	})
+}
+
+func run() error {
+	return Component.Daemon().BackgroundWorker(Component.Name, func(ctx context.Context) {
+		<-ctx.Done()
+
+		Component.LogInfo("Canceling running management jobs...")
+		jobs.Shutdown()
+	}, daemon.PriorityRestAPI)
+}
+
 func responseByHeader(c echo.Context, obj any, httpStatusCode ...int) error {
 	return httpserver.SendResponseByHeader(c, deps.Protocol.CommittedAPI(), obj, httpStatusCode...)
 }
@@ -0,0 +1,263 @@
+package management
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/runtime/syncutils"
+)
+
+const (
+	// JobTypePrune is the type of jobs that prune the database.
+	JobTypePrune = "prune"
+	// JobTypeSnapshot is the type of jobs that create a snapshot.
+	JobTypeSnapshot = "snapshot"
+
+	// JobStateRunning is the state of a job that is still running.
+	JobStateRunning = "running"
+	// JobStateSucceeded is the state of a job that completed successfully.
+	JobStateSucceeded = "succeeded"
+	// JobStateFailed is the state of a job that completed with an error.
+	JobStateFailed = "failed"
+	// JobStateCanceled is the state of a job that was canceled before it completed.
+	JobStateCanceled = "canceled"
+
+	// maxFinishedJobs is the number of finished jobs that are retained to be queried.
+	maxFinishedJobs = 100
+)
+
+// jobFunc is the function executed by a job. It returns the result of the job.
+type jobFunc func(ctx context.Context, j *job) (any, error)
+
+// job is a long-running management task that is executed in the background.
+type job struct {
+	id        string
+	jobType   string
+	startTime time.Time
+	cancel    context.CancelFunc
+	// done is closed after the job finished and another job can be started.
+	done chan struct{}
+
+	epochsPruned atomic.Uint64
+	bytesWritten atomic.Int64
+
+	state   string
+	endTime time.Time
+	result  any
+	err     error
+	mutex   syncutils.RWMutex
+}
+
+// finish records the outcome of the job.
+func (j *job) finish(result any, err error, canceled bool) {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	j.endTime = time.Now()
+	j.result = result
+	j.err = err
+
+	switch {
+	case err == nil:
+		j.state = JobStateSucceeded
+	case canceled:
+		j.state = JobStateCanceled
+	default:
+		j.state = JobStateFailed
+	}
+}
+
+// Response returns the REST API representation of the job.
+func (j *job) Response() *JobResponse {
+	j.mutex.RLock()
+	defer j.mutex.RUnlock()
+
+	resp := &JobResponse{
+		ID:           j.id,
+		Type:         j.jobType,
+		State:        j.state,
+		StartTime:    j.startTime,
+		EpochsPruned: j.epochsPruned.Load(),
+		BytesWritten: j.bytesWritten.Load(),
+		Result:       j.result,
+	}
+
+	if !j.endTime.IsZero() {
+		endTime := j.endTime
+		resp.EndTime = &endTime
+	}
+
+	if j.err != nil {
+		resp.Error = j.err.Error()
+	}
+
+	return resp
+}
+
+// jobManager executes the management jobs in the background and keeps track of their state.
+// Only one job is executed at a time, because pruning and snapshotting must not run concurrently.
+type jobManager struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	jobs         map[string]*job
+	finishedJobs []*job
+	runningJob   *job
+	lastJobID    uint64
+	mutex        syncutils.RWMutex
+
+	waitGroup sync.WaitGroup
+}
+
+func newJobManager() *jobManager {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &jobManager{
+		ctx:    ctx,
+		cancel: cancel,
+		jobs:   make(map[string]*job),
+	}
+}
+
+// Start executes the given function as a new job in the background.
+func (m *jobManager) Start(jobType string, fn jobFunc) (*job, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if m.ctx.Err() != nil {
+		return nil, ierrors.WithMessage(echo.ErrServiceUnavailable, "node is shutting down")
+	}
+
+	if m.runningJob != nil {
+		return nil, ierrors.WithMessagef(echo.ErrServiceUnavailable, "%s job %s is still running", m.runningJob.jobType, m.runningJob.id)
+	}
+
+	m.lastJobID++
+
+	ctx, cancel := context.WithCancel(m.ctx)
+	j := &job{
+		id:        strconv.FormatUint(m.lastJobID, 10),
+		jobType:   jobType,
+		startTime: time.Now(),
+		cancel:    cancel,
+		done:      make(chan struct{}),
+		state:     JobStateRunning,
+	}
+
+	m.jobs[j.id] = j
+	m.runningJob = j
+
+	m.waitGroup.Add(1)
+	go func() {
+		defer m.waitGroup.Done()
+		defer cancel()
+
+		result, err := fn(ctx, j)
+		j.finish(result, err, ctx.Err() != nil)
+
+		m.jobFinished(j)
+		close(j.done)
+	}()
+
+	return j, nil
+}
+
+// Job returns the job with the given ID.
+func (m *jobManager) Job(jobID string) (*job, bool) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	j, exists := m.jobs[jobID]
+
+	return j, exists
+}
+
+// Jobs returns all known jobs, the finished ones in the order they completed, followed by the running job.
+func (m *jobManager) Jobs() []*job {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	allJobs := make([]*job, 0, len(m.finishedJobs)+1)
+	allJobs = append(allJobs, m.finishedJobs...)
+	if m.runningJob != nil {
+		allJobs = append(allJobs, m.runningJob)
+	}
+
+	return allJobs
+}
+
+// Cancel cancels the job with the given ID and waits until it stopped.
+func (m *jobManager) Cancel(ctx context.Context, jobID string) (*job, error) {
+	j, exists := m.Job(jobID)
+	if !exists {
+		return nil, ierrors.WithMessagef(echo.ErrNotFound, "job %s not found", jobID)
+	}
+
+	j.cancel()
+
+	select {
+	case <-j.done:
+		return j, nil
+	case <-ctx.Done():
+		return nil, ierrors.WithMessagef(echo.ErrServiceUnavailable, "job %s did not stop in time", jobID)
+	}
+}
+
+// Shutdown cancels all running jobs and waits until they stopped.
+func (m *jobManager) Shutdown() {
+	m.cancel()
+	m.waitGroup.Wait()
+}
+
+func (m *jobManager) jobFinished(j *job) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.runningJob = nil
+	m.finishedJobs = append(m.finishedJobs, j)
+
+	if len(m.finishedJobs) > maxFinishedJobs {
+		delete(m.jobs, m.finishedJobs[0].id)
+		m.finishedJobs = m.finishedJobs[1:]
+	}
+}
+
+func listJobs() *JobsResponse {
+	allJobs := jobs.Jobs()
+
+	resp := &JobsResponse{
+		Jobs: make([]*JobResponse, 0, len(allJobs)),
+	}
+
+	for _, j := range allJobs {
+		resp.Jobs = append(resp.Jobs, j.Response())
+	}
+
+	return resp
+}
+
+func getJob(c echo.Context) (*JobResponse, error) {
+	jobID := c.Param(ParameterJobID)
+
+	j, exists := jobs.Job(jobID)
+	if !exists {
+		return nil, ierrors.WithMessagef(echo.ErrNotFound, "job %s not found", jobID)
+	}
+
+	return j.Response(), nil
+}
+
+func cancelJob(c echo.Context) (*JobResponse, error) {
+	j, err := jobs.Cancel(c.Request().Context(), c.Param(ParameterJobID))
+	if err != nil {
+		return nil, err
+	}
+
+	return j.Response(), nil
+}
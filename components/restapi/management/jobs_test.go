@@ -0,0 +1,157 @@
+package management
+
+import (
+	"context"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+// blockingJob returns a job function that runs until it is canceled.
+func blockingJob(started chan<- struct{}) jobFunc {
+	return func(ctx context.Context, _ *job) (any, error) {
+		close(started)
+		<-ctx.Done()
+
+		return nil, ctx.Err()
+	}
+}
+
+func TestJobManager_CancelRunningJob(t *testing.T) {
+	manager := newJobManager()
+	defer manager.Shutdown()
+
+	started := make(chan struct{})
+	j, err := manager.Start(JobTypePrune, blockingJob(started))
+	require.NoError(t, err)
+	<-started
+
+	require.Equal(t, JobStateRunning, j.Response().State)
+
+	canceledJob, err := manager.Cancel(context.Background(), j.id)
+	require.NoError(t, err)
+	require.Equal(t, j, canceledJob)
+
+	resp := canceledJob.Response()
+	require.Equal(t, JobStateCanceled, resp.State)
+	require.NotNil(t, resp.EndTime)
+	require.Equal(t, context.Canceled.Error(), resp.Error)
+
+	_, err = manager.Cancel(context.Background(), "unknown")
+	require.ErrorIs(t, err, echo.ErrNotFound)
+}
+
+func TestJobManager_CancelTimeout(t *testing.T) {
+	manager := newJobManager()
+
+	release := make(chan struct{})
+	started := make(chan struct{})
+	j, err := manager.Start(JobTypeSnapshot, func(_ context.Context, _ *job) (any, error) {
+		close(started)
+		<-release
+
+		return nil, nil
+	})
+	require.NoError(t, err)
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err = manager.Cancel(ctx, j.id)
+	require.ErrorIs(t, err, echo.ErrServiceUnavailable)
+
+	close(release)
+	manager.Shutdown()
+}
+
+func TestJobManager_OneJobAtATime(t *testing.T) {
+	manager := newJobManager()
+	defer manager.Shutdown()
+
+	started := make(chan struct{})
+	j, err := manager.Start(JobTypePrune, blockingJob(started))
+	require.NoError(t, err)
+	<-started
+
+	_, err = manager.Start(JobTypeSnapshot, func(_ context.Context, _ *job) (any, error) {
+		return nil, nil
+	})
+	require.ErrorIs(t, err, echo.ErrServiceUnavailable)
+	require.Len(t, manager.Jobs(), 1)
+
+	_, err = manager.Cancel(context.Background(), j.id)
+	require.NoError(t, err)
+
+	// another job can be started as soon as the running one finished.
+	nextJob, err := manager.Start(JobTypeSnapshot, func(_ context.Context, _ *job) (any, error) {
+		return "result", nil
+	})
+	require.NoError(t, err)
+	<-nextJob.done
+
+	resp := nextJob.Response()
+	require.Equal(t, JobStateSucceeded, resp.State)
+	require.Equal(t, "result", resp.Result)
+	require.Len(t, manager.Jobs(), 2)
+}
+
+func TestJobManager_ShutdownWaitsForRunningJob(t *testing.T) {
+	manager := newJobManager()
+
+	var stopped atomic.Bool
+	started := make(chan struct{})
+	j, err := manager.Start(JobTypePrune, func(ctx context.Context, _ *job) (any, error) {
+		close(started)
+		<-ctx.Done()
+
+		// the job takes a while to clean up after it was canceled.
+		time.Sleep(50 * time.Millisecond)
+		stopped.Store(true)
+
+		return nil, ctx.Err()
+	})
+	require.NoError(t, err)
+	<-started
+
+	manager.Shutdown()
+
+	require.True(t, stopped.Load())
+	require.Equal(t, JobStateCanceled, j.Response().State)
+
+	_, err = manager.Start(JobTypeSnapshot, func(_ context.Context, _ *job) (any, error) {
+		return nil, nil
+	})
+	require.ErrorIs(t, err, echo.ErrServiceUnavailable)
+}
+
+func TestJobManager_EvictFinishedJobs(t *testing.T) {
+	manager := newJobManager()
+	defer manager.Shutdown()
+
+	for i := 0; i < maxFinishedJobs+1; i++ {
+		j, err := manager.Start(JobTypeSnapshot, func(_ context.Context, _ *job) (any, error) {
+			return nil, nil
+		})
+		require.NoError(t, err)
+		<-j.done
+	}
+
+	// the oldest finished job is evicted.
+	_, exists := manager.Job("1")
+	require.False(t, exists)
+
+	allJobs := manager.Jobs()
+	require.Len(t, allJobs, maxFinishedJobs)
+	require.Equal(t, "2", allJobs[0].id)
+	require.Equal(t, strconv.Itoa(maxFinishedJobs+1), allJobs[len(allJobs)-1].id)
+
+	for _, j := range allJobs {
+		_, exists := manager.Job(j.id)
+		require.True(t, exists)
+	}
+}
@@ -0,0 +1,151 @@
+package management
+
+import (
+	"time"
+
+	"github.com/iotaledger/iota-core/pkg/network"
+	"github.com/iotaledger/iota-core/pkg/webhooks"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+type (
+	// SnapshotInfo contains information about a snapshot file created by the snapshot scheduler.
+	SnapshotInfo struct {
+		// Slot is the target slot of the snapshot.
+		Slot iotago.SlotIndex `json:"slot"`
+		// FilePath is the path of the snapshot file.
+		FilePath string `json:"filePath"`
+		// Size is the size of the snapshot file in bytes.
+		Size int64 `json:"size"`
+		// ModifiedTime is the time the snapshot file was last modified.
+		ModifiedTime time.Time `json:"modifiedTime"`
+	}
+
+	// SnapshotsResponse defines the response of a GET snapshots REST API call.
+	SnapshotsResponse struct {
+		// Snapshots are the snapshot files sorted by slot in ascending order.
+		Snapshots []*SnapshotInfo `json:"snapshots"`
+		// LatestFilePath is the path of the symlink that points to the latest snapshot.
+		LatestFilePath string `json:"latestFilePath,omitempty"`
+	}
+
+	// JobResponse defines the response of the REST API calls that start, query or cancel a management job.
+	JobResponse struct {
+		// ID is the identifier of the job.
+		ID string `json:"id"`
+		// Type is the type of the job (prune/snapshot).
+		Type string `json:"type"`
+		// State is the state of the job (running/succeeded/failed/canceled).
+		State string `json:"state"`
+		// StartTime is the time the job was started.
+		StartTime time.Time `json:"startTime"`
+		// EndTime is the time the job finished.
+		EndTime *time.Time `json:"endTime,omitempty"`
+		// EpochsPruned is the number of epochs pruned by the job so far.
+		EpochsPruned uint64 `json:"epochsPruned"`
+		// BytesWritten is the number of bytes written to the snapshot file by the job so far.
+		BytesWritten int64 `json:"bytesWritten"`
+		// Result is the result of a job that succeeded.
+		Result any `json:"result,omitempty"`
+		// Error is the error of a job that failed or was canceled.
+		Error string `json:"error,omitempty"`
+	}
+
+	// JobsResponse defines the response of a GET jobs REST API call.
+	JobsResponse struct {
+		// Jobs are the retained finished jobs in the order they completed, followed by the running job.
+		Jobs []*JobResponse `json:"jobs"`
+	}
+
+	// PruneJobResult is the result of a prune job.
+	PruneJobResult struct {
+		// Epoch is the index of the current oldest epoch in the database.
+		Epoch iotago.EpochIndex `json:"epoch"`
+	}
+
+	// SnapshotJobResult is the result of a snapshot job.
+	SnapshotJobResult struct {
+		// Slot is the target slot of the snapshot.
+		Slot iotago.SlotIndex `json:"slot"`
+		// FilePath is the path of the snapshot file.
+		FilePath string `json:"filePath"`
+	}
+
+	// UpdatePeerRequest defines the request of a PATCH peer REST API call.
+	UpdatePeerRequest struct {
+		// Alias is the new alias of the peer.
+		Alias string `json:"alias"`
+	}
+
+	// ReloadPeersResponse defines the response of a POST peers reload REST API call.
+	ReloadPeersResponse struct {
+		// Added are the IDs of the peers that were added to the peering config.
+		Added []string `json:"added"`
+		// Modified are the IDs of the peers whose multi address changed in the peering config.
+		Modified []string `json:"modified"`
+		// Removed are the IDs of the peers that were removed from the peering config.
+		Removed []string `json:"removed"`
+	}
+
+	// BanPeerRequest defines the request of a POST bans REST API call.
+	BanPeerRequest struct {
+		// PeerID is the ID of the peer to ban (optional if IP is given).
+		PeerID string `json:"peerId,omitempty"`
+		// IP is the IP address to ban (optional if PeerID is given).
+		IP string `json:"ip,omitempty"`
+		// Duration is how long the ban lasts, e.g. "24h" (optional, defaults to the configured ban duration).
+		Duration string `json:"duration,omitempty"`
+		// Reason is the reason for the ban (optional).
+		Reason string `json:"reason,omitempty"`
+	}
+
+	// BansResponse defines the response of a GET bans REST API call.
+	BansResponse struct {
+		// Bans are the active bans sorted by the time they were created.
+		Bans []*network.Ban `json:"bans"`
+	}
+
+	// AddWebhookRequest defines the request of a POST webhooks REST API call.
+	AddWebhookRequest struct {
+		// URL is the HTTP endpoint the state changes are posted to.
+		URL string `json:"url"`
+		// TransactionIDs are the IDs of the transactions to watch (optional if Addresses are given).
+		TransactionIDs []string `json:"transactionIds,omitempty"`
+		// Addresses are the bech32 addresses whose transactions are watched (optional if TransactionIDs are given).
+		Addresses []string `json:"addresses,omitempty"`
+		// States are the transaction states to deliver, "accepted", "committed", "finalized" or "failed" (optional, defaults to all).
+		States []string `json:"states,omitempty"`
+		// Secret is the key used to sign the deliveries (optional, a random secret is generated if empty).
+		Secret string `json:"secret,omitempty"`
+	}
+
+	// WebhookResponse defines the response of the webhook REST API calls.
+	WebhookResponse struct {
+		// ID is the identifier of the webhook.
+		ID string `json:"id"`
+		// URL is the HTTP endpoint the state changes are posted to.
+		URL string `json:"url"`
+		// TransactionIDs are the IDs of the watched transactions.
+		TransactionIDs []string `json:"transactionIds,omitempty"`
+		// Addresses are the bech32 addresses whose transactions are watched.
+		Addresses []string `json:"addresses,omitempty"`
+		// States are the delivered transaction states (all if empty).
+		States []string `json:"states,omitempty"`
+		// Secret is the key used to sign the deliveries, it is only returned when the webhook is created.
+		Secret string `json:"secret,omitempty"`
+		// CreatedAt is the time the webhook was registered.
+		CreatedAt time.Time `json:"createdAt"`
+	}
+
+	// WebhooksResponse defines the response of a GET webhooks REST API call.
+	WebhooksResponse struct {
+		// Webhooks are the registered webhooks sorted by the time they were created.
+		Webhooks []*WebhookResponse `json:"webhooks"`
+	}
+
+	// WebhookDeliveriesResponse defines the response of a GET webhook deliveries REST API call.
+	WebhookDeliveriesResponse struct {
+		// Deliveries are the pending deliveries of the webhook sorted by the time they were created.
+		Deliveries []*webhooks.Delivery `json:"deliveries"`
+	}
+)
@@ -10,6 +10,7 @@ import (
 	"github.com/iotaledger/hive.go/ierrors"
 	"github.com/iotaledger/inx-app/pkg/httpserver"
 	"github.com/iotaledger/iota-core/pkg/network"
+	"github.com/iotaledger/iota-core/pkg/network/p2p"
 	iotago "github.com/iotaledger/iota.go/v4"
 	"github.com/iotaledger/iota.go/v4/api"
 )
@@ -101,10 +102,15 @@ func removePeer(c echo.Context) error {
 		return err
 	}
 
-	// error is ignored because we don't care about the config here
-	_ = deps.PeeringConfigManager.RemovePeer(peerID)
+	if err := deps.NetworkManager.RemovePeer(peerID); err != nil {
+		return ierrors.WithMessagef(echo.ErrInternalServerError, "failed to remove peer: %s", err)
+	}
+
+	if err := deps.PeeringConfigManager.RemovePeer(peerID); err != nil {
+		return ierrors.WithMessagef(echo.ErrInternalServerError, "peer was removed, but the peering config could not be stored: %s", err)
+	}
 
-	return deps.NetworkManager.RemovePeer(peerID)
+	return nil
 }
 
 // listPeers returns the list of all peers.
@@ -162,15 +168,99 @@ func addPeer(c echo.Context) (*api.PeerInfo, error) {
 		return nil, ierrors.WithMessagef(echo.ErrInternalServerError, "failed to add peer: %w", err)
 	}
 
-	var alias string
-	if request.Alias != "" {
-		alias = request.Alias
+	if err := deps.PeeringConfigManager.AddPeer(multiAddr, request.Alias); err != nil {
+		return nil, ierrors.WithMessagef(echo.ErrInternalServerError, "peer was added, but the peering config could not be stored: %s", err)
 	}
 
-	// error is ignored because we don't care about the config here
-	if err := deps.PeeringConfigManager.AddPeer(multiAddr, alias); err != nil {
-		Component.LogWarnf("failed to add peer to config, peerID: %s, err: %s", peer.ID.String(), err.Error())
+	return getPeerInfoFromPeer(peer), nil
+}
+
+// updatePeer changes the alias of the peer with the given peerID in the peering config.
+func updatePeer(c echo.Context) (*api.PeerInfo, error) {
+	peerID, err := parsePeerIDParam(c)
+	if err != nil {
+		return nil, err
+	}
+
+	request := &UpdatePeerRequest{}
+	if err := c.Bind(request); err != nil {
+		return nil, ierrors.WithMessagef(httpserver.ErrInvalidParameter, "invalid updatePeerRequest: %s", err)
+	}
+
+	peerConfigItem, err := deps.PeeringConfigManager.SetPeerAlias(peerID, request.Alias)
+	if err != nil {
+		if ierrors.Is(err, network.ErrUnknownPeer) {
+			return nil, ierrors.WithMessagef(echo.ErrNotFound, "peer not found in the peering config, peerID: %s", peerID.String())
+		}
+
+		return nil, ierrors.WithMessagef(echo.ErrInternalServerError, "the peering config could not be stored: %s", err)
+	}
+
+	peer, err := deps.NetworkManager.ManualPeer(peerID)
+	if err != nil {
+		// the peer is only known by the peering config, e.g. because adding it to the manual peering failed.
+		multiAddr, err := multiaddr.NewMultiaddr(peerConfigItem.MultiAddress)
+		if err != nil {
+			return nil, ierrors.WithMessagef(echo.ErrInternalServerError, "invalid multiAddress in peering config (%s): %s", peerConfigItem.MultiAddress, err)
+		}
+
+		if peer, err = network.NewPeerFromMultiAddr(multiAddr); err != nil {
+			return nil, ierrors.WithMessagef(echo.ErrInternalServerError, "invalid peer in peering config (%s): %s", peerConfigItem.MultiAddress, err)
+		}
 	}
 
 	return getPeerInfoFromPeer(peer), nil
 }
+
+// reloadPeers reloads the peering config file and applies the changes to the manual peers without restarting the node.
+func reloadPeers() (*ReloadPeersResponse, error) {
+	changes, err := deps.PeeringConfigManager.Reload()
+	if err != nil {
+		return nil, ierrors.WithMessagef(echo.ErrInternalServerError, "reloading the peering config failed: %s", err)
+	}
+
+	resp := &ReloadPeersResponse{
+		Added:    make([]string, 0, len(changes.Added)),
+		Modified: make([]string, 0, len(changes.Modified)),
+		Removed:  make([]string, 0, len(changes.Removed)),
+	}
+
+	for _, peerConfigItem := range append(changes.Removed, changes.Modified...) {
+		if err := deps.NetworkManager.RemovePeer(peerConfigItem.ID().PeerID()); err != nil {
+			Component.LogWarnf("failed to remove peer %s: %s", peerConfigItem.MultiAddress, err)
+		}
+	}
+
+	addManualPeer := func(peerConfigItem *p2p.PeerConfigItem) {
+		multiAddr, err := multiaddr.NewMultiaddr(peerConfigItem.MultiAddress)
+		if err != nil {
+			Component.LogWarnf("invalid peer address %s: %s", peerConfigItem.MultiAddress, err)
+
+			return
+		}
+
+		if _, err := deps.NetworkManager.AddManualPeer(multiAddr); err != nil {
+			Component.LogWarnf("failed to add peer %s: %s", peerConfigItem.MultiAddress, err)
+		}
+	}
+
+	for _, peerConfigItem := range changes.Removed {
+		resp.Removed = append(resp.Removed, peerConfigItem.ID().String())
+	}
+
+	for _, peerConfigItem := range changes.Modified {
+		addManualPeer(peerConfigItem)
+		resp.Modified = append(resp.Modified, peerConfigItem.ID().String())
+	}
+
+	for _, peerConfigItem := range changes.Added {
+		addManualPeer(peerConfigItem)
+		resp.Added = append(resp.Added, peerConfigItem.ID().String())
+	}
+
+	sort.Strings(resp.Added)
+	sort.Strings(resp.Modified)
+	sort.Strings(resp.Removed)
+
+	return resp, nil
+}
@@ -1,22 +1,25 @@
 package management
 
 import (
+	"context"
+
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/gommon/bytes"
 
 	"github.com/iotaledger/hive.go/ierrors"
 	"github.com/iotaledger/inx-app/pkg/httpserver"
+	iotago "github.com/iotaledger/iota.go/v4"
 	"github.com/iotaledger/iota.go/v4/api"
 )
 
-func pruneDatabase(c echo.Context) (*api.PruneDatabaseResponse, error) {
+func pruneDatabase(c echo.Context) (*JobResponse, error) {
 	if deps.Protocol.Engines.Main.Get().IsSnapshotting() || deps.Protocol.Engines.Main.Get().Storage.IsPruning() {
 		return nil, ierrors.WithMessage(echo.ErrServiceUnavailable, "node is already creating a snapshot or pruning is running")
 	}
 
 	request := &api.PruneDatabaseRequest{}
 	if err := c.Bind(request); err != nil {
-		return nil, ierrors.WithMessagef(httpserver.ErrInvalidParameter, "invalid request, error: %w", err)
+		return nil, ierrors.WithMessagef(httpserver.ErrInvalidParameter, "invalid request, error: %s", err)
 	}
 
 	// only allow one type of pruning at a time
@@ -27,40 +30,49 @@ func pruneDatabase(c echo.Context) (*api.PruneDatabaseResponse, error) {
 		return nil, ierrors.WithMessage(httpserver.ErrInvalidParameter, "either epoch, depth or size has to be specified")
 	}
 
-	var err error
-
-	if request.Epoch != 0 {
-		err = deps.Protocol.Engines.Main.Get().Storage.PruneByEpochIndex(request.Epoch)
-		if err != nil {
-			return nil, ierrors.WithMessagef(echo.ErrInternalServerError, "pruning database failed: %w", err)
+	var targetDatabaseSizeBytes int64
+	if request.TargetDatabaseSize != "" {
+		var err error
+		if targetDatabaseSizeBytes, err = bytes.Parse(request.TargetDatabaseSize); err != nil {
+			return nil, ierrors.WithMessagef(httpserver.ErrInvalidParameter, "invalid target database size, error: %s", err)
 		}
 	}
 
-	if request.Depth != 0 {
-		_, _, err := deps.Protocol.Engines.Main.Get().Storage.PruneByDepth(request.Depth)
-		if err != nil {
-			return nil, ierrors.WithMessagef(echo.ErrInternalServerError, "pruning database failed: %w", err)
+	// the job keeps pruning the storage of the engine that was the main engine when the job was started.
+	engineStorage := deps.Protocol.Engines.Main.Get().Storage
+
+	j, err := jobs.Start(JobTypePrune, func(ctx context.Context, j *job) (any, error) {
+		progressFunc := func(iotago.EpochIndex) {
+			j.epochsPruned.Add(1)
 		}
-	}
 
-	if request.TargetDatabaseSize != "" {
-		pruningTargetDatabaseSizeBytes, err := bytes.Parse(request.TargetDatabaseSize)
-		if err != nil {
-			return nil, ierrors.WithMessagef(echo.ErrInternalServerError, "pruning database failed: %w", err)
+		var err error
+
+		switch {
+		case request.Epoch != 0:
+			err = engineStorage.PruneByEpochIndexWithContext(ctx, request.Epoch, progressFunc)
+		case request.Depth != 0:
+			_, _, err = engineStorage.PruneByDepthWithContext(ctx, request.Depth, progressFunc)
+		default:
+			err = engineStorage.PruneBySizeWithContext(ctx, progressFunc, targetDatabaseSizeBytes)
 		}
 
-		err = deps.Protocol.Engines.Main.Get().Storage.PruneBySize(pruningTargetDatabaseSizeBytes)
 		if err != nil {
-			return nil, ierrors.WithMessagef(echo.ErrInternalServerError, "pruning database failed: %w", err)
+			return nil, ierrors.Wrap(err, "pruning database failed")
+		}
+
+		oldestEpoch, hasPruned := engineStorage.LastPrunedEpoch()
+		if hasPruned {
+			oldestEpoch++
 		}
-	}
 
-	targetEpoch, hasPruned := deps.Protocol.Engines.Main.Get().Storage.LastPrunedEpoch()
-	if hasPruned {
-		targetEpoch++
+		return &PruneJobResult{
+			Epoch: oldestEpoch,
+		}, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return &api.PruneDatabaseResponse{
-		Epoch: targetEpoch,
-	}, nil
+	return j.Response(), nil
 }
@@ -1,24 +1,60 @@
 package management
 
 import (
+	"context"
+
 	"github.com/labstack/echo/v4"
 
 	"github.com/iotaledger/hive.go/ierrors"
-	"github.com/iotaledger/iota.go/v4/api"
 )
 
-func createSnapshots(_ echo.Context) (*api.CreateSnapshotResponse, error) {
+func createSnapshots(_ echo.Context) (*JobResponse, error) {
 	if deps.Protocol.Engines.Main.Get().IsSnapshotting() || deps.Protocol.Engines.Main.Get().Storage.IsPruning() {
 		return nil, ierrors.WithMessage(echo.ErrServiceUnavailable, "node is already creating a snapshot or pruning is running")
 	}
 
-	targetSlot, filePath, err := deps.Protocol.Engines.Main.Get().ExportSnapshot(deps.SnapshotFilePath, true, true)
+	engine := deps.Protocol.Engines.Main.Get()
+
+	j, err := jobs.Start(JobTypeSnapshot, func(ctx context.Context, j *job) (any, error) {
+		targetSlot, filePath, err := engine.ExportSnapshotWithContext(ctx, deps.SnapshotFilePath, true, true, j.bytesWritten.Store)
+		if err != nil {
+			return nil, ierrors.Wrap(err, "creating snapshot failed")
+		}
+
+		return &SnapshotJobResult{
+			Slot:     targetSlot,
+			FilePath: filePath,
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return j.Response(), nil
+}
+
+func listSnapshots(_ echo.Context) (*SnapshotsResponse, error) {
+	files, err := deps.SnapshotDirectory.List()
 	if err != nil {
-		return nil, ierrors.WithMessagef(echo.ErrInternalServerError, "creating snapshot failed: %w", err)
+		return nil, ierrors.WithMessagef(echo.ErrInternalServerError, "listing snapshots failed: %s", err)
+	}
+
+	resp := &SnapshotsResponse{
+		Snapshots: make([]*SnapshotInfo, 0, len(files)),
+	}
+
+	for _, file := range files {
+		resp.Snapshots = append(resp.Snapshots, &SnapshotInfo{
+			Slot:         file.Slot,
+			FilePath:     file.Path,
+			Size:         file.Size,
+			ModifiedTime: file.ModTime,
+		})
+	}
+
+	if len(files) > 0 {
+		resp.LatestFilePath = deps.SnapshotDirectory.LatestPath()
 	}
 
-	return &api.CreateSnapshotResponse{
-		Slot:     targetSlot,
-		FilePath: filePath,
-	}, nil
+	return resp, nil
 }
@@ -0,0 +1,150 @@
+package management
+
+import (
+	"slices"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+	"github.com/iotaledger/iota-core/pkg/webhooks"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/api"
+)
+
+// webhookStates are the transaction states that are delivered to webhooks.
+var webhookStates = []string{
+	api.TransactionStateAccepted.String(),
+	api.TransactionStateCommitted.String(),
+	api.TransactionStateFinalized.String(),
+	api.TransactionStateFailed.String(),
+}
+
+func newWebhookResponse(webhook *webhooks.Webhook, withSecret bool) *WebhookResponse {
+	response := &WebhookResponse{
+		ID:             webhook.ID,
+		URL:            webhook.URL,
+		TransactionIDs: webhook.TransactionIDs,
+		Addresses:      webhook.Addresses,
+		States:         webhook.States,
+		CreatedAt:      webhook.CreatedAt,
+	}
+
+	if withSecret {
+		response.Secret = webhook.Secret
+	}
+
+	return response
+}
+
+// listWebhooks returns all registered webhooks without their secrets.
+func listWebhooks() *WebhooksResponse {
+	registeredWebhooks := deps.WebhooksManager.Webhooks()
+
+	response := &WebhooksResponse{
+		Webhooks: make([]*WebhookResponse, 0, len(registeredWebhooks)),
+	}
+	for _, webhook := range registeredWebhooks {
+		response.Webhooks = append(response.Webhooks, newWebhookResponse(webhook, false))
+	}
+
+	return response
+}
+
+// addWebhook registers the webhook given in the request and returns it together with its secret.
+func addWebhook(c echo.Context) (*WebhookResponse, error) {
+	request := &AddWebhookRequest{}
+	if err := c.Bind(request); err != nil {
+		return nil, ierrors.WithMessagef(httpserver.ErrInvalidParameter, "invalid addWebhookRequest: %s", err)
+	}
+
+	webhook := &webhooks.Webhook{
+		URL:    request.URL,
+		Secret: request.Secret,
+	}
+
+	// the transaction IDs and addresses are normalized, so that they match the encoding of the events.
+	for _, transactionIDHex := range request.TransactionIDs {
+		transactionID, err := iotago.TransactionIDFromHexString(transactionIDHex)
+		if err != nil {
+			return nil, ierrors.WithMessagef(httpserver.ErrInvalidParameter, "invalid transaction ID %s: %s", transactionIDHex, err)
+		}
+
+		webhook.TransactionIDs = append(webhook.TransactionIDs, transactionID.ToHex())
+	}
+
+	expectedHRP := deps.Protocol.CommittedAPI().ProtocolParameters().Bech32HRP()
+	for _, bech32Address := range request.Addresses {
+		hrp, address, err := iotago.ParseBech32(bech32Address)
+		if err != nil {
+			return nil, ierrors.WithMessagef(httpserver.ErrInvalidParameter, "invalid address %s: %s", bech32Address, err)
+		}
+
+		if hrp != expectedHRP {
+			return nil, ierrors.WithMessagef(httpserver.ErrInvalidParameter, "invalid bech32 address prefix %s, expected %s", hrp, expectedHRP)
+		}
+
+		webhook.Addresses = append(webhook.Addresses, address.Bech32(hrp))
+	}
+
+	for _, state := range request.States {
+		if !slices.Contains(webhookStates, state) {
+			return nil, ierrors.WithMessagef(httpserver.ErrInvalidParameter, "invalid state %s, valid states are %v", state, webhookStates)
+		}
+
+		webhook.States = append(webhook.States, state)
+	}
+
+	addedWebhook, err := deps.WebhooksManager.AddWebhook(webhook)
+	if err != nil {
+		switch {
+		case ierrors.Is(err, webhooks.ErrInvalidWebhook):
+			return nil, ierrors.WithMessage(httpserver.ErrInvalidParameter, err.Error())
+		case ierrors.Is(err, webhooks.ErrTooManyWebhooks):
+			return nil, ierrors.WithMessage(echo.ErrForbidden, err.Error())
+		default:
+			return nil, ierrors.WithMessagef(echo.ErrInternalServerError, "failed to add webhook: %s", err)
+		}
+	}
+
+	return newWebhookResponse(addedWebhook, true), nil
+}
+
+// getWebhook returns the webhook with the ID given in the request without its secret.
+func getWebhook(c echo.Context) (*WebhookResponse, error) {
+	webhook, err := deps.WebhooksManager.Webhook(c.Param(ParameterWebhookID))
+	if err != nil {
+		return nil, webhookError(err, "failed to get webhook")
+	}
+
+	return newWebhookResponse(webhook, false), nil
+}
+
+// removeWebhook removes the webhook with the ID given in the request.
+func removeWebhook(c echo.Context) error {
+	if err := deps.WebhooksManager.RemoveWebhook(c.Param(ParameterWebhookID)); err != nil {
+		return webhookError(err, "failed to remove webhook")
+	}
+
+	return nil
+}
+
+// listWebhookDeliveries returns the pending deliveries of the webhook with the ID given in the request.
+func listWebhookDeliveries(c echo.Context) (*WebhookDeliveriesResponse, error) {
+	deliveries, err := deps.WebhooksManager.PendingDeliveries(c.Param(ParameterWebhookID))
+	if err != nil {
+		return nil, webhookError(err, "failed to get webhook deliveries")
+	}
+
+	return &WebhookDeliveriesResponse{
+		Deliveries: deliveries,
+	}, nil
+}
+
+func webhookError(err error, message string) error {
+	if ierrors.Is(err, webhooks.ErrWebhookNotFound) {
+		return ierrors.WithMessage(echo.ErrNotFound, err.Error())
+	}
+
+	return ierrors.WithMessagef(echo.ErrInternalServerError, "%s: %s", message, err)
+}
@@ -1,6 +1,8 @@
 package restapi
 
 import (
+	"time"
+
 	"github.com/iotaledger/hive.go/app"
 )
 
@@ -30,6 +32,19 @@ type ParametersRestAPI struct {
 		// the maximum number of results that may be returned by an endpoint
 		MaxResults int `default:"1000" usage:"the maximum number of results that may be returned by an endpoint"`
 	}
+
+	EventStream struct {
+		// whether the WebSocket and Server-Sent-Events endpoints for live events are enabled
+		Enabled bool `default:"true" usage:"whether the WebSocket and Server-Sent-Events endpoints for live events are enabled"`
+		// the maximum number of clients that can be connected to the event stream at the same time
+		MaxClients int `default:"1000" usage:"the maximum number of clients that can be connected to the event stream at the same time"`
+		// the maximum number of topics a single client can subscribe to
+		MaxTopicsPerClient int `default:"100" usage:"the maximum number of topics a single client can subscribe to"`
+		// the number of events that are buffered for a client before it gets disconnected for being too slow
+		ClientBufferSize int `default:"1000" usage:"the number of events that are buffered for a client before it gets disconnected for being too slow"`
+		// the interval in which keep-alive messages are sent to the clients
+		KeepAliveInterval time.Duration `default:"30s" usage:"the interval in which keep-alive messages are sent to the clients"`
+	}
 }
 
 var ParamsRestAPI = &ParametersRestAPI{
@@ -46,6 +61,8 @@ var ParamsRestAPI = &ParametersRestAPI{
 		"/api/core/v3/validators*",
 		"/api/core/v3/rewards*",
 		"/api/core/v3/committee*",
+		"/api/core/v3/events*",
+		"/api/core/v3/batch*",
 		"/api/debug/v2/*",
 		"/api/indexer/v2/*",
 		"/api/mqtt/v2",
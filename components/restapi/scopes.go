@@ -0,0 +1,26 @@
+package restapi
+
+import (
+	"net/http"
+
+	"github.com/iotaledger/iota-core/pkg/jwt"
+	"github.com/iotaledger/iota-core/pkg/restapi"
+)
+
+// routeScopes defines the scopes that grant access to the protected routes if they are called with a scoped JWT.
+// The first matching rule wins and routes without a matching rule can only be called with a JWT without scopes.
+var routeScopes = []*restapi.ScopeRule{
+	{Route: "/api/routes"},
+	{Method: http.MethodPost, Route: "/api/core/v3/blocks", Scopes: []string{jwt.ScopeBlocksSubmit}},
+	{Route: "/api/core/v3/*", Scopes: []string{jwt.ScopeCoreRead}},
+	{Method: http.MethodGet, Route: "/api/management/v1/*", Scopes: []string{jwt.ScopeManagementRead}},
+	{Route: "/api/management/v1/peers*", Scopes: []string{jwt.ScopeManagementPeers}},
+	{Route: "/api/management/v1/bans*", Scopes: []string{jwt.ScopeManagementPeers}},
+	{Route: "/api/management/v1/storage/database/prune", Scopes: []string{jwt.ScopeManagementPrune}},
+	{Route: "/api/management/v1/snapshots*", Scopes: []string{jwt.ScopeManagementSnapshots}},
+	// the jobs either prune the database or create snapshots, so both scopes are allowed to cancel them.
+	{Route: "/api/management/v1/jobs*", Scopes: []string{jwt.ScopeManagementPrune, jwt.ScopeManagementSnapshots}},
+	{Route: "/api/management/v1/webhooks*", Scopes: []string{jwt.ScopeManagementWebhooks}},
+	{Route: "/api/indexer/*", Scopes: []string{jwt.ScopeIndexer}},
+	{Route: "/api/debug/*", Scopes: []string{jwt.ScopeDebug}},
+}
@@ -0,0 +1,114 @@
+package webhooks
+
+import (
+	"context"
+
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/hive.go/app"
+	hivedb "github.com/iotaledger/hive.go/db"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/runtime/event"
+	"github.com/iotaledger/hive.go/runtime/workerpool"
+	"github.com/iotaledger/iota-core/pkg/daemon"
+	"github.com/iotaledger/iota-core/pkg/protocol"
+	"github.com/iotaledger/iota-core/pkg/requesthandler"
+	"github.com/iotaledger/iota-core/pkg/storage/database"
+	webhookspkg "github.com/iotaledger/iota-core/pkg/webhooks"
+	"github.com/iotaledger/iota.go/v4/api"
+)
+
+func init() {
+	Component = &app.Component{
+		Name:      "Webhooks",
+		DepsFunc:  func(cDeps dependencies) { deps = cDeps },
+		Params:    params,
+		Provide:   provide,
+		Configure: configure,
+		Run:       run,
+		IsEnabled: func(_ *dig.Container) bool {
+			return ParamsWebhooks.Enabled
+		},
+	}
+}
+
+var (
+	Component *app.Component
+	deps      dependencies
+
+	// webhooksStore is the database the webhooks and their pending deliveries are stored in.
+	webhooksStore kvstore.KVStore
+)
+
+type dependencies struct {
+	dig.In
+
+	Protocol        *protocol.Protocol
+	RequestHandler  *requesthandler.RequestHandler
+	WebhooksManager *webhookspkg.Manager
+}
+
+func provide(c *dig.Container) error {
+	type managerDeps struct {
+		dig.In
+		DatabaseEngine hivedb.Engine `name:"databaseEngine"`
+	}
+
+	if err := c.Provide(func(deps managerDeps) *webhookspkg.Manager {
+		if ParamsWebhooks.WorkerCount <= 0 {
+			Component.LogPanicf("parameter %s has to be greater than 0", Component.App().Config().GetParameterPath(&(ParamsWebhooks.WorkerCount)))
+		}
+
+		var err error
+		if webhooksStore, err = database.StoreWithDefaultSettings(ParamsWebhooks.DatabasePath, true, deps.DatabaseEngine); err != nil {
+			Component.LogPanicf("failed to open webhooks database: %s", err)
+		}
+
+		manager, err := webhookspkg.NewManager(Component.Logger, webhooksStore,
+			webhookspkg.WithMaxWebhooks(ParamsWebhooks.MaxWebhooks),
+			webhookspkg.WithMaxPendingDeliveries(ParamsWebhooks.MaxPendingDeliveries),
+			webhookspkg.WithMaxAttempts(ParamsWebhooks.MaxAttempts),
+			webhookspkg.WithRetryInterval(ParamsWebhooks.RetryInterval),
+			webhookspkg.WithMaxRetryInterval(ParamsWebhooks.MaxRetryInterval),
+			webhookspkg.WithRequestTimeout(ParamsWebhooks.RequestTimeout),
+			webhookspkg.WithWorkerCount(ParamsWebhooks.WorkerCount),
+		)
+		if err != nil {
+			Component.LogPanicf("failed to load webhooks: %s", err)
+		}
+
+		return manager
+	}); err != nil {
+		Component.LogPanic(err.Error())
+	}
+
+	return nil
+}
+
+func configure() error {
+	Component.LogInfof("Loaded %d webhooks", len(deps.WebhooksManager.Webhooks()))
+
+	return nil
+}
+
+func run() error {
+	return Component.Daemon().BackgroundWorker("Webhooks", func(ctx context.Context) {
+		// a single worker is used, so that the state changes of a transaction are delivered in the order they happened.
+		workerPool := workerpool.New("Webhooks", workerpool.WithWorkerCount(1)).Start()
+
+		unhook := deps.Protocol.Events.Engine.TransactionRetainer.TransactionStateChanged.Hook(func(metadata *api.TransactionMetadataResponse) {
+			onTransactionStateChanged(metadata)
+		}, event.WithWorkerPool(workerPool)).Unhook
+
+		deps.WebhooksManager.Run(ctx)
+
+		Component.LogInfo("Gracefully shutting down the webhooks...")
+		unhook()
+		workerPool.Shutdown()
+		workerPool.ShutdownComplete.Wait()
+
+		if err := webhooksStore.Close(); err != nil {
+			Component.LogErrorf("failed to close webhooks database: %s", err)
+		}
+	}, daemon.PriorityWebhooks)
+}
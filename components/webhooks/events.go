@@ -0,0 +1,111 @@
+package webhooks
+
+import (
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/mempool"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/utxoledger"
+	webhookspkg "github.com/iotaledger/iota-core/pkg/webhooks"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/api"
+)
+
+func onTransactionStateChanged(metadata *api.TransactionMetadataResponse) {
+	// resolving the addresses is expensive, so it is skipped if nobody is interested.
+	if !deps.WebhooksManager.HasWebhooks() {
+		return
+	}
+
+	encodedMetadata, err := deps.Protocol.CommittedAPI().JSONEncode(metadata)
+	if err != nil {
+		Component.LogWarnf("failed to encode metadata of transaction %s: %s", metadata.TransactionID.ToHex(), err)
+
+		return
+	}
+
+	if err := deps.WebhooksManager.Notify(&webhookspkg.Event{
+		TransactionID: metadata.TransactionID.ToHex(),
+		Addresses:     transactionAddresses(metadata.TransactionID),
+		State:         metadata.TransactionState.String(),
+		Metadata:      encodedMetadata,
+	}); err != nil {
+		Component.LogWarnf("failed to notify webhooks about transaction %s: %s", metadata.TransactionID.ToHex(), err)
+	}
+}
+
+// transactionAddresses returns the bech32 encoded addresses that own the inputs and outputs of the transaction.
+// The transaction is taken from the mempool, or from its block if it was already evicted from the mempool.
+func transactionAddresses(transactionID iotago.TransactionID) []string {
+	engine := deps.Protocol.Engines.Main.Get()
+	bech32HRP := deps.Protocol.CommittedAPI().ProtocolParameters().Bech32HRP()
+
+	addresses := make(map[string]struct{})
+	addOutputAddresses := func(output iotago.Output) {
+		for _, address := range utxoledger.OwnerAddresses(output, utxoledger.WithReturnAddresses(true)) {
+			addresses[address.Bech32(bech32HRP)] = struct{}{}
+		}
+	}
+
+	var transaction *iotago.Transaction
+	if transactionMetadata, exists := engine.Ledger.MemPool().TransactionMetadata(transactionID); exists {
+		transaction, _ = transactionMetadata.Transaction().(*iotago.Transaction)
+
+		// the inputs are only known if the transaction was solid.
+		_ = transactionMetadata.Inputs().ForEach(func(stateMetadata mempool.StateMetadata) error {
+			if input, isOutput := stateMetadata.State().(*utxoledger.Output); isOutput {
+				addOutputAddresses(input.Output())
+			}
+
+			return nil
+		})
+	} else if transaction = committedTransaction(transactionID); transaction != nil {
+		for _, input := range transaction.TransactionEssence.Inputs {
+			utxoInput, isUTXOInput := input.(*iotago.UTXOInput)
+			if !isUTXOInput {
+				continue
+			}
+
+			// the spent outputs are kept until they are pruned.
+			output, spent, err := engine.Ledger.OutputOrSpent(utxoInput.OutputID())
+			switch {
+			case err != nil:
+				continue
+			case output != nil:
+				addOutputAddresses(output.Output())
+			default:
+				addOutputAddresses(spent.Output().Output())
+			}
+		}
+	}
+
+	if transaction != nil {
+		for _, output := range transaction.Outputs {
+			addOutputAddresses(output)
+		}
+	}
+
+	result := make([]string, 0, len(addresses))
+	for address := range addresses {
+		result = append(result, address)
+	}
+
+	return result
+}
+
+// committedTransaction returns the transaction from the block it was committed in (nil if it can't be found).
+func committedTransaction(transactionID iotago.TransactionID) *iotago.Transaction {
+	blockID, err := deps.RequestHandler.BlockIDFromTransactionID(transactionID)
+	if err != nil {
+		return nil
+	}
+
+	block, err := deps.RequestHandler.ModelBlockFromBlockID(blockID)
+	if err != nil {
+		return nil
+	}
+
+	signedTransaction, isTransaction := block.SignedTransaction()
+	if !isTransaction {
+		return nil
+	}
+
+	return signedTransaction.Transaction
+}
@@ -0,0 +1,38 @@
+package webhooks
+
+import (
+	"time"
+
+	"github.com/iotaledger/hive.go/app"
+)
+
+// ParametersWebhooks contains the definition of configuration parameters used by the webhooks.
+type ParametersWebhooks struct {
+	// Enabled whether the webhooks component is enabled.
+	Enabled bool `default:"false" usage:"whether the webhooks component is enabled"`
+	// DatabasePath is the path to the database folder the webhooks and their pending deliveries are stored in.
+	DatabasePath string `default:"testnet/webhooks" usage:"the path to the database folder the webhooks and their pending deliveries are stored in"`
+	// MaxWebhooks is the maximum number of registered webhooks.
+	MaxWebhooks int `default:"1000" usage:"the maximum number of registered webhooks (0 = unlimited)"`
+	// MaxPendingDeliveries is the maximum number of pending deliveries, new events are dropped if it is reached.
+	MaxPendingDeliveries int `default:"10000" usage:"the maximum number of pending deliveries, new events are dropped if it is reached (0 = unlimited)"`
+	// MaxAttempts is the maximum number of attempts of a delivery before it is dropped.
+	MaxAttempts int `default:"10" usage:"the maximum number of attempts of a delivery before it is dropped (0 = unlimited)"`
+	// RetryInterval is the time to wait before the first retry of a failed delivery.
+	RetryInterval time.Duration `default:"5s" usage:"the time to wait before the first retry of a failed delivery, it doubles with every failed attempt"`
+	// MaxRetryInterval is the maximum time to wait between two attempts of a delivery.
+	MaxRetryInterval time.Duration `default:"10m" usage:"the maximum time to wait between two attempts of a delivery"`
+	// RequestTimeout is the timeout of a single delivery attempt.
+	RequestTimeout time.Duration `default:"10s" usage:"the timeout of a single delivery attempt"`
+	// WorkerCount is the number of deliveries that are attempted in parallel.
+	WorkerCount int `default:"4" usage:"the number of deliveries that are attempted in parallel"`
+}
+
+// ParamsWebhooks is the default configuration parameters for the webhooks component.
+var ParamsWebhooks = &ParametersWebhooks{}
+
+var params = &app.ComponentParams{
+	Params: map[string]any{
+		"webhooks": ParamsWebhooks,
+	},
+}
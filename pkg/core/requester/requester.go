@@ -0,0 +1,248 @@
+package requester
+
+import (
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/iotaledger/hive.go/core/index"
+	"github.com/iotaledger/hive.go/core/memstorage"
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/hive.go/runtime/syncutils"
+	"github.com/iotaledger/hive.go/runtime/timed"
+)
+
+// RequestFunc is the function that sends the request for an entity to the given peers (or to all peers if no peer is
+// given).
+type RequestFunc[T any] func(id T, to ...peer.ID)
+
+// Requester decides which peers an entity is requested from.
+//
+// Entities are requested from a single peer at a time, preferring the peers that are known to have referenced the
+// entity (e.g. the senders of the children of a missing block), as they most likely know it. If a peer does not deliver
+// the entity within the request timeout, the next peer is asked. Only once all of these peers were asked, the entity is
+// requested from all peers. Further requests for an entity are ignored while a request for it is in flight, so that
+// the same entity is not requested from every peer over and over again.
+type Requester[I index.Type, T index.IndexedID[I]] struct {
+	requestFunc      RequestFunc[T]
+	requests         *memstorage.IndexedStorage[I, T, *request]
+	timedExecutor    *timed.Executor
+	lastEvictedIndex I
+	mutex            syncutils.Mutex
+
+	optsRequestTimeout time.Duration
+	optsMaxHints       int
+}
+
+// request contains the state of the requests for a single entity.
+type request struct {
+	// hints contains the peers that likely know the entity, in the order they were learned.
+	hints []peer.ID
+	// askedPeers contains the hinted peers that were already asked in the current round.
+	askedPeers map[peer.ID]struct{}
+	// timeout is the scheduled timeout of the request that is in flight (nil if no request is in flight).
+	timeout *timed.ScheduledTask
+}
+
+// New creates a new Requester that uses the given function to send the requests.
+func New[I index.Type, T index.IndexedID[I]](requestFunc RequestFunc[T], opts ...options.Option[Requester[I, T]]) *Requester[I, T] {
+	return options.Apply(&Requester[I, T]{
+		requestFunc:        requestFunc,
+		requests:           memstorage.NewIndexedStorage[I, T, *request](),
+		timedExecutor:      timed.NewExecutor(1),
+		optsRequestTimeout: 2 * time.Second,
+		optsMaxHints:       3,
+	}, opts)
+}
+
+// AddHint adds a peer that likely knows the entity with the given ID.
+func (r *Requester[I, T]) AddHint(id T, peerID peer.ID) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if id.Index() <= r.lastEvictedIndex {
+		return
+	}
+
+	req := r.request(id)
+	for _, hint := range req.hints {
+		if hint == peerID {
+			return
+		}
+	}
+
+	// drop the oldest hint if the limit is reached, as it is the least likely to still be a neighbor.
+	if len(req.hints) >= r.optsMaxHints {
+		delete(req.askedPeers, req.hints[0])
+		req.hints = req.hints[1:]
+	}
+
+	req.hints = append(req.hints, peerID)
+}
+
+// Request requests the entity with the given ID, unless a request for it is already in flight.
+func (r *Requester[I, T]) Request(id T) {
+	r.mutex.Lock()
+
+	// the state of evicted entities is not tracked anymore, so they are simply requested from all peers.
+	if id.Index() <= r.lastEvictedIndex {
+		r.mutex.Unlock()
+		r.requestFunc(id)
+
+		return
+	}
+
+	req := r.request(id)
+	if req.timeout != nil {
+		r.mutex.Unlock()
+
+		return
+	}
+
+	r.sendNextRequest(id, req)
+}
+
+// Complete stops requesting the entity with the given ID and forgets about its hints.
+func (r *Requester[I, T]) Complete(id T) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	requests := r.requests.Get(id.Index())
+	if requests == nil {
+		return
+	}
+
+	if req, exists := requests.Get(id); exists {
+		if req.timeout != nil {
+			req.timeout.Cancel()
+		}
+
+		requests.Delete(id)
+	}
+}
+
+// IsRequestInFlight returns true if a request for the entity with the given ID is in flight.
+func (r *Requester[I, T]) IsRequestInFlight(id T) bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	req, exists := r.existingRequest(id)
+
+	return exists && req.timeout != nil
+}
+
+// EvictUntil evicts the state of all entities with an index lower than or equal to the given index.
+func (r *Requester[I, T]) EvictUntil(index I) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if index <= r.lastEvictedIndex {
+		return
+	}
+
+	for currentIndex := r.lastEvictedIndex + 1; currentIndex <= index; currentIndex++ {
+		if evictedRequests := r.requests.Evict(currentIndex); evictedRequests != nil {
+			evictedRequests.ForEach(func(_ T, req *request) bool {
+				if req.timeout != nil {
+					req.timeout.Cancel()
+				}
+
+				return true
+			})
+		}
+	}
+
+	r.lastEvictedIndex = index
+}
+
+// Shutdown cancels all pending request timeouts.
+func (r *Requester[I, T]) Shutdown() {
+	r.timedExecutor.Shutdown(timed.CancelPendingElements)
+}
+
+// request returns the request state of the entity with the given ID (it needs to be called with the mutex locked).
+func (r *Requester[I, T]) request(id T) *request {
+	req, _ := r.requests.Get(id.Index(), true).GetOrCreate(id, func() *request {
+		return &request{
+			askedPeers: make(map[peer.ID]struct{}),
+		}
+	})
+
+	return req
+}
+
+// existingRequest returns the request state of the entity with the given ID if it exists (it needs to be called with
+// the mutex locked).
+func (r *Requester[I, T]) existingRequest(id T) (req *request, exists bool) {
+	requests := r.requests.Get(id.Index())
+	if requests == nil {
+		return nil, false
+	}
+
+	return requests.Get(id)
+}
+
+// sendNextRequest requests the entity from the next hinted peer that was not asked yet, or from all peers if all
+// of them were asked. It needs to be called with the mutex locked and unlocks it before the request is sent.
+func (r *Requester[I, T]) sendNextRequest(id T, req *request) {
+	var nextPeer peer.ID
+	for _, hint := range req.hints {
+		if _, asked := req.askedPeers[hint]; !asked {
+			nextPeer = hint
+			break
+		}
+	}
+
+	if nextPeer != "" {
+		req.askedPeers[nextPeer] = struct{}{}
+		req.timeout = r.timedExecutor.ExecuteAfter(func() { r.onTimeout(id, req, true) }, r.optsRequestTimeout)
+		r.mutex.Unlock()
+
+		r.requestFunc(id, nextPeer)
+
+		return
+	}
+
+	// all hinted peers were asked, so we start over with the next round after requesting it from everybody.
+	req.askedPeers = make(map[peer.ID]struct{})
+	req.timeout = r.timedExecutor.ExecuteAfter(func() { r.onTimeout(id, req, false) }, r.optsRequestTimeout)
+	r.mutex.Unlock()
+
+	r.requestFunc(id)
+}
+
+// onTimeout is called when the request that is in flight timed out. Requests to single peers fall back to the next
+// peer, while requests to all peers are only retried with the next call to Request.
+func (r *Requester[I, T]) onTimeout(id T, req *request, fallback bool) {
+	r.mutex.Lock()
+
+	// ignore the timeout if the request was completed or evicted in the meantime.
+	if currentReq, exists := r.existingRequest(id); !exists || currentReq != req {
+		r.mutex.Unlock()
+
+		return
+	}
+
+	req.timeout = nil
+	if !fallback {
+		r.mutex.Unlock()
+
+		return
+	}
+
+	r.sendNextRequest(id, req)
+}
+
+// WithRequestTimeout sets the time after which the next peer is asked for an entity.
+func WithRequestTimeout[I index.Type, T index.IndexedID[I]](requestTimeout time.Duration) options.Option[Requester[I, T]] {
+	return func(r *Requester[I, T]) {
+		r.optsRequestTimeout = requestTimeout
+	}
+}
+
+// WithMaxHints sets the maximum number of peers that are remembered to likely know an entity.
+func WithMaxHints[I index.Type, T index.IndexedID[I]](maxHints int) options.Option[Requester[I, T]] {
+	return func(r *Requester[I, T]) {
+		r.optsMaxHints = maxHints
+	}
+}
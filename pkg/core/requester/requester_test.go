@@ -0,0 +1,126 @@
+package requester
+
+import (
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/runtime/syncutils"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/tpkg"
+)
+
+// sentRequests records the requests sent by a Requester.
+type sentRequests struct {
+	requests [][]peer.ID
+	mutex    syncutils.Mutex
+}
+
+func (s *sentRequests) send(_ iotago.BlockID, to ...peer.ID) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.requests = append(s.requests, to)
+}
+
+func (s *sentRequests) count() int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return len(s.requests)
+}
+
+func (s *sentRequests) last() []peer.ID {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.requests[len(s.requests)-1]
+}
+
+func newTestRequester(t *testing.T, requestTimeout time.Duration) (*Requester[iotago.SlotIndex, iotago.BlockID], *sentRequests) {
+	sent := &sentRequests{}
+
+	r := New[iotago.SlotIndex, iotago.BlockID](sent.send,
+		WithRequestTimeout[iotago.SlotIndex, iotago.BlockID](requestTimeout),
+		WithMaxHints[iotago.SlotIndex, iotago.BlockID](2),
+	)
+	t.Cleanup(r.Shutdown)
+
+	return r, sent
+}
+
+func TestRequester_Fallback(t *testing.T) {
+	r, sent := newTestRequester(t, 50*time.Millisecond)
+
+	blockID := iotago.NewBlockID(10, tpkg.RandBlockID().Identifier())
+
+	// the oldest hint is dropped as only 2 hints are kept.
+	r.AddHint(blockID, "peer1")
+	r.AddHint(blockID, "peer2")
+	r.AddHint(blockID, "peer2")
+	r.AddHint(blockID, "peer3")
+
+	r.Request(blockID)
+	require.Equal(t, 1, sent.count())
+	require.Equal(t, []peer.ID{"peer2"}, sent.last())
+	require.True(t, r.IsRequestInFlight(blockID))
+
+	// duplicate requests are ignored while the request is in flight.
+	r.Request(blockID)
+	require.Equal(t, 1, sent.count())
+
+	// after the timeout, the next hinted peer is asked and then all peers.
+	require.Eventually(t, func() bool { return sent.count() == 3 }, time.Second, 5*time.Millisecond)
+	require.Equal(t, []peer.ID{"peer3"}, sent.requests[1])
+	require.Empty(t, sent.last())
+
+	// after the request to all peers timed out, the next request starts over with the hinted peers.
+	require.Eventually(t, func() bool { return !r.IsRequestInFlight(blockID) }, time.Second, 5*time.Millisecond)
+	require.Equal(t, 3, sent.count())
+
+	r.Request(blockID)
+	require.Equal(t, 4, sent.count())
+	require.Equal(t, []peer.ID{"peer2"}, sent.last())
+
+	// completing the request stops the fallback.
+	r.Complete(blockID)
+	require.False(t, r.IsRequestInFlight(blockID))
+	time.Sleep(100 * time.Millisecond)
+	require.Equal(t, 4, sent.count())
+}
+
+func TestRequester_NoHints(t *testing.T) {
+	r, sent := newTestRequester(t, time.Minute)
+
+	blockID := iotago.NewBlockID(10, tpkg.RandBlockID().Identifier())
+
+	r.Request(blockID)
+	require.Equal(t, 1, sent.count())
+	require.Empty(t, sent.last())
+
+	r.Request(blockID)
+	require.Equal(t, 1, sent.count())
+}
+
+func TestRequester_Eviction(t *testing.T) {
+	r, sent := newTestRequester(t, time.Minute)
+
+	blockID := iotago.NewBlockID(10, tpkg.RandBlockID().Identifier())
+
+	r.AddHint(blockID, "peer1")
+	r.Request(blockID)
+	require.Equal(t, []peer.ID{"peer1"}, sent.last())
+
+	r.EvictUntil(10)
+	require.False(t, r.IsRequestInFlight(blockID))
+
+	// evicted blocks are not tracked anymore and always requested from all peers.
+	r.AddHint(blockID, "peer1")
+	r.Request(blockID)
+	r.Request(blockID)
+	require.Equal(t, 3, sent.count())
+	require.Empty(t, sent.last())
+	require.False(t, r.IsRequestInFlight(blockID))
+}
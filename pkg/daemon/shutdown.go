@@ -7,7 +7,11 @@ const (
 	PriorityCloseDatabase = iota // no dependencies
 	PriorityP2P
 	PriorityProtocol
+	PrioritySnapshotScheduler // depends on Protocol
+	PriorityIndexer           // depends on Protocol
+	PriorityWebhooks          // depends on Protocol
 	PriorityRestAPI
+	PriorityGRPCAPI
 	PriorityINX
 	PriorityDashboardMetrics
 	PriorityDashboard
@@ -0,0 +1,312 @@
+package eventstream
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/hive.go/runtime/syncutils"
+)
+
+var (
+	// ErrBrokerShutdown is returned if a subscription is created after the broker was shut down.
+	ErrBrokerShutdown = ierrors.New("event broker was shut down")
+	// ErrTooManySubscriptions is returned if the maximum number of subscriptions of the broker is reached.
+	ErrTooManySubscriptions = ierrors.New("too many subscriptions")
+	// ErrTooManyTopics is returned if the maximum number of topics of a subscription is reached.
+	ErrTooManyTopics = ierrors.New("too many topics")
+	// ErrSubscriptionClosed is returned if topics are added to a subscription that was closed.
+	ErrSubscriptionClosed = ierrors.New("subscription was closed")
+	// ErrSlowSubscription is the reason a subscription gets closed if it does not consume its events fast enough.
+	ErrSlowSubscription = ierrors.New("subscription did not consume its events fast enough")
+)
+
+// Event is a payload that was published on a topic.
+type Event struct {
+	// Topic is the topic the event was published on.
+	Topic string
+	// Payload is the encoded payload of the event.
+	Payload []byte
+}
+
+// Broker distributes the events that are published on topics to the subscriptions of these topics.
+//
+// Publishing never blocks: every subscription buffers its events, and a subscription that does not consume its events
+// fast enough is closed, so that a single slow client can't hold back the node or the other clients.
+type Broker struct {
+	subscriptions      map[*Subscription]struct{}
+	topicSubscriptions map[string]map[*Subscription]struct{}
+	isShutdown         bool
+	mutex              syncutils.RWMutex
+
+	optsMaxSubscriptions int
+	optsMaxTopics        int
+	optsBufferSize       int
+}
+
+// NewBroker creates a new Broker.
+func NewBroker(opts ...options.Option[Broker]) *Broker {
+	return options.Apply(&Broker{
+		subscriptions:        make(map[*Subscription]struct{}),
+		topicSubscriptions:   make(map[string]map[*Subscription]struct{}),
+		optsMaxSubscriptions: 1000,
+		optsMaxTopics:        100,
+		optsBufferSize:       1000,
+	}, opts)
+}
+
+// Subscribe creates a new subscription without any topics.
+func (b *Broker) Subscribe() (*Subscription, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.isShutdown {
+		return nil, ErrBrokerShutdown
+	}
+
+	if b.optsMaxSubscriptions > 0 && len(b.subscriptions) >= b.optsMaxSubscriptions {
+		return nil, ierrors.WithMessagef(ErrTooManySubscriptions, "the limit of %d subscriptions is reached", b.optsMaxSubscriptions)
+	}
+
+	subscription := &Subscription{
+		broker: b,
+		events: make(chan *Event, b.optsBufferSize),
+		topics: make(map[string]struct{}),
+		closed: make(chan struct{}),
+	}
+	b.subscriptions[subscription] = struct{}{}
+
+	return subscription, nil
+}
+
+// HasSubscribers returns true if at least one of the given topics has subscribers.
+func (b *Broker) HasSubscribers(topics ...string) bool {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	for _, topic := range topics {
+		if len(b.topicSubscriptions[topic]) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Publish sends the payload to the subscriptions of the given topics. The payload is only encoded (once) if at least
+// one of the topics has subscribers.
+func (b *Broker) Publish(encodePayload func() ([]byte, error), topics ...string) error {
+	if !b.HasSubscribers(topics...) {
+		return nil
+	}
+
+	payload, err := encodePayload()
+	if err != nil {
+		return ierrors.Wrap(err, "failed to encode event payload")
+	}
+
+	var slowSubscriptions []*Subscription
+
+	b.mutex.RLock()
+	for _, topic := range topics {
+		event := &Event{
+			Topic:   topic,
+			Payload: payload,
+		}
+
+		for subscription := range b.topicSubscriptions[topic] {
+			select {
+			case subscription.events <- event:
+			default:
+				slowSubscriptions = append(slowSubscriptions, subscription)
+			}
+		}
+	}
+	b.mutex.RUnlock()
+
+	// the subscriptions are closed after releasing the lock, as closing them needs to modify the subscriptions.
+	for _, subscription := range slowSubscriptions {
+		subscription.closeWithError(ErrSlowSubscription)
+	}
+
+	return nil
+}
+
+// SubscriptionCount returns the number of active subscriptions.
+func (b *Broker) SubscriptionCount() int {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	return len(b.subscriptions)
+}
+
+// Shutdown closes all subscriptions and prevents new ones from being created.
+func (b *Broker) Shutdown() {
+	b.mutex.Lock()
+	b.isShutdown = true
+	subscriptions := make([]*Subscription, 0, len(b.subscriptions))
+	for subscription := range b.subscriptions {
+		subscriptions = append(subscriptions, subscription)
+	}
+	b.mutex.Unlock()
+
+	for _, subscription := range subscriptions {
+		subscription.closeWithError(ErrBrokerShutdown)
+	}
+}
+
+// removeTopicSubscription removes the subscription from the topic (it needs to be called with the mutex locked).
+func (b *Broker) removeTopicSubscription(topic string, subscription *Subscription) {
+	topicSubscriptions, exists := b.topicSubscriptions[topic]
+	if !exists {
+		return
+	}
+
+	delete(topicSubscriptions, subscription)
+	if len(topicSubscriptions) == 0 {
+		delete(b.topicSubscriptions, topic)
+	}
+}
+
+// Subscription receives the events of the topics it is subscribed to.
+type Subscription struct {
+	broker    *Broker
+	events    chan *Event
+	topics    map[string]struct{}
+	closed    chan struct{}
+	closeOnce sync.Once
+	err       error
+}
+
+// Subscribe adds the given topics to the subscription.
+func (s *Subscription) Subscribe(topics ...string) error {
+	s.broker.mutex.Lock()
+	defer s.broker.mutex.Unlock()
+
+	if s.isClosed() {
+		if s.err != nil {
+			return s.err
+		}
+
+		return ErrSubscriptionClosed
+	}
+
+	newTopics := 0
+	for _, topic := range topics {
+		if _, exists := s.topics[topic]; !exists {
+			newTopics++
+		}
+	}
+
+	if s.broker.optsMaxTopics > 0 && len(s.topics)+newTopics > s.broker.optsMaxTopics {
+		return ierrors.WithMessagef(ErrTooManyTopics, "a subscription can't have more than %d topics", s.broker.optsMaxTopics)
+	}
+
+	for _, topic := range topics {
+		s.topics[topic] = struct{}{}
+
+		topicSubscriptions, exists := s.broker.topicSubscriptions[topic]
+		if !exists {
+			topicSubscriptions = make(map[*Subscription]struct{})
+			s.broker.topicSubscriptions[topic] = topicSubscriptions
+		}
+		topicSubscriptions[s] = struct{}{}
+	}
+
+	return nil
+}
+
+// Unsubscribe removes the given topics from the subscription.
+func (s *Subscription) Unsubscribe(topics ...string) {
+	s.broker.mutex.Lock()
+	defer s.broker.mutex.Unlock()
+
+	for _, topic := range topics {
+		delete(s.topics, topic)
+		s.broker.removeTopicSubscription(topic, s)
+	}
+}
+
+// Topics returns the sorted topics of the subscription.
+func (s *Subscription) Topics() []string {
+	s.broker.mutex.RLock()
+	defer s.broker.mutex.RUnlock()
+
+	topics := make([]string, 0, len(s.topics))
+	for topic := range s.topics {
+		topics = append(topics, topic)
+	}
+	sort.Strings(topics)
+
+	return topics
+}
+
+// Events returns the channel the events of the subscription are delivered on.
+func (s *Subscription) Events() <-chan *Event {
+	return s.events
+}
+
+// Closed returns a channel that is closed once the subscription was closed.
+func (s *Subscription) Closed() <-chan struct{} {
+	return s.closed
+}
+
+// Err returns the reason the subscription was closed (nil if it is still open or if it was closed by its owner).
+func (s *Subscription) Err() error {
+	s.broker.mutex.RLock()
+	defer s.broker.mutex.RUnlock()
+
+	return s.err
+}
+
+// Close closes the subscription.
+func (s *Subscription) Close() {
+	s.closeWithError(nil)
+}
+
+func (s *Subscription) closeWithError(err error) {
+	s.closeOnce.Do(func() {
+		s.broker.mutex.Lock()
+		defer s.broker.mutex.Unlock()
+
+		for topic := range s.topics {
+			s.broker.removeTopicSubscription(topic, s)
+		}
+		delete(s.broker.subscriptions, s)
+
+		s.err = err
+		close(s.closed)
+	})
+}
+
+// isClosed returns true if the subscription was closed (it needs to be called with the mutex locked).
+func (s *Subscription) isClosed() bool {
+	select {
+	case <-s.closed:
+		return true
+	default:
+		return false
+	}
+}
+
+// WithMaxSubscriptions sets the maximum number of subscriptions of the broker (0 means unlimited).
+func WithMaxSubscriptions(maxSubscriptions int) options.Option[Broker] {
+	return func(b *Broker) {
+		b.optsMaxSubscriptions = maxSubscriptions
+	}
+}
+
+// WithMaxTopics sets the maximum number of topics of a single subscription (0 means unlimited).
+func WithMaxTopics(maxTopics int) options.Option[Broker] {
+	return func(b *Broker) {
+		b.optsMaxTopics = maxTopics
+	}
+}
+
+// WithBufferSize sets the number of events that are buffered for a subscription before it is considered too slow.
+func WithBufferSize(bufferSize int) options.Option[Broker] {
+	return func(b *Broker) {
+		b.optsBufferSize = bufferSize
+	}
+}
@@ -0,0 +1,164 @@
+package eventstream
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/ierrors"
+)
+
+func payload(data string) func() ([]byte, error) {
+	return func() ([]byte, error) {
+		return []byte(data), nil
+	}
+}
+
+func requireEvents(t *testing.T, subscription *Subscription, expectedEvents ...*Event) {
+	t.Helper()
+
+	for _, expectedEvent := range expectedEvents {
+		select {
+		case event := <-subscription.Events():
+			require.Equal(t, expectedEvent, event)
+		default:
+			require.Failf(t, "missing event", "expected event %s on topic %s", expectedEvent.Payload, expectedEvent.Topic)
+		}
+	}
+
+	require.Empty(t, subscription.Events())
+}
+
+func TestBroker_Publish(t *testing.T) {
+	broker := NewBroker()
+
+	subscription1, err := broker.Subscribe()
+	require.NoError(t, err)
+	require.NoError(t, subscription1.Subscribe("blocks", "blocks/basic"))
+
+	subscription2, err := broker.Subscribe()
+	require.NoError(t, err)
+	require.NoError(t, subscription2.Subscribe("commitments/latest"))
+
+	require.True(t, broker.HasSubscribers("blocks/validation", "blocks"))
+	require.False(t, broker.HasSubscribers("blocks/validation"))
+
+	require.NoError(t, broker.Publish(payload("block"), "blocks", "blocks/basic"))
+	require.NoError(t, broker.Publish(payload("commitment"), "commitments/latest"))
+
+	requireEvents(t, subscription1,
+		&Event{Topic: "blocks", Payload: []byte("block")},
+		&Event{Topic: "blocks/basic", Payload: []byte("block")},
+	)
+	requireEvents(t, subscription2, &Event{Topic: "commitments/latest", Payload: []byte("commitment")})
+
+	subscription1.Unsubscribe("blocks")
+	require.Equal(t, []string{"blocks/basic"}, subscription1.Topics())
+
+	require.NoError(t, broker.Publish(payload("block"), "blocks", "blocks/basic"))
+	requireEvents(t, subscription1, &Event{Topic: "blocks/basic", Payload: []byte("block")})
+	requireEvents(t, subscription2)
+}
+
+func TestBroker_PublishEncodesOnlyWithSubscribers(t *testing.T) {
+	broker := NewBroker()
+
+	encodeCalls := 0
+	encodePayload := func() ([]byte, error) {
+		encodeCalls++
+
+		return []byte("block"), nil
+	}
+
+	require.NoError(t, broker.Publish(encodePayload, "blocks"))
+	require.Equal(t, 0, encodeCalls)
+
+	subscription1, err := broker.Subscribe()
+	require.NoError(t, err)
+	require.NoError(t, subscription1.Subscribe("blocks"))
+
+	subscription2, err := broker.Subscribe()
+	require.NoError(t, err)
+	require.NoError(t, subscription2.Subscribe("blocks", "blocks/basic"))
+
+	require.NoError(t, broker.Publish(encodePayload, "blocks", "blocks/basic"))
+	require.Equal(t, 1, encodeCalls)
+
+	encodeErr := ierrors.New("encoding failed")
+	require.ErrorIs(t, broker.Publish(func() ([]byte, error) { return nil, encodeErr }, "blocks"), encodeErr)
+}
+
+func TestBroker_SlowSubscription(t *testing.T) {
+	broker := NewBroker(WithBufferSize(2))
+
+	slowSubscription, err := broker.Subscribe()
+	require.NoError(t, err)
+	require.NoError(t, slowSubscription.Subscribe("blocks"))
+
+	subscription, err := broker.Subscribe()
+	require.NoError(t, err)
+	require.NoError(t, subscription.Subscribe("blocks"))
+
+	require.NoError(t, broker.Publish(payload("block1"), "blocks"))
+	require.NoError(t, broker.Publish(payload("block2"), "blocks"))
+
+	requireEvents(t, subscription,
+		&Event{Topic: "blocks", Payload: []byte("block1")},
+		&Event{Topic: "blocks", Payload: []byte("block2")},
+	)
+
+	// the buffer of the slow subscription is full, so it gets closed while the other subscription keeps receiving events.
+	require.NoError(t, broker.Publish(payload("block3"), "blocks"))
+
+	select {
+	case <-slowSubscription.Closed():
+	default:
+		require.Fail(t, "slow subscription was not closed")
+	}
+	require.ErrorIs(t, slowSubscription.Err(), ErrSlowSubscription)
+	require.ErrorIs(t, slowSubscription.Subscribe("blocks"), ErrSlowSubscription)
+	require.Equal(t, 1, broker.SubscriptionCount())
+
+	requireEvents(t, subscription, &Event{Topic: "blocks", Payload: []byte("block3")})
+}
+
+func TestBroker_Limits(t *testing.T) {
+	broker := NewBroker(WithMaxSubscriptions(1), WithMaxTopics(2))
+
+	subscription, err := broker.Subscribe()
+	require.NoError(t, err)
+
+	_, err = broker.Subscribe()
+	require.ErrorIs(t, err, ErrTooManySubscriptions)
+
+	require.NoError(t, subscription.Subscribe("blocks", "blocks/basic"))
+	require.NoError(t, subscription.Subscribe("blocks"))
+	require.ErrorIs(t, subscription.Subscribe("blocks/validation"), ErrTooManyTopics)
+	require.Equal(t, []string{"blocks", "blocks/basic"}, subscription.Topics())
+
+	// closing a subscription frees its slot.
+	subscription.Close()
+	require.NoError(t, subscription.Err())
+	require.ErrorIs(t, subscription.Subscribe("blocks"), ErrSubscriptionClosed)
+	require.False(t, broker.HasSubscribers("blocks", "blocks/basic"))
+
+	_, err = broker.Subscribe()
+	require.NoError(t, err)
+}
+
+func TestBroker_Shutdown(t *testing.T) {
+	broker := NewBroker()
+
+	subscription, err := broker.Subscribe()
+	require.NoError(t, err)
+	require.NoError(t, subscription.Subscribe("blocks"))
+
+	broker.Shutdown()
+
+	<-subscription.Closed()
+	require.ErrorIs(t, subscription.Err(), ErrBrokerShutdown)
+	require.Equal(t, 0, broker.SubscriptionCount())
+
+	_, err = broker.Subscribe()
+	require.ErrorIs(t, err, ErrBrokerShutdown)
+}
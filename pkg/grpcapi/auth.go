@@ -0,0 +1,147 @@
+package grpcapi
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotaledger/iota-core/pkg/jwt"
+	"github.com/iotaledger/iota-core/pkg/restapi"
+)
+
+const (
+	// MetadataKeyAuthorization is the metadata key that contains the JWT of a call in the form "Bearer <token>".
+	MetadataKeyAuthorization = "authorization"
+
+	bearerPrefix = "bearer "
+)
+
+// Authenticator authorizes the calls to the gRPC API the same way the REST API authorizes its routes.
+// Public methods can be called by everyone, protected methods need a valid JWT and all other methods are forbidden.
+// A scoped JWT additionally needs one of the scopes of the first scope rule that matches the method.
+type Authenticator struct {
+	jwtAuth          *jwt.Auth
+	publicMethods    []*regexp.Regexp
+	protectedMethods []*regexp.Regexp
+	scopeRules       *restapi.ScopeRules
+}
+
+// NewAuthenticator creates a new Authenticator for the given full method names (e.g. "/coreapi.CoreAPI/Read*").
+func NewAuthenticator(jwtAuth *jwt.Auth, publicMethods []string, protectedMethods []string, scopeRules ...*restapi.ScopeRule) (*Authenticator, error) {
+	publicMethodsRegEx, err := restapi.CompileRoutesAsRegexes(publicMethods)
+	if err != nil {
+		return nil, err
+	}
+
+	protectedMethodsRegEx, err := restapi.CompileRoutesAsRegexes(protectedMethods)
+	if err != nil {
+		return nil, err
+	}
+
+	compiledScopeRules, err := restapi.NewScopeRules(scopeRules...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Authenticator{
+		jwtAuth:          jwtAuth,
+		publicMethods:    publicMethodsRegEx,
+		protectedMethods: protectedMethodsRegEx,
+		scopeRules:       compiledScopeRules,
+	}, nil
+}
+
+// Authorize checks whether the call of the given full method name with the metadata of the context is allowed.
+func (a *Authenticator) Authorize(ctx context.Context, fullMethod string) error {
+	if matchesAny(a.publicMethods, fullMethod) {
+		return nil
+	}
+
+	if !matchesAny(a.protectedMethods, fullMethod) {
+		return status.Errorf(codes.PermissionDenied, "method %s is not exposed", fullMethod)
+	}
+
+	token, exists := bearerToken(ctx)
+	if !exists {
+		return status.Errorf(codes.Unauthenticated, "method %s needs a JWT in the %s metadata", fullMethod, MetadataKeyAuthorization)
+	}
+
+	var verifiedClaims *jwt.AuthClaims
+	if !a.jwtAuth.VerifyJWT(token, func(claims *jwt.AuthClaims) bool {
+		verifiedClaims = claims
+
+		return claims.VerifySubject(a.jwtAuth.Subject())
+	}) {
+		return status.Error(codes.Unauthenticated, "invalid JWT")
+	}
+
+	if !a.allowedByScopes(fullMethod, verifiedClaims) {
+		return status.Errorf(codes.PermissionDenied, "JWT does not grant access to method %s", fullMethod)
+	}
+
+	return nil
+}
+
+// allowedByScopes checks whether the scopes of the JWT grant access to the method.
+func (a *Authenticator) allowedByScopes(fullMethod string, claims *jwt.AuthClaims) bool {
+	scopes, exists := a.scopeRules.Scopes("", fullMethod)
+	if !exists {
+		// methods without scopes can only be called with a JWT that grants access to everything
+		return len(claims.Scopes) == 0
+	}
+
+	return len(scopes) == 0 || claims.HasAnyScope(scopes...)
+}
+
+// UnaryServerInterceptor returns an interceptor that rejects all unary calls that are not authorized.
+func (a *Authenticator) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := a.Authorize(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor returns an interceptor that rejects all streams that are not authorized.
+func (a *Authenticator) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := a.Authorize(stream.Context(), info.FullMethod); err != nil {
+			return err
+		}
+
+		return handler(srv, stream)
+	}
+}
+
+func matchesAny(regexes []*regexp.Regexp, fullMethod string) bool {
+	for _, reg := range regexes {
+		if reg.MatchString(fullMethod) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// bearerToken returns the JWT of the authorization metadata of the incoming call.
+func bearerToken(ctx context.Context) (string, bool) {
+	md, exists := metadata.FromIncomingContext(ctx)
+	if !exists {
+		return "", false
+	}
+
+	for _, value := range md.Get(MetadataKeyAuthorization) {
+		if len(value) > len(bearerPrefix) && strings.EqualFold(value[:len(bearerPrefix)], bearerPrefix) {
+			return value[len(bearerPrefix):], true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,99 @@
+package grpcapi
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotaledger/iota-core/pkg/jwt"
+	"github.com/iotaledger/iota-core/pkg/restapi"
+)
+
+func newTestAuth(t *testing.T, subject string, privateKey crypto.PrivKey) *jwt.Auth {
+	t.Helper()
+
+	jwtAuth, err := jwt.NewAuth(subject, 0, "nodeID", privateKey)
+	require.NoError(t, err)
+
+	return jwtAuth
+}
+
+func contextWithToken(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(MetadataKeyAuthorization, "Bearer "+token))
+}
+
+func requireCode(t *testing.T, expected codes.Code, err error) {
+	t.Helper()
+
+	require.Equal(t, expected, status.Code(err))
+}
+
+func TestAuthenticator_Authorize(t *testing.T) {
+	privateKey, _, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	jwtAuth := newTestAuth(t, "IOTA", privateKey)
+	authenticator, err := NewAuthenticator(jwtAuth, []string{"/coreapi.CoreAPI/Read*"}, []string{"/coreapi.CoreAPI/*"})
+	require.NoError(t, err)
+
+	token, err := jwtAuth.IssueJWT()
+	require.NoError(t, err)
+
+	// public methods can be called without a token
+	require.NoError(t, authenticator.Authorize(context.Background(), "/coreapi.CoreAPI/ReadInfo"))
+
+	// protected methods need a valid token
+	requireCode(t, codes.Unauthenticated, authenticator.Authorize(context.Background(), "/coreapi.CoreAPI/SubmitBlock"))
+	requireCode(t, codes.Unauthenticated, authenticator.Authorize(contextWithToken("invalid"), "/coreapi.CoreAPI/SubmitBlock"))
+	require.NoError(t, authenticator.Authorize(contextWithToken(token), "/coreapi.CoreAPI/SubmitBlock"))
+
+	// tokens with a different salt or of a different node are rejected
+	otherSaltToken, err := newTestAuth(t, "other", privateKey).IssueJWT()
+	require.NoError(t, err)
+	requireCode(t, codes.Unauthenticated, authenticator.Authorize(contextWithToken(otherSaltToken), "/coreapi.CoreAPI/SubmitBlock"))
+
+	otherPrivateKey, _, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	otherNodeToken, err := newTestAuth(t, "IOTA", otherPrivateKey).IssueJWT()
+	require.NoError(t, err)
+	requireCode(t, codes.Unauthenticated, authenticator.Authorize(contextWithToken(otherNodeToken), "/coreapi.CoreAPI/SubmitBlock"))
+
+	// methods that are neither public nor protected are forbidden, even with a valid token
+	requireCode(t, codes.PermissionDenied, authenticator.Authorize(contextWithToken(token), "/inx.INX/ForceCommitUntil"))
+}
+
+func TestAuthenticator_AuthorizeScopes(t *testing.T) {
+	privateKey, _, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	jwtAuth := newTestAuth(t, "IOTA", privateKey)
+	authenticator, err := NewAuthenticator(jwtAuth, nil, []string{"/coreapi.CoreAPI/*", "/inx.INX/*"},
+		&restapi.ScopeRule{Route: "/coreapi.CoreAPI/SubmitBlock", Scopes: []string{jwt.ScopeBlocksSubmit}},
+		&restapi.ScopeRule{Route: "/coreapi.CoreAPI/*", Scopes: []string{jwt.ScopeCoreRead}},
+	)
+	require.NoError(t, err)
+
+	readToken, err := jwtAuth.IssueScopedJWT([]string{jwt.ScopeCoreRead}, 0)
+	require.NoError(t, err)
+	require.NoError(t, authenticator.Authorize(contextWithToken(readToken), "/coreapi.CoreAPI/ReadInfo"))
+	requireCode(t, codes.PermissionDenied, authenticator.Authorize(contextWithToken(readToken), "/coreapi.CoreAPI/SubmitBlock"))
+
+	submitToken, err := jwtAuth.IssueScopedJWT([]string{jwt.ScopeBlocksSubmit}, 0)
+	require.NoError(t, err)
+	require.NoError(t, authenticator.Authorize(contextWithToken(submitToken), "/coreapi.CoreAPI/SubmitBlock"))
+	requireCode(t, codes.PermissionDenied, authenticator.Authorize(contextWithToken(submitToken), "/coreapi.CoreAPI/ReadInfo"))
+
+	// methods without a scope rule can only be called with unscoped tokens
+	requireCode(t, codes.PermissionDenied, authenticator.Authorize(contextWithToken(readToken), "/inx.INX/ReadNodeStatus"))
+
+	token, err := jwtAuth.IssueJWT()
+	require.NoError(t, err)
+	require.NoError(t, authenticator.Authorize(contextWithToken(token), "/coreapi.CoreAPI/SubmitBlock"))
+	require.NoError(t, authenticator.Authorize(contextWithToken(token), "/inx.INX/ReadNodeStatus"))
+}
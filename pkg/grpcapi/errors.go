@@ -0,0 +1,58 @@
+package grpcapi
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotaledger/hive.go/ierrors"
+)
+
+// httpStatusCodes maps the HTTP status codes of the errors returned by the request handler to gRPC status codes.
+var httpStatusCodes = map[int]codes.Code{
+	http.StatusBadRequest:          codes.InvalidArgument,
+	http.StatusUnauthorized:        codes.Unauthenticated,
+	http.StatusForbidden:           codes.PermissionDenied,
+	http.StatusNotFound:            codes.NotFound,
+	http.StatusConflict:            codes.AlreadyExists,
+	http.StatusTooManyRequests:     codes.ResourceExhausted,
+	http.StatusNotImplemented:      codes.Unimplemented,
+	http.StatusServiceUnavailable:  codes.Unavailable,
+	http.StatusGatewayTimeout:      codes.DeadlineExceeded,
+	http.StatusInternalServerError: codes.Internal,
+}
+
+// StatusFromError converts an error of the request handler to a gRPC status error.
+func StatusFromError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if _, isStatus := status.FromError(err); isStatus {
+		return err
+	}
+
+	switch {
+	case ierrors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case ierrors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+
+	var httpErr *echo.HTTPError
+	if !ierrors.As(err, &httpErr) {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	code, exists := httpStatusCodes[httpErr.Code]
+	if !exists {
+		code = codes.Unknown
+	}
+
+	// the HTTP status is replaced by the gRPC status, so it is removed from the message.
+	return status.Error(code, strings.TrimPrefix(err.Error(), httpErr.Error()+": "))
+}
@@ -0,0 +1,29 @@
+package grpcapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotaledger/hive.go/ierrors"
+)
+
+func TestStatusFromError(t *testing.T) {
+	require.NoError(t, StatusFromError(nil))
+
+	notFound := StatusFromError(ierrors.WithMessagef(echo.ErrNotFound, "block %s not found", "0x01"))
+	requireCode(t, codes.NotFound, notFound)
+	require.Equal(t, "block 0x01 not found", status.Convert(notFound).Message())
+
+	requireCode(t, codes.Unavailable, StatusFromError(ierrors.Wrap(echo.ErrServiceUnavailable, "node is not synced")))
+	requireCode(t, codes.Internal, StatusFromError(ierrors.New("unexpected")))
+	requireCode(t, codes.DeadlineExceeded, StatusFromError(ierrors.Wrap(context.DeadlineExceeded, "failed to await block")))
+
+	// status errors are kept as they are
+	alreadyStatus := status.Error(codes.InvalidArgument, "invalid block ID")
+	require.Equal(t, alreadyStatus, StatusFromError(alreadyStatus))
+}
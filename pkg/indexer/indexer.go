@@ -0,0 +1,285 @@
+package indexer
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/utxoledger"
+	"github.com/iotaledger/iota-core/pkg/storage"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+const (
+	// statusID is the primary key of the single status entry.
+	statusID = 1
+
+	// insertBatchSize is the amount of outputs that are inserted into the database with a single statement.
+	insertBatchSize = 500
+)
+
+var (
+	// ErrNotInitialized is returned if the indexer was not initialized with the ledger state yet.
+	ErrNotInitialized = ierrors.New("indexer is not initialized")
+	// ErrSlotMismatch is returned if a slot is applied to the indexer that does not follow its ledger index.
+	ErrSlotMismatch = ierrors.New("slot does not follow the ledger index of the indexer")
+	// ErrRollbackBelowPrunedSlot is returned if the indexer should be rolled back to a slot whose spent outputs were already pruned.
+	ErrRollbackBelowPrunedSlot = ierrors.New("rollback target is below the pruned slot of the indexer")
+
+	dbTables = []interface{}{
+		&Output{},
+		&Status{},
+	}
+)
+
+// Indexer keeps the outputs of the ledger indexed by their unlock conditions and features in a SQL database.
+type Indexer struct {
+	dbExecFunc storage.SQLDatabaseExecFunc
+}
+
+// New creates a new Indexer that stores its data in the given database.
+func New(dbExecFunc storage.SQLDatabaseExecFunc) *Indexer {
+	// create tables and indexes in the gorm DB if needed.
+	// HINT: the indexer database has the same lifetime as the storage of an engine,
+	// so it is sufficient to migrate it whenever a new Indexer is created for an engine.
+	if err := dbExecFunc(func(db *gorm.DB) error {
+		return db.AutoMigrate(dbTables...)
+	}); err != nil {
+		panic(ierrors.Wrap(err, "failed to auto migrate tables"))
+	}
+
+	return &Indexer{
+		dbExecFunc: dbExecFunc,
+	}
+}
+
+// LedgerIndex returns the slot up to which the ledger was indexed.
+func (i *Indexer) LedgerIndex() (ledgerIndex iotago.SlotIndex, initialized bool, err error) {
+	if err = i.dbExecFunc(func(db *gorm.DB) error {
+		ledgerIndex, initialized, err = ledgerIndexWithoutLocking(db)
+
+		return err
+	}); err != nil {
+		return 0, false, err
+	}
+
+	return ledgerIndex, initialized, nil
+}
+
+// Import replaces the content of the indexer with the given unspent outputs of the ledger state at the given slot.
+func (i *Indexer) Import(ledgerIndex iotago.SlotIndex, forEachUnspentOutput func(consumer func(output *utxoledger.Output) bool) error) error {
+	if err := i.dbExecFunc(func(db *gorm.DB) error {
+		return db.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Session(&gorm.Session{AllowGlobalUpdate: true}).Delete(&Output{}).Error; err != nil {
+				return ierrors.Wrap(err, "failed to clear outputs")
+			}
+
+			batch := make([]*Output, 0, insertBatchSize)
+			flushBatch := func() error {
+				if len(batch) == 0 {
+					return nil
+				}
+
+				if err := tx.Create(batch).Error; err != nil {
+					return err
+				}
+				batch = batch[:0]
+
+				return nil
+			}
+
+			var innerErr error
+			if err := forEachUnspentOutput(func(output *utxoledger.Output) bool {
+				entry, err := newOutput(output)
+				if err != nil {
+					innerErr = err
+
+					return false
+				}
+
+				if batch = append(batch, entry); len(batch) == insertBatchSize {
+					innerErr = flushBatch()
+				}
+
+				return innerErr == nil
+			}); err != nil {
+				return ierrors.Wrap(err, "failed to iterate unspent outputs")
+			}
+
+			if innerErr != nil {
+				return ierrors.Wrap(innerErr, "failed to insert outputs")
+			}
+
+			if err := flushBatch(); err != nil {
+				return ierrors.Wrap(err, "failed to insert outputs")
+			}
+
+			// the imported ledger state doesn't contain any spent outputs, so it can't be rolled back.
+			if err := tx.Clauses(clause.OnConflict{UpdateAll: true}).Create(&Status{ID: statusID, LedgerIndex: ledgerIndex, PrunedSlot: ledgerIndex}).Error; err != nil {
+				return ierrors.Wrap(err, "failed to store indexer status")
+			}
+
+			return nil
+		})
+	}); err != nil {
+		return ierrors.Wrapf(err, "failed to import ledger state of slot %d", ledgerIndex)
+	}
+
+	return nil
+}
+
+// CommitSlot applies the outputs that were created and consumed in the given slot to the indexer.
+func (i *Indexer) CommitSlot(slot iotago.SlotIndex, created utxoledger.Outputs, consumed utxoledger.Spents) error {
+	entries := make([]*Output, 0, len(created))
+	for _, output := range created {
+		entry, err := newOutput(output)
+		if err != nil {
+			return err
+		}
+
+		entries = append(entries, entry)
+	}
+
+	consumedOutputIDs := make([][]byte, 0, len(consumed))
+	for _, spent := range consumed {
+		consumedOutputIDs = append(consumedOutputIDs, lo.PanicOnErr(spent.OutputID().Bytes()))
+	}
+
+	if err := i.dbExecFunc(func(db *gorm.DB) error {
+		return db.Transaction(func(tx *gorm.DB) error {
+			status, err := statusWithoutLocking(tx)
+			if err != nil {
+				return err
+			}
+
+			if status == nil {
+				return ErrNotInitialized
+			}
+
+			if slot != status.LedgerIndex+1 {
+				return ierrors.Wrapf(ErrSlotMismatch, "slot %d, ledger index %d", slot, status.LedgerIndex)
+			}
+
+			if len(entries) > 0 {
+				if err := tx.CreateInBatches(entries, insertBatchSize).Error; err != nil {
+					return ierrors.Wrap(err, "failed to insert created outputs")
+				}
+			}
+
+			// outputs are created before they are marked as spent, as they might be created and consumed in the same slot.
+			for len(consumedOutputIDs) > 0 {
+				batchSize := min(len(consumedOutputIDs), insertBatchSize)
+
+				if err := tx.Model(&Output{}).Where("output_id IN ?", consumedOutputIDs[:batchSize]).Update("spent_slot", slot).Error; err != nil {
+					return ierrors.Wrap(err, "failed to mark consumed outputs as spent")
+				}
+
+				consumedOutputIDs = consumedOutputIDs[batchSize:]
+			}
+
+			return storeLedgerIndexWithoutLocking(tx, slot)
+		})
+	}); err != nil {
+		return ierrors.Wrapf(err, "failed to commit slot %d", slot)
+	}
+
+	return nil
+}
+
+// Rollback reverts the indexer to the state it had after the given slot was committed.
+// This is used to rollback the indexer after chain switching.
+func (i *Indexer) Rollback(targetSlot iotago.SlotIndex) error {
+	if err := i.dbExecFunc(func(db *gorm.DB) error {
+		return db.Transaction(func(tx *gorm.DB) error {
+			status, err := statusWithoutLocking(tx)
+			if err != nil {
+				return err
+			}
+
+			// there is nothing to rollback if the ledger state was not imported yet.
+			if status == nil {
+				return nil
+			}
+
+			if targetSlot < status.PrunedSlot {
+				return ierrors.Wrapf(ErrRollbackBelowPrunedSlot, "target slot %d, pruned slot %d", targetSlot, status.PrunedSlot)
+			}
+
+			// delete all outputs that were created after the given slot
+			if err := tx.Where("created_slot > ?", targetSlot).Delete(&Output{}).Error; err != nil {
+				return ierrors.Wrap(err, "failed to delete outputs")
+			}
+
+			// mark all outputs as unspent that were spent after the given slot
+			if err := tx.Model(&Output{}).Where("spent_slot > ?", targetSlot).Update("spent_slot", nil).Error; err != nil {
+				return ierrors.Wrap(err, "failed to unspend outputs")
+			}
+
+			return storeLedgerIndexWithoutLocking(tx, targetSlot)
+		})
+	}); err != nil {
+		return ierrors.Wrapf(err, "failed to rollback indexer to slot %d", targetSlot)
+	}
+
+	return nil
+}
+
+// Prune deletes all outputs that were spent in a slot smaller or equal the given slot.
+// The indexer can't be rolled back below this slot afterwards.
+func (i *Indexer) Prune(targetSlot iotago.SlotIndex) error {
+	if err := i.dbExecFunc(func(db *gorm.DB) error {
+		return db.Transaction(func(tx *gorm.DB) error {
+			status, err := statusWithoutLocking(tx)
+			if err != nil || status == nil || targetSlot <= status.PrunedSlot {
+				return err
+			}
+
+			if err := tx.Where("spent_slot <= ?", targetSlot).Delete(&Output{}).Error; err != nil {
+				return ierrors.Wrap(err, "failed to delete spent outputs")
+			}
+
+			if err := tx.Model(&Status{}).Where("id = ?", statusID).Update("pruned_slot", targetSlot).Error; err != nil {
+				return ierrors.Wrap(err, "failed to store indexer status")
+			}
+
+			return nil
+		})
+	}); err != nil {
+		return ierrors.Wrapf(err, "failed to prune indexer to slot %d", targetSlot)
+	}
+
+	return nil
+}
+
+// statusWithoutLocking returns the status of the indexer or nil if the indexer was not initialized yet.
+func statusWithoutLocking(db *gorm.DB) (*Status, error) {
+	status := &Status{}
+	if err := db.Where("id = ?", statusID).Take(status).Error; err != nil {
+		if ierrors.Is(err, gorm.ErrRecordNotFound) {
+			//nolint:nilnil // we want to return nil here
+			return nil, nil
+		}
+
+		return nil, ierrors.Wrap(err, "failed to query indexer status")
+	}
+
+	return status, nil
+}
+
+func ledgerIndexWithoutLocking(db *gorm.DB) (iotago.SlotIndex, bool, error) {
+	status, err := statusWithoutLocking(db)
+	if err != nil || status == nil {
+		return 0, false, err
+	}
+
+	return status.LedgerIndex, true, nil
+}
+
+func storeLedgerIndexWithoutLocking(db *gorm.DB, ledgerIndex iotago.SlotIndex) error {
+	if err := db.Model(&Status{}).Where("id = ?", statusID).Update("ledger_index", ledgerIndex).Error; err != nil {
+		return ierrors.Wrap(err, "failed to store indexer status")
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package indexer_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/log"
+	"github.com/iotaledger/iota-core/pkg/indexer"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/utxoledger"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/utxoledger/tpkg"
+	"github.com/iotaledger/iota-core/pkg/storage/clonablesql"
+	iotago "github.com/iotaledger/iota.go/v4"
+	iotago_tpkg "github.com/iotaledger/iota.go/v4/tpkg"
+)
+
+func newIndexer(t *testing.T) *indexer.Indexer {
+	t.Helper()
+
+	database := clonablesql.NewClonableSQLiteDatabase(log.NewLogger().NewChildLogger(t.Name()), t.TempDir(), "indexer.db", func(err error) {
+		require.NoError(t, err)
+	})
+	t.Cleanup(database.Shutdown)
+
+	return indexer.New(database.ExecDBFunc())
+}
+
+func randOutputOnAddressBookedInSlot(outputType iotago.OutputType, address iotago.Address, slot iotago.SlotIndex) *utxoledger.Output {
+	return tpkg.RandLedgerStateOutputOnAddress(outputType, address).CopyWithBlockIDAndSlotBooked(iotago_tpkg.RandBlockID(), slot)
+}
+
+func importOutputs(t *testing.T, idx *indexer.Indexer, slot iotago.SlotIndex, outputs ...*utxoledger.Output) {
+	t.Helper()
+
+	require.NoError(t, idx.Import(slot, func(consumer func(output *utxoledger.Output) bool) error {
+		for _, output := range outputs {
+			if !consumer(output) {
+				break
+			}
+		}
+
+		return nil
+	}))
+}
+
+func requireOutputs(t *testing.T, idx *indexer.Indexer, expectedLedgerIndex iotago.SlotIndex, filters []indexer.Filter, expected ...*utxoledger.Output) {
+	t.Helper()
+
+	result, err := idx.Outputs(100, nil, filters...)
+	require.NoError(t, err)
+	require.Equal(t, expectedLedgerIndex, result.LedgerIndex)
+	require.Nil(t, result.Cursor)
+
+	expectedOutputIDs := make(iotago.OutputIDs, 0, len(expected))
+	for _, output := range expected {
+		expectedOutputIDs = append(expectedOutputIDs, output.OutputID())
+	}
+	require.ElementsMatch(t, expectedOutputIDs, result.OutputIDs)
+}
+
+func TestIndexer(t *testing.T) {
+	idx := newIndexer(t)
+
+	_, err := idx.Outputs(100, nil)
+	require.ErrorIs(t, err, indexer.ErrNotInitialized)
+
+	addressA, addressB := iotago_tpkg.RandEd25519Address(), iotago_tpkg.RandEd25519Address()
+
+	outputA := randOutputOnAddressBookedInSlot(iotago.OutputBasic, addressA, 5)
+	outputB := randOutputOnAddressBookedInSlot(iotago.OutputBasic, addressB, 8)
+	// the NFT output creates the chain, so its ID is derived from the output ID.
+	nftOutput := iotago_tpkg.RandOutputOnAddress(iotago.OutputNFT, addressA).(*iotago.NFTOutput)
+	nftOutput.NFTID = iotago.EmptyNFTID()
+	outputC := tpkg.RandLedgerStateOutputWithOutput(nftOutput).CopyWithBlockIDAndSlotBooked(iotago_tpkg.RandBlockID(), 10)
+	importOutputs(t, idx, 10, outputA, outputB, outputC)
+
+	ledgerIndex, initialized, err := idx.LedgerIndex()
+	require.NoError(t, err)
+	require.True(t, initialized)
+	require.Equal(t, iotago.SlotIndex(10), ledgerIndex)
+
+	requireOutputs(t, idx, 10, []indexer.Filter{indexer.FilterAddress(addressA)}, outputA, outputC)
+	requireOutputs(t, idx, 10, []indexer.Filter{indexer.FilterOutputType(iotago.OutputBasic)}, outputA, outputB)
+	requireOutputs(t, idx, 10, []indexer.Filter{indexer.FilterOutputType(iotago.OutputBasic), indexer.FilterUnlockableByAddress(addressA)}, outputA)
+	requireOutputs(t, idx, 10, []indexer.Filter{indexer.FilterCreatedAfter(5), indexer.FilterCreatedBefore(10)}, outputB)
+	requireOutputs(t, idx, 10, []indexer.Filter{indexer.FilterChainID(iotago.NFTIDFromOutputID(outputC.OutputID()))}, outputC)
+
+	outputD := randOutputOnAddressBookedInSlot(iotago.OutputBasic, addressB, 11)
+	outputE := randOutputOnAddressBookedInSlot(iotago.OutputBasic, addressB, 11)
+	require.NoError(t, idx.CommitSlot(11, utxoledger.Outputs{outputD, outputE}, utxoledger.Spents{
+		tpkg.RandLedgerStateSpentWithOutput(outputA, 11),
+		tpkg.RandLedgerStateSpentWithOutput(outputE, 11),
+	}))
+
+	requireOutputs(t, idx, 11, nil, outputB, outputC, outputD)
+
+	require.ErrorIs(t, idx.CommitSlot(13, nil, nil), indexer.ErrSlotMismatch)
+
+	// the rollback restores the state after the given slot.
+	require.NoError(t, idx.Rollback(10))
+	requireOutputs(t, idx, 10, nil, outputA, outputB, outputC)
+
+	// spent outputs can't be restored once they were pruned.
+	require.NoError(t, idx.CommitSlot(11, nil, utxoledger.Spents{tpkg.RandLedgerStateSpentWithOutput(outputA, 11)}))
+	require.NoError(t, idx.Prune(11))
+	requireOutputs(t, idx, 11, nil, outputB, outputC)
+	require.ErrorIs(t, idx.Rollback(10), indexer.ErrRollbackBelowPrunedSlot)
+	requireOutputs(t, idx, 11, nil, outputB, outputC)
+}
+
+func TestIndexerPagination(t *testing.T) {
+	idx := newIndexer(t)
+
+	address := iotago_tpkg.RandEd25519Address()
+
+	outputs := make(utxoledger.Outputs, 0)
+	for slot := iotago.SlotIndex(1); slot <= 5; slot++ {
+		outputs = append(outputs, randOutputOnAddressBookedInSlot(iotago.OutputBasic, address, slot), randOutputOnAddressBookedInSlot(iotago.OutputBasic, address, slot))
+	}
+	importOutputs(t, idx, 5, outputs...)
+
+	var cursor *string
+	collectedOutputIDs := make(iotago.OutputIDs, 0)
+	for pages := 0; ; pages++ {
+		require.Less(t, pages, len(outputs))
+
+		result, err := idx.Outputs(3, cursor, indexer.FilterAddress(address))
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(result.OutputIDs), 3)
+
+		collectedOutputIDs = append(collectedOutputIDs, result.OutputIDs...)
+
+		if cursor = result.Cursor; cursor == nil {
+			break
+		}
+	}
+
+	expectedOutputIDs := make(iotago.OutputIDs, 0, len(outputs))
+	for _, output := range outputs {
+		expectedOutputIDs = append(expectedOutputIDs, output.OutputID())
+	}
+	require.ElementsMatch(t, expectedOutputIDs, collectedOutputIDs)
+
+	invalidCursor := "invalid"
+	_, err := idx.Outputs(3, &invalidCursor)
+	require.ErrorIs(t, err, indexer.ErrInvalidCursor)
+}
@@ -0,0 +1,141 @@
+package indexer
+
+import (
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/utxoledger"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// Output represents an output in the SQL database of the indexer.
+// Outputs that were spent are kept until the slot they were spent in is pruned,
+// so that the indexer can be rolled back after chain switching.
+type Output struct {
+	OutputID   []byte            `gorm:"primaryKey;notnull"`
+	OutputType iotago.OutputType `gorm:"notnull;index:outputs_output_type"`
+	Amount     iotago.BaseToken  `gorm:"notnull"`
+
+	// ChainID is the identifier of account, anchor, foundry, NFT and delegation outputs.
+	ChainID []byte `gorm:"index:outputs_chain_id"`
+
+	Address                     []byte `gorm:"index:outputs_address"`
+	StateController             []byte `gorm:"index:outputs_state_controller"`
+	Governor                    []byte `gorm:"index:outputs_governor"`
+	AccountAddress              []byte `gorm:"index:outputs_account_address"`
+	Validator                   []byte `gorm:"index:outputs_validator"`
+	Sender                      []byte `gorm:"index:outputs_sender"`
+	Issuer                      []byte `gorm:"index:outputs_issuer"`
+	Tag                         []byte `gorm:"index:outputs_tag"`
+	NativeToken                 []byte `gorm:"index:outputs_native_token"`
+	StorageDepositReturnAddress []byte
+	TimelockSlot                *iotago.SlotIndex
+	ExpirationSlot              *iotago.SlotIndex
+	ExpirationReturnAddress     []byte
+
+	CreatedSlot iotago.SlotIndex  `gorm:"notnull;index:outputs_created_slot"`
+	SpentSlot   *iotago.SlotIndex `gorm:"index:outputs_spent_slot"`
+}
+
+// Status represents the state of the indexer in the SQL database.
+// There is only a single entry in this table.
+type Status struct {
+	ID          uint             `gorm:"primaryKey;notnull"`
+	LedgerIndex iotago.SlotIndex `gorm:"notnull"`
+	// PrunedSlot is the slot up to which spent outputs were removed, the indexer can't be rolled back below it.
+	PrunedSlot iotago.SlotIndex `gorm:"notnull"`
+}
+
+// newOutput creates the database entry of the given ledger output.
+func newOutput(output *utxoledger.Output) (*Output, error) {
+	outputID := output.OutputID()
+	iotaOutput := output.Output()
+
+	entry := &Output{
+		OutputID:    lo.PanicOnErr(outputID.Bytes()),
+		OutputType:  output.OutputType(),
+		Amount:      output.BaseTokenAmount(),
+		CreatedSlot: output.SlotBooked(),
+	}
+
+	if chainOutput, isChainOutput := iotaOutput.(iotago.ChainOutput); isChainOutput {
+		chainID, err := chainIDBytes(resolveChainID(chainOutput.ChainID(), outputID))
+		if err != nil {
+			return nil, ierrors.Wrapf(err, "failed to index output %s", outputID.ToHex())
+		}
+		entry.ChainID = chainID
+	}
+
+	unlockConditions := iotaOutput.UnlockConditionSet()
+	if unlockCondition := unlockConditions.Address(); unlockCondition != nil {
+		entry.Address = unlockCondition.Address.ID()
+	}
+	if unlockCondition := unlockConditions.StateControllerAddress(); unlockCondition != nil {
+		entry.StateController = unlockCondition.Address.ID()
+	}
+	if unlockCondition := unlockConditions.GovernorAddress(); unlockCondition != nil {
+		entry.Governor = unlockCondition.Address.ID()
+	}
+	if unlockCondition := unlockConditions.ImmutableAccount(); unlockCondition != nil {
+		entry.AccountAddress = unlockCondition.Address.ID()
+	}
+	if unlockCondition := unlockConditions.StorageDepositReturn(); unlockCondition != nil {
+		entry.StorageDepositReturnAddress = unlockCondition.ReturnAddress.ID()
+	}
+	if unlockCondition := unlockConditions.Timelock(); unlockCondition != nil {
+		entry.TimelockSlot = &unlockCondition.Slot
+	}
+	if unlockCondition := unlockConditions.Expiration(); unlockCondition != nil {
+		entry.ExpirationSlot = &unlockCondition.Slot
+		entry.ExpirationReturnAddress = unlockCondition.ReturnAddress.ID()
+	}
+
+	features := iotaOutput.FeatureSet()
+	if feature := features.SenderFeature(); feature != nil {
+		entry.Sender = feature.Address.ID()
+	}
+	if feature := features.Tag(); feature != nil {
+		entry.Tag = feature.Tag
+	}
+	if feature := features.NativeToken(); feature != nil {
+		entry.NativeToken = feature.ID[:]
+	}
+
+	if immutableOutput, hasImmutableFeatures := iotaOutput.(interface{ ImmutableFeatureSet() iotago.FeatureSet }); hasImmutableFeatures {
+		if feature := immutableOutput.ImmutableFeatureSet().Issuer(); feature != nil {
+			entry.Issuer = feature.Address.ID()
+		}
+	}
+
+	if delegationOutput, isDelegationOutput := iotaOutput.(*iotago.DelegationOutput); isDelegationOutput {
+		entry.Validator = delegationOutput.ValidatorAddress.ID()
+	}
+
+	return entry, nil
+}
+
+// resolveChainID returns the chain ID of an output, which is derived from the output ID if the chain is created by the output.
+func resolveChainID(chainID iotago.ChainID, outputID iotago.OutputID) iotago.ChainID {
+	if utxoIDChainID, derivedFromOutputID := chainID.(iotago.UTXOIDChainID); derivedFromOutputID && chainID.Empty() {
+		return utxoIDChainID.FromOutputID(outputID)
+	}
+
+	return chainID
+}
+
+// chainIDBytes returns the serialized form of the given chain ID that is stored in the database.
+func chainIDBytes(chainID iotago.ChainID) ([]byte, error) {
+	switch id := chainID.(type) {
+	case iotago.AccountID:
+		return id[:], nil
+	case iotago.AnchorID:
+		return id[:], nil
+	case iotago.NFTID:
+		return id[:], nil
+	case iotago.FoundryID:
+		return id[:], nil
+	case iotago.DelegationID:
+		return id[:], nil
+	default:
+		return nil, ierrors.Errorf("unknown chain ID type %T", chainID)
+	}
+}
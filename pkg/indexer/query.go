@@ -0,0 +1,292 @@
+package indexer
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+
+	"gorm.io/gorm"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/lo"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// cursorLength is the length of a decoded cursor, which consists of the created slot and the output ID of the next output.
+const cursorLength = iotago.SlotIndexLength + iotago.OutputIDLength
+
+// ErrInvalidCursor is returned if a cursor can't be decoded.
+var ErrInvalidCursor = ierrors.New("invalid cursor")
+
+// Filter restricts the unspent outputs that are returned by a query.
+type Filter func(db *gorm.DB) *gorm.DB
+
+// Result is a page of unspent outputs that match a query.
+type Result struct {
+	// LedgerIndex is the slot up to which the ledger was indexed when the query was executed.
+	LedgerIndex iotago.SlotIndex
+	// PageSize is the maximum amount of output IDs that were requested.
+	PageSize uint32
+	// OutputIDs are the IDs of the matching outputs ordered by their creation slot.
+	OutputIDs iotago.OutputIDs
+	// Cursor can be used to query the next page, it is nil if there are no further outputs.
+	Cursor *string
+}
+
+// Outputs returns a page of the unspent outputs that match all given filters, starting at the given cursor.
+func (i *Indexer) Outputs(pageSize uint32, cursor *string, filters ...Filter) (*Result, error) {
+	query := func(db *gorm.DB) *gorm.DB {
+		db = db.Model(&Output{}).Where("spent_slot IS NULL")
+		for _, filter := range filters {
+			db = filter(db)
+		}
+
+		return db
+	}
+
+	if cursor != nil {
+		cursorSlot, cursorOutputID, err := decodeCursor(*cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		queryWithoutCursor := query
+		query = func(db *gorm.DB) *gorm.DB {
+			return queryWithoutCursor(db).Where("(created_slot > ? OR (created_slot = ? AND output_id >= ?))", cursorSlot, cursorSlot, cursorOutputID)
+		}
+	}
+
+	result := &Result{
+		PageSize:  pageSize,
+		OutputIDs: make(iotago.OutputIDs, 0),
+	}
+
+	if err := i.dbExecFunc(func(db *gorm.DB) error {
+		return db.Transaction(func(tx *gorm.DB) error {
+			ledgerIndex, initialized, err := ledgerIndexWithoutLocking(tx)
+			if err != nil {
+				return err
+			}
+
+			if !initialized {
+				return ErrNotInitialized
+			}
+			result.LedgerIndex = ledgerIndex
+
+			// we fetch one additional entry to know where the next page starts.
+			var entries []*Output
+			if err := query(tx).Select("output_id", "created_slot").Order("created_slot ASC, output_id ASC").Limit(int(pageSize) + 1).Find(&entries).Error; err != nil {
+				return ierrors.Wrap(err, "failed to query outputs")
+			}
+
+			for index, entry := range entries {
+				if index == int(pageSize) {
+					nextCursor := encodeCursor(entry.CreatedSlot, entry.OutputID)
+					result.Cursor = &nextCursor
+
+					break
+				}
+
+				outputID, _, err := iotago.OutputIDFromBytes(entry.OutputID)
+				if err != nil {
+					return ierrors.Wrap(err, "failed to decode output ID")
+				}
+
+				result.OutputIDs = append(result.OutputIDs, outputID)
+			}
+
+			return nil
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// FilterOutputType returns outputs of the given types.
+func FilterOutputType(outputTypes ...iotago.OutputType) Filter {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("output_type IN ?", outputTypes)
+	}
+}
+
+// FilterChainID returns the output of the chain with the given ID.
+func FilterChainID(chainID iotago.ChainID) Filter {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("chain_id = ?", lo.PanicOnErr(chainIDBytes(chainID)))
+	}
+}
+
+// FilterAddress returns outputs that are owned by the given address via their address unlock condition.
+func FilterAddress(address iotago.Address) Filter {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("address = ?", address.ID())
+	}
+}
+
+// FilterUnlockableByAddress returns outputs that can potentially be unlocked by the given address.
+func FilterUnlockableByAddress(address iotago.Address) Filter {
+	return func(db *gorm.DB) *gorm.DB {
+		addressID := address.ID()
+
+		return db.Where("(address = ? OR state_controller = ? OR governor = ? OR account_address = ? OR expiration_return_address = ?)", addressID, addressID, addressID, addressID, addressID)
+	}
+}
+
+// FilterStateController returns anchor outputs with the given state controller address.
+func FilterStateController(address iotago.Address) Filter {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("state_controller = ?", address.ID())
+	}
+}
+
+// FilterGovernor returns anchor outputs with the given governor address.
+func FilterGovernor(address iotago.Address) Filter {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("governor = ?", address.ID())
+	}
+}
+
+// FilterAccountAddress returns foundry outputs that are controlled by the given account address.
+func FilterAccountAddress(address iotago.Address) Filter {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("account_address = ?", address.ID())
+	}
+}
+
+// FilterValidator returns delegation outputs that delegate to the given validator.
+func FilterValidator(address iotago.Address) Filter {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("validator = ?", address.ID())
+	}
+}
+
+// FilterSender returns outputs with a sender feature of the given address.
+func FilterSender(address iotago.Address) Filter {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("sender = ?", address.ID())
+	}
+}
+
+// FilterIssuer returns outputs with an issuer feature of the given address.
+func FilterIssuer(address iotago.Address) Filter {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("issuer = ?", address.ID())
+	}
+}
+
+// FilterTag returns outputs with the given tag feature.
+func FilterTag(tag []byte) Filter {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("tag = ?", tag)
+	}
+}
+
+// FilterHasNativeToken returns outputs depending on whether they hold a native token.
+func FilterHasNativeToken(hasNativeToken bool) Filter {
+	return filterHasColumn("native_token", hasNativeToken)
+}
+
+// FilterNativeToken returns outputs that hold the given native token.
+func FilterNativeToken(nativeTokenID iotago.NativeTokenID) Filter {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("native_token = ?", nativeTokenID[:])
+	}
+}
+
+// FilterHasStorageDepositReturn returns outputs depending on whether they have a storage deposit return unlock condition.
+func FilterHasStorageDepositReturn(hasStorageDepositReturn bool) Filter {
+	return filterHasColumn("storage_deposit_return_address", hasStorageDepositReturn)
+}
+
+// FilterStorageDepositReturnAddress returns outputs with a storage deposit return unlock condition of the given address.
+func FilterStorageDepositReturnAddress(address iotago.Address) Filter {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("storage_deposit_return_address = ?", address.ID())
+	}
+}
+
+// FilterHasTimelock returns outputs depending on whether they have a timelock unlock condition.
+func FilterHasTimelock(hasTimelock bool) Filter {
+	return filterHasColumn("timelock_slot", hasTimelock)
+}
+
+// FilterTimelockedBefore returns outputs that are timelocked until a slot before the given slot.
+func FilterTimelockedBefore(slot iotago.SlotIndex) Filter {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("timelock_slot < ?", slot)
+	}
+}
+
+// FilterTimelockedAfter returns outputs that are timelocked until a slot after the given slot.
+func FilterTimelockedAfter(slot iotago.SlotIndex) Filter {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("timelock_slot > ?", slot)
+	}
+}
+
+// FilterHasExpiration returns outputs depending on whether they have an expiration unlock condition.
+func FilterHasExpiration(hasExpiration bool) Filter {
+	return filterHasColumn("expiration_slot", hasExpiration)
+}
+
+// FilterExpiresBefore returns outputs that expire before the given slot.
+func FilterExpiresBefore(slot iotago.SlotIndex) Filter {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("expiration_slot < ?", slot)
+	}
+}
+
+// FilterExpiresAfter returns outputs that expire after the given slot.
+func FilterExpiresAfter(slot iotago.SlotIndex) Filter {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("expiration_slot > ?", slot)
+	}
+}
+
+// FilterExpirationReturnAddress returns outputs with an expiration unlock condition of the given return address.
+func FilterExpirationReturnAddress(address iotago.Address) Filter {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("expiration_return_address = ?", address.ID())
+	}
+}
+
+// FilterCreatedBefore returns outputs that were created before the given slot.
+func FilterCreatedBefore(slot iotago.SlotIndex) Filter {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("created_slot < ?", slot)
+	}
+}
+
+// FilterCreatedAfter returns outputs that were created after the given slot.
+func FilterCreatedAfter(slot iotago.SlotIndex) Filter {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("created_slot > ?", slot)
+	}
+}
+
+func filterHasColumn(column string, has bool) Filter {
+	return func(db *gorm.DB) *gorm.DB {
+		if has {
+			return db.Where(column + " IS NOT NULL")
+		}
+
+		return db.Where(column + " IS NULL")
+	}
+}
+
+func encodeCursor(createdSlot iotago.SlotIndex, outputID []byte) string {
+	cursor := make([]byte, iotago.SlotIndexLength, cursorLength)
+	binary.BigEndian.PutUint32(cursor, uint32(createdSlot))
+
+	return hex.EncodeToString(append(cursor, outputID...))
+}
+
+func decodeCursor(cursor string) (iotago.SlotIndex, []byte, error) {
+	cursorBytes, err := hex.DecodeString(cursor)
+	if err != nil || len(cursorBytes) != cursorLength {
+		return 0, nil, ierrors.Wrapf(ErrInvalidCursor, "cursor %s", cursor)
+	}
+
+	return iotago.SlotIndex(binary.BigEndian.Uint32(cursorBytes[:iotago.SlotIndexLength])), cursorBytes[iotago.SlotIndexLength:], nil
+}
@@ -43,8 +43,16 @@ func NewAuth(subject string, sessionTimeout time.Duration, nodeID string, secret
 	}, nil
 }
 
+// Subject returns the subject of the JWT tokens issued by the Auth.
+func (j *Auth) Subject() string {
+	return j.subject
+}
+
 type AuthClaims struct {
 	jwt.StandardClaims
+
+	// Scopes are the scopes the token grants access to. Tokens without scopes grant access to everything.
+	Scopes []string `json:"scopes,omitempty"`
 }
 
 func (c *AuthClaims) compare(field string, expected string) bool {
@@ -116,7 +124,27 @@ func (j *Auth) Middleware(skipper middleware.Skipper, allow func(c echo.Context,
 	}
 }
 
+// IssueJWT issues a JWT that grants access to everything.
 func (j *Auth) IssueJWT() (string, error) {
+	return j.issueJWT(nil, j.sessionTimeout)
+}
+
+// IssueScopedJWT issues a JWT that only grants access to the given scopes and expires after the given duration (0 = never).
+func (j *Auth) IssueScopedJWT(scopes []string, expiry time.Duration) (string, error) {
+	if len(scopes) == 0 {
+		return "", ierrors.New("scopes must not be empty")
+	}
+
+	for _, scope := range scopes {
+		if !IsValidScope(scope) {
+			return "", ierrors.Errorf("unknown scope %s", scope)
+		}
+	}
+
+	return j.issueJWT(scopes, expiry)
+}
+
+func (j *Auth) issueJWT(scopes []string, expiry time.Duration) (string, error) {
 	now := time.Now()
 
 	// Set claims
@@ -129,12 +157,13 @@ func (j *Auth) IssueJWT() (string, error) {
 		NotBefore: now.Unix(),
 	}
 
-	if j.sessionTimeout > 0 {
-		stdClaims.ExpiresAt = now.Add(j.sessionTimeout).Unix()
+	if expiry > 0 {
+		stdClaims.ExpiresAt = now.Add(expiry).Unix()
 	}
 
 	claims := &AuthClaims{
 		StandardClaims: stdClaims,
+		Scopes:         scopes,
 	}
 
 	// Create token
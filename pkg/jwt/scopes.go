@@ -0,0 +1,60 @@
+package jwt
+
+import (
+	"slices"
+)
+
+const (
+	// ScopeCoreRead grants access to the read-only routes of the core API.
+	ScopeCoreRead = "core:read"
+	// ScopeBlocksSubmit grants access to the submission of blocks.
+	ScopeBlocksSubmit = "blocks:submit"
+	// ScopeManagementRead grants access to the read-only routes of the management API.
+	ScopeManagementRead = "management:read"
+	// ScopeManagementPeers grants access to adding, removing and banning peers.
+	ScopeManagementPeers = "management:peers"
+	// ScopeManagementPrune grants access to pruning the database.
+	ScopeManagementPrune = "management:prune"
+	// ScopeManagementSnapshots grants access to creating snapshots.
+	ScopeManagementSnapshots = "management:snapshots"
+	// ScopeManagementWebhooks grants access to adding and removing webhooks.
+	ScopeManagementWebhooks = "management:webhooks"
+	// ScopeIndexer grants access to the indexer API.
+	ScopeIndexer = "indexer"
+	// ScopeDebug grants access to the debug API.
+	ScopeDebug = "debug"
+)
+
+// Scopes are all scopes a JWT can be issued for.
+var Scopes = []string{
+	ScopeCoreRead,
+	ScopeBlocksSubmit,
+	ScopeManagementRead,
+	ScopeManagementPeers,
+	ScopeManagementPrune,
+	ScopeManagementSnapshots,
+	ScopeManagementWebhooks,
+	ScopeIndexer,
+	ScopeDebug,
+}
+
+// IsValidScope returns true if the scope is known.
+func IsValidScope(scope string) bool {
+	return slices.Contains(Scopes, scope)
+}
+
+// HasAnyScope returns true if the claims grant access to at least one of the given scopes.
+// Claims without scopes grant access to everything, so that tokens issued before the scopes existed keep working.
+func (c *AuthClaims) HasAnyScope(scopes ...string) bool {
+	if len(c.Scopes) == 0 {
+		return true
+	}
+
+	for _, scope := range scopes {
+		if slices.Contains(c.Scopes, scope) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,63 @@
+package jwt
+
+import (
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestAuth(t *testing.T) *Auth {
+	t.Helper()
+
+	privateKey, _, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	jwtAuth, err := NewAuth("IOTA", 0, "nodeID", privateKey)
+	require.NoError(t, err)
+
+	return jwtAuth
+}
+
+func TestAuth_IssueScopedJWT(t *testing.T) {
+	jwtAuth := newTestAuth(t)
+
+	_, err := jwtAuth.IssueScopedJWT(nil, 0)
+	require.Error(t, err)
+
+	_, err = jwtAuth.IssueScopedJWT([]string{ScopeCoreRead, "unknown"}, 0)
+	require.Error(t, err)
+
+	token, err := jwtAuth.IssueScopedJWT([]string{ScopeCoreRead, ScopeManagementRead}, time.Hour)
+	require.NoError(t, err)
+
+	var verifiedClaims *AuthClaims
+	require.True(t, jwtAuth.VerifyJWT(token, func(claims *AuthClaims) bool {
+		verifiedClaims = claims
+
+		return claims.VerifySubject("IOTA")
+	}))
+	require.Equal(t, []string{ScopeCoreRead, ScopeManagementRead}, verifiedClaims.Scopes)
+	require.NotZero(t, verifiedClaims.ExpiresAt)
+
+	require.True(t, verifiedClaims.HasAnyScope(ScopeManagementPrune, ScopeManagementRead))
+	require.False(t, verifiedClaims.HasAnyScope(ScopeManagementPrune))
+}
+
+func TestAuth_ExpiredScopedJWT(t *testing.T) {
+	jwtAuth := newTestAuth(t)
+
+	token, err := jwtAuth.IssueScopedJWT([]string{ScopeCoreRead}, time.Nanosecond)
+	require.NoError(t, err)
+	time.Sleep(1100 * time.Millisecond)
+	require.False(t, jwtAuth.VerifyJWT(token, func(_ *AuthClaims) bool { return true }))
+}
+
+func TestAuthClaims_HasAnyScope(t *testing.T) {
+	// tokens without scopes grant access to everything
+	require.True(t, (&AuthClaims{}).HasAnyScope(ScopeManagementPrune))
+	require.True(t, (&AuthClaims{Scopes: []string{ScopeDebug}}).HasAnyScope(ScopeDebug))
+	require.False(t, (&AuthClaims{Scopes: []string{ScopeDebug}}).HasAnyScope(ScopeCoreRead, ScopeIndexer))
+}
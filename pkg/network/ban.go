@@ -0,0 +1,57 @@
+package network
+
+import (
+	"net"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// Ban blocks all connections from and to a peer ID and the IP addresses it was connected from until it expires.
+type Ban struct {
+	// PeerID is the ID of the banned peer (optional if IPs are given).
+	PeerID peer.ID `json:"peerId,omitempty"`
+	// IPs are the banned IP addresses.
+	IPs []string `json:"ips,omitempty"`
+	// Reason is the reason why the peer was banned.
+	Reason string `json:"reason"`
+	// BannedAt is the time the ban was created.
+	BannedAt time.Time `json:"bannedAt"`
+	// ExpiresAt is the time the ban is lifted.
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// Key returns the identifier of the ban, which is the peer ID or the IP address for bans without peer ID.
+func (b *Ban) Key() string {
+	if b.PeerID != "" {
+		return b.PeerID.String()
+	}
+
+	if len(b.IPs) > 0 {
+		return b.IPs[0]
+	}
+
+	return ""
+}
+
+// IsExpired returns true if the ban is lifted at the given time.
+func (b *Ban) IsExpired(now time.Time) bool {
+	return !now.Before(b.ExpiresAt)
+}
+
+// Matches returns true if the ban applies to the given peer ID or one of the given IP addresses.
+func (b *Ban) Matches(peerID peer.ID, ips ...net.IP) bool {
+	if b.PeerID != "" && b.PeerID == peerID {
+		return true
+	}
+
+	for _, bannedIP := range b.IPs {
+		for _, ip := range ips {
+			if ip != nil && ip.String() == bannedIP {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -7,6 +7,9 @@ import (
 
 const (
 	CoreProtocolID = "iota-core/1.0.0"
+	// CoreProtocolV2ID is the version of the core protocol that wraps packets in frames that can be compressed and
+	// that supports batched packets. Peers that don't support it fall back to CoreProtocolID.
+	CoreProtocolV2ID = "iota-core/2.0.0"
 )
 
 type Endpoint interface {
@@ -13,4 +13,12 @@ var (
 	ErrDuplicatePeer = ierrors.New("already connected")
 	// ErrMaxAutopeeringPeersReached is returned when the maximum number of autopeering peers is reached.
 	ErrMaxAutopeeringPeersReached = ierrors.New("max autopeering peers reached")
+	// ErrPeerBanned is returned when a connection to a banned peer is attempted.
+	ErrPeerBanned = ierrors.New("peer is banned")
+	// ErrPeerFiltered is returned when a connection to a peer is attempted that is rejected by the peer filter.
+	ErrPeerFiltered = ierrors.New("peer is not allowed by the peer filter")
+	// ErrMalformedPacket is returned when a packet can't be decoded, independent of the protocol versions known to the node.
+	ErrMalformedPacket = ierrors.New("malformed packet")
+	// ErrInvalidBlock is returned when a block of a protocol version that is known to the node fails its syntactic validation.
+	ErrInvalidBlock = ierrors.New("invalid block")
 )
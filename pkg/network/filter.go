@@ -2,10 +2,15 @@ package network
 
 import (
 	"fmt"
+	"net"
+	"strings"
 
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
 	mamask "github.com/whyrusleeping/multiaddr-filter"
 
+	"github.com/iotaledger/hive.go/ierrors"
 	"github.com/iotaledger/hive.go/lo"
 )
 
@@ -68,3 +73,145 @@ func PublicOnlyAddressesFilter(allowLocalNetworks bool) AddressFilter {
 		})
 	}
 }
+
+// PeerFilter decides which peers the node is allowed to connect to, based on their peer IDs and IP addresses.
+// Denied peers and networks take precedence over allowed ones. If allowed peers or networks are configured,
+// all peers or addresses that are not explicitly allowed are rejected.
+// A nil PeerFilter allows all peers.
+type PeerFilter struct {
+	allowedPeerIDs  map[peer.ID]struct{}
+	deniedPeerIDs   map[peer.ID]struct{}
+	allowedNetworks []*net.IPNet
+	deniedNetworks  []*net.IPNet
+}
+
+// NewPeerFilter creates a new PeerFilter from the given peer IDs and networks in CIDR notation.
+// Single IP addresses are accepted as networks as well.
+func NewPeerFilter(allowedPeerIDs []string, deniedPeerIDs []string, allowedCIDRs []string, deniedCIDRs []string) (*PeerFilter, error) {
+	f := &PeerFilter{}
+
+	var err error
+	if f.allowedPeerIDs, err = parsePeerIDs(allowedPeerIDs); err != nil {
+		return nil, ierrors.Wrap(err, "invalid allowed peer ID")
+	}
+
+	if f.deniedPeerIDs, err = parsePeerIDs(deniedPeerIDs); err != nil {
+		return nil, ierrors.Wrap(err, "invalid denied peer ID")
+	}
+
+	if f.allowedNetworks, err = parseNetworks(allowedCIDRs); err != nil {
+		return nil, ierrors.Wrap(err, "invalid allowed CIDR")
+	}
+
+	if f.deniedNetworks, err = parseNetworks(deniedCIDRs); err != nil {
+		return nil, ierrors.Wrap(err, "invalid denied CIDR")
+	}
+
+	return f, nil
+}
+
+// AllowsPeerID returns true if the node is allowed to connect to the peer with the given ID.
+func (f *PeerFilter) AllowsPeerID(id peer.ID) bool {
+	if f == nil {
+		return true
+	}
+
+	if _, denied := f.deniedPeerIDs[id]; denied {
+		return false
+	}
+
+	if len(f.allowedPeerIDs) == 0 {
+		return true
+	}
+
+	_, allowed := f.allowedPeerIDs[id]
+
+	return allowed
+}
+
+// AllowsAddress returns true if the node is allowed to connect to the given address.
+// Addresses without an IP (e.g. DNS addresses) can not be checked, so they are only allowed if no allowed networks
+// are configured.
+func (f *PeerFilter) AllowsAddress(addr multiaddr.Multiaddr) bool {
+	if f == nil {
+		return true
+	}
+
+	ip, err := manet.ToIP(addr)
+	if err != nil {
+		return len(f.allowedNetworks) == 0
+	}
+
+	if containsIP(f.deniedNetworks, ip) {
+		return false
+	}
+
+	return len(f.allowedNetworks) == 0 || containsIP(f.allowedNetworks, ip)
+}
+
+// Allows returns true if the node is allowed to connect to the peer with the given ID at the given address.
+func (f *PeerFilter) Allows(id peer.ID, addr multiaddr.Multiaddr) bool {
+	return f.AllowsPeerID(id) && f.AllowsAddress(addr)
+}
+
+// FilterAddresses returns the addresses the node is allowed to connect to.
+func (f *PeerFilter) FilterAddresses(addresses []multiaddr.Multiaddr) []multiaddr.Multiaddr {
+	if f == nil {
+		return addresses
+	}
+
+	return lo.Filter(addresses, f.AllowsAddress)
+}
+
+func parsePeerIDs(peerIDs []string) (map[peer.ID]struct{}, error) {
+	parsedPeerIDs := make(map[peer.ID]struct{}, len(peerIDs))
+	for _, peerID := range peerIDs {
+		parsedPeerID, err := peer.Decode(peerID)
+		if err != nil {
+			return nil, ierrors.Wrapf(err, "failed to parse peer ID %s", peerID)
+		}
+
+		parsedPeerIDs[parsedPeerID] = struct{}{}
+	}
+
+	return parsedPeerIDs, nil
+}
+
+func parseNetworks(cidrs []string) ([]*net.IPNet, error) {
+	networks := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		if !strings.Contains(cidr, "/") {
+			ip := net.ParseIP(cidr)
+			if ip == nil {
+				return nil, ierrors.Errorf("failed to parse IP address %s", cidr)
+			}
+
+			if ip4 := ip.To4(); ip4 != nil {
+				ip = ip4
+			}
+
+			networks = append(networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(len(ip)*8, len(ip)*8)})
+
+			continue
+		}
+
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, ierrors.Wrapf(err, "failed to parse CIDR %s", cidr)
+		}
+
+		networks = append(networks, ipNet)
+	}
+
+	return networks, nil
+}
+
+func containsIP(networks []*net.IPNet, ip net.IP) bool {
+	for _, ipNet := range networks {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,82 @@
+package network
+
+import (
+	"testing"
+
+	p2pcrypto "github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/lo"
+)
+
+func newTestPeerID(t *testing.T) peer.ID {
+	privateKey, _, err := p2pcrypto.GenerateEd25519Key(nil)
+	require.NoError(t, err)
+
+	return lo.PanicOnErr(peer.IDFromPrivateKey(privateKey))
+}
+
+func TestPeerFilter_PeerIDs(t *testing.T) {
+	allowedPeerID := newTestPeerID(t)
+	deniedPeerID := newTestPeerID(t)
+	otherPeerID := newTestPeerID(t)
+
+	// without an allowlist, all peers that are not denied are allowed
+	peerFilter, err := NewPeerFilter(nil, []string{deniedPeerID.String()}, nil, nil)
+	require.NoError(t, err)
+	require.True(t, peerFilter.AllowsPeerID(allowedPeerID))
+	require.True(t, peerFilter.AllowsPeerID(otherPeerID))
+	require.False(t, peerFilter.AllowsPeerID(deniedPeerID))
+
+	// with an allowlist, only the allowed peers are allowed
+	peerFilter, err = NewPeerFilter([]string{allowedPeerID.String(), deniedPeerID.String()}, []string{deniedPeerID.String()}, nil, nil)
+	require.NoError(t, err)
+	require.True(t, peerFilter.AllowsPeerID(allowedPeerID))
+	require.False(t, peerFilter.AllowsPeerID(otherPeerID))
+	require.False(t, peerFilter.AllowsPeerID(deniedPeerID))
+
+	_, err = NewPeerFilter([]string{"invalid"}, nil, nil, nil)
+	require.Error(t, err)
+
+	// a nil filter allows everything
+	var nilPeerFilter *PeerFilter
+	require.True(t, nilPeerFilter.AllowsPeerID(deniedPeerID))
+}
+
+func TestPeerFilter_CIDRs(t *testing.T) {
+	addr := func(s string) multiaddr.Multiaddr {
+		return lo.PanicOnErr(multiaddr.NewMultiaddr(s))
+	}
+
+	peerFilter, err := NewPeerFilter(nil, nil, []string{"10.0.0.0/8", "2001:db8::/32", "192.168.1.1"}, []string{"10.1.0.0/16"})
+	require.NoError(t, err)
+
+	require.True(t, peerFilter.AllowsAddress(addr("/ip4/10.0.0.1/tcp/15600")))
+	require.False(t, peerFilter.AllowsAddress(addr("/ip4/10.1.0.1/tcp/15600")))
+	require.True(t, peerFilter.AllowsAddress(addr("/ip4/192.168.1.1/tcp/15600")))
+	require.False(t, peerFilter.AllowsAddress(addr("/ip4/192.168.1.2/tcp/15600")))
+	require.True(t, peerFilter.AllowsAddress(addr("/ip6/2001:db8::1/tcp/15600")))
+	require.False(t, peerFilter.AllowsAddress(addr("/ip6/2001:db9::1/tcp/15600")))
+	// DNS addresses can not be checked against the allowed networks
+	require.False(t, peerFilter.AllowsAddress(addr("/dns4/example.com/tcp/15600")))
+
+	require.Equal(t, []multiaddr.Multiaddr{addr("/ip4/10.0.0.1/tcp/15600")}, peerFilter.FilterAddresses([]multiaddr.Multiaddr{
+		addr("/ip4/10.0.0.1/tcp/15600"),
+		addr("/ip4/10.1.0.1/tcp/15600"),
+		addr("/ip4/8.8.8.8/tcp/15600"),
+	}))
+
+	// without allowed networks, only the denied networks are rejected
+	peerFilter, err = NewPeerFilter(nil, nil, nil, []string{"10.1.0.0/16"})
+	require.NoError(t, err)
+	require.True(t, peerFilter.AllowsAddress(addr("/ip4/8.8.8.8/tcp/15600")))
+	require.True(t, peerFilter.AllowsAddress(addr("/dns4/example.com/tcp/15600")))
+	require.False(t, peerFilter.AllowsAddress(addr("/ip4/10.1.2.3/tcp/15600")))
+
+	_, err = NewPeerFilter(nil, nil, []string{"10.0.0.0/33"}, nil)
+	require.Error(t, err)
+	_, err = NewPeerFilter(nil, nil, nil, []string{"invalid"})
+	require.Error(t, err)
+}
@@ -2,6 +2,8 @@ package network
 
 import (
 	"context"
+	"net"
+	"time"
 
 	"github.com/libp2p/go-libp2p/core/host"
 	"github.com/libp2p/go-libp2p/core/peer"
@@ -40,6 +42,15 @@ type Manager interface {
 	// DisconnectNeighbor disconnects the neighbor with the given ID.
 	DisconnectNeighbor(peerID peer.ID) error
 
+	// ReportMisbehavior decreases the reputation of the peer and bans it if it misbehaved too often.
+	ReportMisbehavior(peerID peer.ID, misbehavior Misbehavior, reason error)
+	// BanPeer bans the peer with the given ID and IP addresses for the given duration (0 for the default duration) and disconnects it.
+	BanPeer(peerID peer.ID, ips []net.IP, duration time.Duration, reason string) (*Ban, error)
+	// Unban lifts the ban with the given key (peer ID or IP address).
+	Unban(key string) error
+	// Bans returns all active bans.
+	Bans() []*Ban
+
 	// Neighbors returns all the neighbors that are currently connected.
 	Neighbors() []Neighbor
 	// AutopeeringNeighbors returns all the neighbors that are currently connected via autopeering.
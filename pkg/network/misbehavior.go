@@ -0,0 +1,33 @@
+package network
+
+import "fmt"
+
+// Misbehavior is a protocol violation of a peer that decreases its reputation.
+type Misbehavior uint8
+
+const (
+	// MisbehaviorMalformedPacket is reported if a peer sends a packet that can not be parsed.
+	MisbehaviorMalformedPacket Misbehavior = iota + 1
+	// MisbehaviorInvalidBlock is reported if a peer sends a block of a known protocol version that is syntactically invalid.
+	MisbehaviorInvalidBlock
+	// MisbehaviorInvalidAttestations is reported if a peer sends attestations that can not be verified.
+	MisbehaviorInvalidAttestations
+	// MisbehaviorInvalidWarpSyncProof is reported if a peer sends a warp sync response with an invalid proof.
+	MisbehaviorInvalidWarpSyncProof
+)
+
+// String returns a human-readable representation of the Misbehavior.
+func (m Misbehavior) String() string {
+	switch m {
+	case MisbehaviorMalformedPacket:
+		return "malformed packet"
+	case MisbehaviorInvalidBlock:
+		return "invalid block"
+	case MisbehaviorInvalidAttestations:
+		return "invalid attestations"
+	case MisbehaviorInvalidWarpSyncProof:
+		return "invalid warp sync proof"
+	default:
+		return fmt.Sprintf("unknown misbehavior %d", m)
+	}
+}
@@ -34,6 +34,7 @@ type Manager struct {
 	stopFunc         context.CancelFunc
 	routingDiscovery *routing.RoutingDiscovery
 	addrFilter       network.AddressFilter
+	peerFilter       *network.PeerFilter
 
 	advertiseLock   sync.Mutex
 	advertiseCtx    context.Context
@@ -41,13 +42,14 @@ type Manager struct {
 }
 
 // NewManager creates a new autopeering manager.
-func NewManager(maxPeers int, networkManager network.Manager, host host.Host, addressFilter network.AddressFilter, logger log.Logger) *Manager {
+func NewManager(maxPeers int, networkManager network.Manager, host host.Host, addressFilter network.AddressFilter, peerFilter *network.PeerFilter, logger log.Logger) *Manager {
 	return &Manager{
 		maxPeers:       maxPeers,
 		networkManager: networkManager,
 		host:           host,
 		logger:         logger.NewChildLogger("Autopeering"),
 		addrFilter:     addressFilter,
+		peerFilter:     peerFilter,
 	}
 }
 
@@ -249,9 +251,14 @@ func (m *Manager) discoverAndDialPeers() {
 			continue
 		}
 
+		if !m.peerFilter.AllowsPeerID(peerAddrInfo.ID) {
+			m.logger.LogDebugf("Filtered out peer %s because it is rejected by the peer filter", peerAddrInfo.ID)
+			continue
+		}
+
 		peerInfo := m.filteredPeerAddrInfo(&peerAddrInfo)
 		if len(peerInfo.Addrs) == 0 {
-			m.logger.LogWarnf("Filtered out peer %s because it has no public reachable addresses", peerAddrInfo)
+			m.logger.LogWarnf("Filtered out peer %s because it has no allowed public reachable addresses", peerAddrInfo)
 			continue
 		}
 
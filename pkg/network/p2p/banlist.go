@@ -0,0 +1,173 @@
+package p2p
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/runtime/ioutils"
+	"github.com/iotaledger/hive.go/runtime/syncutils"
+	"github.com/iotaledger/iota-core/pkg/network"
+)
+
+// ErrBanNotFound is returned if a ban that should be lifted does not exist.
+var ErrBanNotFound = ierrors.New("ban not found")
+
+// BanList contains the banned peers and persists them to a file, so that bans survive a restart of the node.
+type BanList struct {
+	filePath string
+	bans     map[string]*network.Ban
+	mutex    syncutils.RWMutex
+}
+
+// NewBanList creates a new BanList and loads the persisted bans from the given file (if it exists).
+// If the file path is empty, the bans are only kept in memory.
+func NewBanList(filePath string) (*BanList, error) {
+	b := &BanList{
+		filePath: filePath,
+		bans:     make(map[string]*network.Ban),
+	}
+
+	if filePath == "" {
+		return b, nil
+	}
+
+	var bans []*network.Ban
+	if err := ioutils.ReadJSONFromFile(filePath, &bans); err != nil {
+		if ierrors.Is(err, os.ErrNotExist) {
+			return b, nil
+		}
+
+		return nil, ierrors.Wrapf(err, "failed to read ban list from %s", filePath)
+	}
+
+	now := time.Now()
+	for _, ban := range bans {
+		if !ban.IsExpired(now) && ban.Key() != "" {
+			b.bans[ban.Key()] = ban
+		}
+	}
+
+	return b, nil
+}
+
+// Ban adds the given ban to the list. An existing ban with the same key is extended by the new ban.
+func (b *BanList) Ban(ban *network.Ban) error {
+	if ban.Key() == "" {
+		return ierrors.New("a ban needs a peer ID or an IP address")
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if existingBan, exists := b.bans[ban.Key()]; exists && !existingBan.IsExpired(time.Now()) {
+		ban.IPs = mergeIPs(existingBan.IPs, ban.IPs)
+		if existingBan.ExpiresAt.After(ban.ExpiresAt) {
+			ban.ExpiresAt = existingBan.ExpiresAt
+		}
+	}
+
+	b.bans[ban.Key()] = ban
+
+	return b.store()
+}
+
+// Unban lifts the ban with the given key (peer ID or IP address).
+func (b *BanList) Unban(key string) error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if _, exists := b.bans[key]; !exists {
+		return ierrors.WithMessagef(ErrBanNotFound, "no ban for %s", key)
+	}
+
+	delete(b.bans, key)
+
+	return b.store()
+}
+
+// IsBanned returns true if the given peer ID or one of the given IP addresses is banned.
+func (b *BanList) IsBanned(peerID peer.ID, ips ...net.IP) bool {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	now := time.Now()
+	for _, ban := range b.bans {
+		if !ban.IsExpired(now) && ban.Matches(peerID, ips...) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Bans returns all bans that are not expired, sorted by the time they were created.
+func (b *BanList) Bans() []*network.Ban {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	return b.activeBans(time.Now())
+}
+
+func (b *BanList) activeBans(now time.Time) []*network.Ban {
+	bans := make([]*network.Ban, 0, len(b.bans))
+	for _, ban := range b.bans {
+		if !ban.IsExpired(now) {
+			bans = append(bans, ban)
+		}
+	}
+
+	sort.Slice(bans, func(i, j int) bool {
+		if bans[i].BannedAt.Equal(bans[j].BannedAt) {
+			return bans[i].Key() < bans[j].Key()
+		}
+
+		return bans[i].BannedAt.Before(bans[j].BannedAt)
+	})
+
+	return bans
+}
+
+// store writes the active bans to the file and drops the expired ones.
+func (b *BanList) store() error {
+	bans := b.activeBans(time.Now())
+
+	b.bans = make(map[string]*network.Ban, len(bans))
+	for _, ban := range bans {
+		b.bans[ban.Key()] = ban
+	}
+
+	if b.filePath == "" {
+		return nil
+	}
+
+	if err := ioutils.CreateDirectory(filepath.Dir(b.filePath), 0o700); err != nil {
+		return ierrors.Wrap(err, "failed to create ban list directory")
+	}
+
+	if err := ioutils.WriteJSONToFile(b.filePath, bans, 0o600); err != nil {
+		return ierrors.Wrapf(err, "failed to store ban list to %s", b.filePath)
+	}
+
+	return nil
+}
+
+// mergeIPs returns the union of the given IP addresses.
+func mergeIPs(ips []string, additionalIPs []string) []string {
+	merged := make([]string, 0, len(ips)+len(additionalIPs))
+	seen := make(map[string]struct{}, len(ips)+len(additionalIPs))
+
+	for _, ip := range append(append([]string{}, ips...), additionalIPs...) {
+		if _, exists := seen[ip]; !exists {
+			seen[ip] = struct{}{}
+			merged = append(merged, ip)
+		}
+	}
+
+	return merged
+}
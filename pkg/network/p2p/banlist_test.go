@@ -0,0 +1,98 @@
+package p2p
+
+import (
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/iota-core/pkg/network"
+)
+
+func TestBanList_Persistence(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "p2p", "banlist.json")
+
+	banList, err := NewBanList(filePath)
+	require.NoError(t, err)
+	require.Empty(t, banList.Bans())
+
+	peerID, _ := newTestPeerMultiAddr(t, 15600)
+	otherPeerID, _ := newTestPeerMultiAddr(t, 15601)
+	now := time.Now()
+
+	require.NoError(t, banList.Ban(&network.Ban{
+		PeerID:    peerID,
+		IPs:       []string{"10.0.0.1"},
+		Reason:    "invalid blocks",
+		BannedAt:  now,
+		ExpiresAt: now.Add(time.Hour),
+	}))
+	require.NoError(t, banList.Ban(&network.Ban{
+		IPs:       []string{"10.0.0.2"},
+		BannedAt:  now.Add(time.Second),
+		ExpiresAt: now.Add(time.Hour),
+	}))
+	require.Error(t, banList.Ban(&network.Ban{ExpiresAt: now.Add(time.Hour)}))
+
+	require.True(t, banList.IsBanned(peerID))
+	require.True(t, banList.IsBanned(otherPeerID, net.ParseIP("10.0.0.1")))
+	require.True(t, banList.IsBanned(otherPeerID, net.ParseIP("10.0.0.2")))
+	require.False(t, banList.IsBanned(otherPeerID, net.ParseIP("10.0.0.3")))
+
+	// the bans survive a restart
+	reloadedBanList, err := NewBanList(filePath)
+	require.NoError(t, err)
+
+	bans := reloadedBanList.Bans()
+	require.Len(t, bans, 2)
+	require.Equal(t, peerID, bans[0].PeerID)
+	require.Equal(t, "invalid blocks", bans[0].Reason)
+	require.Equal(t, "10.0.0.2", bans[1].Key())
+
+	require.NoError(t, reloadedBanList.Unban(peerID.String()))
+	require.True(t, ierrors.Is(reloadedBanList.Unban(peerID.String()), ErrBanNotFound))
+	require.False(t, reloadedBanList.IsBanned(peerID, net.ParseIP("10.0.0.1")))
+
+	reloadedBanList, err = NewBanList(filePath)
+	require.NoError(t, err)
+	require.Len(t, reloadedBanList.Bans(), 1)
+}
+
+func TestBanList_Expiry(t *testing.T) {
+	banList, err := NewBanList("")
+	require.NoError(t, err)
+
+	peerID, _ := newTestPeerMultiAddr(t, 15600)
+	now := time.Now()
+
+	require.NoError(t, banList.Ban(&network.Ban{
+		PeerID:    peerID,
+		IPs:       []string{"10.0.0.1"},
+		BannedAt:  now.Add(-2 * time.Hour),
+		ExpiresAt: now.Add(-time.Hour),
+	}))
+	require.False(t, banList.IsBanned(peerID))
+	require.Empty(t, banList.Bans())
+
+	// banning a peer again extends the ban and keeps the known IP addresses
+	require.NoError(t, banList.Ban(&network.Ban{
+		PeerID:    peerID,
+		IPs:       []string{"10.0.0.1"},
+		BannedAt:  now,
+		ExpiresAt: now.Add(2 * time.Hour),
+	}))
+	require.NoError(t, banList.Ban(&network.Ban{
+		PeerID:    peerID,
+		IPs:       []string{"10.0.0.2"},
+		BannedAt:  now,
+		ExpiresAt: now.Add(time.Hour),
+	}))
+
+	bans := banList.Bans()
+	require.Len(t, bans, 1)
+	require.Equal(t, []string{"10.0.0.1", "10.0.0.2"}, bans[0].IPs)
+	require.Equal(t, now.Add(2*time.Hour), bans[0].ExpiresAt)
+}
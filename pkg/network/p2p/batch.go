@@ -0,0 +1,45 @@
+package p2p
+
+import (
+	"google.golang.org/protobuf/proto"
+
+	nwmodels "github.com/iotaledger/iota-core/pkg/network/protocols/core/models"
+)
+
+// newPacketBatch returns a packet that contains all the given packets.
+func newPacketBatch(packets []*nwmodels.Packet) *nwmodels.Packet {
+	if len(packets) == 1 {
+		return packets[0]
+	}
+
+	return &nwmodels.Packet{Body: &nwmodels.Packet_Batch{Batch: &nwmodels.PacketBatch{
+		Packets: packets,
+	}}}
+}
+
+// unbatchPackets returns the packets contained in the given batch, or the packet itself if it is not a batch.
+// Batches are never nested, so only a single level is unpacked.
+func unbatchPackets(packet *nwmodels.Packet) []*nwmodels.Packet {
+	if batch := packet.GetBatch(); batch != nil {
+		return batch.GetPackets()
+	}
+
+	return []*nwmodels.Packet{packet}
+}
+
+// receivedPackets returns the packets contained in a packet received from a neighbor, which might be a batch.
+func receivedPackets(packet proto.Message) []proto.Message {
+	corePacket, isCorePacket := packet.(*nwmodels.Packet)
+	if !isCorePacket {
+		return []proto.Message{packet}
+	}
+
+	unbatchedPackets := unbatchPackets(corePacket)
+
+	packets := make([]proto.Message, 0, len(unbatchedPackets))
+	for _, unbatchedPacket := range unbatchedPackets {
+		packets = append(packets, unbatchedPacket)
+	}
+
+	return packets
+}
@@ -5,21 +5,39 @@ import (
 	"github.com/multiformats/go-multiaddr"
 
 	"github.com/iotaledger/hive.go/ds/onchangemap"
+	"github.com/iotaledger/hive.go/ierrors"
 	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/runtime/syncutils"
+	"github.com/iotaledger/iota-core/pkg/network"
 )
 
 // ConfigManager handles the list of peers that are stored in the peering config.
 // It calls a function if the list changed.
 type ConfigManager struct {
-	onChangeMap *onchangemap.OnChangeMap[string, *ComparablePeerID, *PeerConfigItem]
+	onChangeMap   *onchangemap.OnChangeMap[string, *ComparablePeerID, *PeerConfigItem]
+	loadCallback  func() ([]*PeerConfig, error)
+	storeOnChange bool
+	mutex         syncutils.Mutex
+}
+
+// PeerConfigChanges contains the differences between the known peers and the reloaded peering config.
+type PeerConfigChanges struct {
+	// Added are the peers that were added to the peering config.
+	Added []*PeerConfigItem
+	// Modified are the peers whose multi address changed in the peering config.
+	Modified []*PeerConfigItem
+	// Removed are the peers that were removed from the peering config.
+	Removed []*PeerConfigItem
 }
 
 // NewConfigManager creates a new config manager.
-func NewConfigManager(storeCallback func([]*PeerConfigItem) error) *ConfigManager {
+// The storeCallback is called with all peers if the list changed, the loadCallback reads the peers from the peering config.
+func NewConfigManager(storeCallback func([]*PeerConfigItem) error, loadCallback func() ([]*PeerConfig, error)) *ConfigManager {
 	return &ConfigManager{
 		onChangeMap: onchangemap.NewOnChangeMap(
 			onchangemap.WithChangedCallback(storeCallback),
 		),
+		loadCallback: loadCallback,
 	}
 }
 
@@ -38,8 +56,11 @@ func (pm *ConfigManager) Peers() []*PeerConfigItem {
 	return lo.Values(pm.onChangeMap.All())
 }
 
-// AddPeer adds a peer to the config manager.
+// AddPeer adds a peer to the config manager or replaces the existing peer with the same ID.
 func (pm *ConfigManager) AddPeer(multiAddress multiaddr.Multiaddr, alias string) error {
+	pm.mutex.Lock()
+	defer pm.mutex.Unlock()
+
 	comparablePeerConfig, err := NewPeerConfigItem(&PeerConfig{
 		MultiAddress: multiAddress.String(),
 		Alias:        alias,
@@ -48,26 +69,129 @@ func (pm *ConfigManager) AddPeer(multiAddress multiaddr.Multiaddr, alias string)
 		return err
 	}
 
-	if err := pm.onChangeMap.Add(comparablePeerConfig); err != nil {
-		// already exists, modify the existing
-		_, err := pm.onChangeMap.Modify(comparablePeerConfig.ID(), func(item *PeerConfigItem) bool {
-			*item = *comparablePeerConfig
-			return true
-		})
+	if _, err := pm.onChangeMap.Get(comparablePeerConfig.ID()); err != nil {
+		return pm.onChangeMap.Add(comparablePeerConfig)
+	}
 
-		return err
+	// already exists, modify the existing
+	_, err = pm.onChangeMap.Modify(comparablePeerConfig.ID(), func(item *PeerConfigItem) bool {
+		*item = *comparablePeerConfig
+		return true
+	})
+
+	return err
+}
+
+// SetPeerAlias changes the alias of a known peer.
+func (pm *ConfigManager) SetPeerAlias(peerID peer.ID, alias string) (*PeerConfigItem, error) {
+	pm.mutex.Lock()
+	defer pm.mutex.Unlock()
+
+	if _, err := pm.onChangeMap.Get(NewComparablePeerID(peerID)); err != nil {
+		return nil, ierrors.WithMessagef(network.ErrUnknownPeer, "peer %s is not in the peering config", peerID)
 	}
 
-	return nil
+	return pm.onChangeMap.Modify(NewComparablePeerID(peerID), func(item *PeerConfigItem) bool {
+		if item.Alias == alias {
+			return false
+		}
+
+		item.PeerConfig = &PeerConfig{
+			MultiAddress: item.MultiAddress,
+			Alias:        alias,
+		}
+
+		return true
+	})
 }
 
-// RemovePeer removes a peer from the config manager.
+// RemovePeer removes a peer from the config manager. Removing an unknown peer is a no-op.
 func (pm *ConfigManager) RemovePeer(peerID peer.ID) error {
+	pm.mutex.Lock()
+	defer pm.mutex.Unlock()
+
+	if _, err := pm.onChangeMap.Get(NewComparablePeerID(peerID)); err != nil {
+		return nil
+	}
+
 	return pm.onChangeMap.Delete(NewComparablePeerID(peerID))
 }
 
+// Reload replaces the known peers with the peers of the peering config and returns the changes.
+// The peering config is the source of truth, so the reloaded peers are not stored again.
+func (pm *ConfigManager) Reload() (*PeerConfigChanges, error) {
+	if pm.loadCallback == nil {
+		return nil, ierrors.New("reloading the peering config is not supported")
+	}
+
+	peerConfigs, err := pm.loadCallback()
+	if err != nil {
+		return nil, ierrors.Wrap(err, "failed to load peering config")
+	}
+
+	reloadedPeers := make(map[string]*PeerConfigItem, len(peerConfigs))
+	for i, peerConfig := range peerConfigs {
+		peerConfigItem, err := NewPeerConfigItem(peerConfig)
+		if err != nil {
+			return nil, ierrors.Wrapf(err, "invalid peer at pos %d", i)
+		}
+
+		reloadedPeers[peerConfigItem.ID().Key()] = peerConfigItem
+	}
+
+	pm.mutex.Lock()
+	defer pm.mutex.Unlock()
+
+	pm.onChangeMap.CallbacksEnabled(false)
+	defer pm.onChangeMap.CallbacksEnabled(pm.storeOnChange)
+
+	changes := &PeerConfigChanges{}
+
+	for key, knownPeer := range pm.onChangeMap.All() {
+		if _, exists := reloadedPeers[key]; exists {
+			continue
+		}
+
+		if err := pm.onChangeMap.Delete(knownPeer.ID()); err != nil {
+			return nil, err
+		}
+
+		changes.Removed = append(changes.Removed, knownPeer)
+	}
+
+	for _, reloadedPeer := range reloadedPeers {
+		knownPeer, err := pm.onChangeMap.Get(reloadedPeer.ID())
+		if err != nil {
+			if err := pm.onChangeMap.Add(reloadedPeer); err != nil {
+				return nil, err
+			}
+
+			changes.Added = append(changes.Added, reloadedPeer)
+
+			continue
+		}
+
+		if _, err := pm.onChangeMap.Modify(reloadedPeer.ID(), func(item *PeerConfigItem) bool {
+			*item = *reloadedPeer
+			return true
+		}); err != nil {
+			return nil, err
+		}
+
+		if knownPeer.MultiAddress != reloadedPeer.MultiAddress {
+			changes.Modified = append(changes.Modified, reloadedPeer)
+		}
+	}
+
+	return changes, nil
+}
+
 // StoreOnChange sets whether storing changes to the config is active or not.
 func (pm *ConfigManager) StoreOnChange(enabled bool) {
+	pm.mutex.Lock()
+	defer pm.mutex.Unlock()
+
+	pm.storeOnChange = enabled
 	pm.onChangeMap.CallbacksEnabled(enabled)
 }
 
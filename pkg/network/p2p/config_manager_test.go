@@ -0,0 +1,132 @@
+package p2p
+
+import (
+	"fmt"
+	"testing"
+
+	p2pcrypto "github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/iota-core/pkg/network"
+)
+
+func newTestPeerMultiAddr(t *testing.T, port int) (peer.ID, multiaddr.Multiaddr) {
+	privateKey, _, err := p2pcrypto.GenerateEd25519Key(nil)
+	require.NoError(t, err)
+
+	peerID, err := peer.IDFromPrivateKey(privateKey)
+	require.NoError(t, err)
+
+	return peerID, lo.PanicOnErr(multiaddr.NewMultiaddr(fmt.Sprintf("/ip4/127.0.0.1/tcp/%d/p2p/%s", port, peerID)))
+}
+
+func TestConfigManager_Store(t *testing.T) {
+	var storedPeers []*PeerConfigItem
+	storeCalls := 0
+
+	configManager := NewConfigManager(func(peers []*PeerConfigItem) error {
+		storedPeers = peers
+		storeCalls++
+
+		return nil
+	}, nil)
+	configManager.StoreOnChange(true)
+
+	peerID, multiAddr := newTestPeerMultiAddr(t, 15600)
+
+	require.NoError(t, configManager.AddPeer(multiAddr, "node1"))
+	require.Equal(t, 1, storeCalls)
+	require.Len(t, storedPeers, 1)
+	require.Equal(t, "node1", storedPeers[0].Alias)
+
+	// adding an existing peer replaces it
+	require.NoError(t, configManager.AddPeer(multiAddr, "node1-renamed"))
+	require.Equal(t, 2, storeCalls)
+	require.Equal(t, "node1-renamed", configManager.Peer(peerID).Alias)
+
+	peerConfigItem, err := configManager.SetPeerAlias(peerID, "node1-alias")
+	require.NoError(t, err)
+	require.Equal(t, "node1-alias", peerConfigItem.Alias)
+	require.Equal(t, multiAddr.String(), peerConfigItem.MultiAddress)
+	require.Equal(t, 3, storeCalls)
+	require.Equal(t, "node1-alias", storedPeers[0].Alias)
+
+	// setting the same alias again does not store the config
+	_, err = configManager.SetPeerAlias(peerID, "node1-alias")
+	require.NoError(t, err)
+	require.Equal(t, 3, storeCalls)
+
+	unknownPeerID, _ := newTestPeerMultiAddr(t, 15601)
+	_, err = configManager.SetPeerAlias(unknownPeerID, "unknown")
+	require.True(t, ierrors.Is(err, network.ErrUnknownPeer))
+
+	// removing an unknown peer is a no-op
+	require.NoError(t, configManager.RemovePeer(unknownPeerID))
+	require.Equal(t, 3, storeCalls)
+
+	require.NoError(t, configManager.RemovePeer(peerID))
+	require.Equal(t, 4, storeCalls)
+	require.Empty(t, storedPeers)
+	require.Nil(t, configManager.Peer(peerID))
+}
+
+func TestConfigManager_Reload(t *testing.T) {
+	storeCalls := 0
+	var peeringConfig []*PeerConfig
+
+	configManager := NewConfigManager(func(peers []*PeerConfigItem) error {
+		storeCalls++
+
+		return nil
+	}, func() ([]*PeerConfig, error) {
+		return peeringConfig, nil
+	})
+
+	keptPeerID, keptMultiAddr := newTestPeerMultiAddr(t, 15600)
+	movedPeerID, movedMultiAddr := newTestPeerMultiAddr(t, 15601)
+	removedPeerID, removedMultiAddr := newTestPeerMultiAddr(t, 15602)
+	addedPeerID, addedMultiAddr := newTestPeerMultiAddr(t, 15603)
+
+	require.NoError(t, configManager.AddPeer(keptMultiAddr, "kept"))
+	require.NoError(t, configManager.AddPeer(movedMultiAddr, "moved"))
+	require.NoError(t, configManager.AddPeer(removedMultiAddr, "removed"))
+	configManager.StoreOnChange(true)
+
+	peeringConfig = []*PeerConfig{
+		{MultiAddress: keptMultiAddr.String(), Alias: "kept-renamed"},
+		{MultiAddress: fmt.Sprintf("/ip4/127.0.0.2/tcp/15601/p2p/%s", movedPeerID), Alias: "moved"},
+		{MultiAddress: addedMultiAddr.String(), Alias: "added"},
+	}
+
+	changes, err := configManager.Reload()
+	require.NoError(t, err)
+
+	// the peering config is the source of truth, so it is not stored again
+	require.Equal(t, 0, storeCalls)
+
+	require.Len(t, changes.Added, 1)
+	require.Equal(t, addedPeerID, changes.Added[0].ID().PeerID())
+	require.Len(t, changes.Modified, 1)
+	require.Equal(t, movedPeerID, changes.Modified[0].ID().PeerID())
+	require.Len(t, changes.Removed, 1)
+	require.Equal(t, removedPeerID, changes.Removed[0].ID().PeerID())
+
+	require.Len(t, configManager.Peers(), 3)
+	require.Equal(t, "kept-renamed", configManager.Peer(keptPeerID).Alias)
+	require.Equal(t, "/ip4/127.0.0.2/tcp/15601/p2p/"+movedPeerID.String(), configManager.Peer(movedPeerID).MultiAddress)
+	require.Nil(t, configManager.Peer(removedPeerID))
+
+	// storing is active again after the reload
+	require.NoError(t, configManager.RemovePeer(addedPeerID))
+	require.Equal(t, 1, storeCalls)
+
+	// an invalid peering config does not change the known peers
+	peeringConfig = []*PeerConfig{{MultiAddress: "invalid"}}
+	_, err = configManager.Reload()
+	require.Error(t, err)
+	require.Len(t, configManager.Peers(), 2)
+}
@@ -0,0 +1,51 @@
+package p2p
+
+import (
+	"github.com/libp2p/go-libp2p/core/connmgr"
+	"github.com/libp2p/go-libp2p/core/control"
+	p2pnetwork "github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+
+	"github.com/iotaledger/iota-core/pkg/network"
+)
+
+// ConnectionGater applies the PeerFilter to all connections of the libp2p host, so that rejected peers can neither be
+// reached by the gossip protocol nor by any other protocol like the autopeering DHT.
+type ConnectionGater struct {
+	peerFilter *network.PeerFilter
+}
+
+var _ connmgr.ConnectionGater = (*ConnectionGater)(nil)
+
+// NewConnectionGater creates a new ConnectionGater that rejects all connections that are denied by the PeerFilter.
+func NewConnectionGater(peerFilter *network.PeerFilter) *ConnectionGater {
+	return &ConnectionGater{
+		peerFilter: peerFilter,
+	}
+}
+
+// InterceptPeerDial tests whether the host is allowed to dial the given peer.
+func (g *ConnectionGater) InterceptPeerDial(peerID peer.ID) bool {
+	return g.peerFilter.AllowsPeerID(peerID)
+}
+
+// InterceptAddrDial tests whether the host is allowed to dial the given address of the peer.
+func (g *ConnectionGater) InterceptAddrDial(peerID peer.ID, addr multiaddr.Multiaddr) bool {
+	return g.peerFilter.Allows(peerID, addr)
+}
+
+// InterceptAccept tests whether an inbound connection from the given address is allowed.
+func (g *ConnectionGater) InterceptAccept(connAddrs p2pnetwork.ConnMultiaddrs) bool {
+	return g.peerFilter.AllowsAddress(connAddrs.RemoteMultiaddr())
+}
+
+// InterceptSecured tests whether an authenticated connection to the given peer is allowed.
+func (g *ConnectionGater) InterceptSecured(_ p2pnetwork.Direction, peerID peer.ID, connAddrs p2pnetwork.ConnMultiaddrs) bool {
+	return g.peerFilter.Allows(peerID, connAddrs.RemoteMultiaddr())
+}
+
+// InterceptUpgraded allows all upgraded connections, because they already passed the other checks.
+func (g *ConnectionGater) InterceptUpgraded(_ p2pnetwork.Conn) (bool, control.DisconnectReason) {
+	return true, 0
+}
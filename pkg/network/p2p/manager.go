@@ -2,11 +2,15 @@ package p2p
 
 import (
 	"context"
+	"fmt"
+	"net"
+	"time"
 
 	"github.com/libp2p/go-libp2p/core/host"
 	p2pnetwork "github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/iotaledger/hive.go/ds/shrinkingmap"
@@ -14,6 +18,7 @@ import (
 	"github.com/iotaledger/hive.go/lo"
 	"github.com/iotaledger/hive.go/log"
 	"github.com/iotaledger/hive.go/runtime/event"
+	"github.com/iotaledger/hive.go/runtime/options"
 	"github.com/iotaledger/hive.go/runtime/syncutils"
 	"github.com/iotaledger/iota-core/pkg/network"
 	"github.com/iotaledger/iota-core/pkg/network/p2p/autopeering"
@@ -49,28 +54,50 @@ type Manager struct {
 	onBlockSentCallback  func()
 
 	addrFilter    network.AddressFilter
+	peerFilter    *network.PeerFilter
 	autoPeering   *autopeering.Manager
 	manualPeering *manualpeering.Manager
+	reputation    *Reputation
+	rateLimiter   *RateLimiter
+
+	optsTopologyRole   TopologyRole
+	optsPrivatePeerIDs map[peer.ID]struct{}
+	optsCompression    bool
+	optsBatchSize      int
 }
 
 var _ network.Manager = (*Manager)(nil)
 
 // NewManager creates a new Manager.
-func NewManager(logger log.Logger, libp2pHost host.Host, maxAutopeeringPeers int, allowLocalAutopeering bool, onBlockSentCallback func()) *Manager {
-	m := &Manager{
+func NewManager(logger log.Logger, libp2pHost host.Host, maxAutopeeringPeers int, allowLocalAutopeering bool, peerFilter *network.PeerFilter, reputation *Reputation, rateLimiter *RateLimiter, onBlockSentCallback func(), opts ...options.Option[Manager]) *Manager {
+	publicOnlyAddressesFilter := network.PublicOnlyAddressesFilter(allowLocalAutopeering)
+	addrFilter := func(addresses []multiaddr.Multiaddr) []multiaddr.Multiaddr {
+		return peerFilter.FilterAddresses(publicOnlyAddressesFilter(addresses))
+	}
+
+	return options.Apply(&Manager{
 		logger:              logger,
 		libp2pHost:          libp2pHost,
 		neighborAdded:       event.New1[network.Neighbor](),
 		neighborRemoved:     event.New1[network.Neighbor](),
 		neighbors:           shrinkingmap.New[peer.ID, *neighbor](),
 		onBlockSentCallback: onBlockSentCallback,
-		addrFilter:          network.PublicOnlyAddressesFilter(allowLocalAutopeering),
-	}
-
-	m.autoPeering = autopeering.NewManager(maxAutopeeringPeers, m, libp2pHost, m.addrFilter, logger)
-	m.manualPeering = manualpeering.NewManager(m, logger)
+		addrFilter:          addrFilter,
+		peerFilter:          peerFilter,
+		reputation:          reputation,
+		rateLimiter:         rateLimiter,
+		optsTopologyRole:    TopologyRoleNone,
+		optsPrivatePeerIDs:  make(map[peer.ID]struct{}),
+	}, opts, func(m *Manager) {
+		// the DHT of a sentry must not know the addresses of the validators behind it.
+		var autopeeringHost host.Host = libp2pHost
+		if m.optsTopologyRole == TopologyRoleSentry && len(m.optsPrivatePeerIDs) > 0 {
+			autopeeringHost = newPrivatePeersHost(libp2pHost, m.optsPrivatePeerIDs)
+		}
 
-	return m
+		m.autoPeering = autopeering.NewManager(maxAutopeeringPeers, m, autopeeringHost, m.addrFilter, peerFilter, logger)
+		m.manualPeering = manualpeering.NewManager(m, logger)
+	})
 }
 
 // RegisterProtocol registers the handler for the protocol within the manager.
@@ -84,6 +111,7 @@ func (m *Manager) RegisterProtocol(factory func() proto.Message, handler func(pe
 	}
 
 	m.libp2pHost.SetStreamHandler(network.CoreProtocolID, m.handleStream)
+	m.libp2pHost.SetStreamHandler(network.CoreProtocolV2ID, m.handleStream)
 }
 
 // UnregisterProtocol unregisters the handler for the protocol.
@@ -92,6 +120,7 @@ func (m *Manager) UnregisterProtocol() {
 	defer m.protocolHandlerMutex.Unlock()
 
 	m.libp2pHost.RemoveStreamHandler(network.CoreProtocolID)
+	m.libp2pHost.RemoveStreamHandler(network.CoreProtocolV2ID)
 	m.protocolHandler = nil
 }
 
@@ -117,25 +146,41 @@ func (m *Manager) DialPeer(ctx context.Context, peer *network.Peer) error {
 		return ierrors.WithMessagef(network.ErrDuplicatePeer, "peer %s already exists", peer.ID.String())
 	}
 
+	if m.isBanned(peer.ID, peer.PeerAddresses...) {
+		return ierrors.WithMessagef(network.ErrPeerBanned, "peer %s is banned", peer.ID.String())
+	}
+
+	if !m.peerFilter.AllowsPeerID(peer.ID) {
+		return ierrors.WithMessagef(network.ErrPeerFiltered, "peer %s is not allowed", peer.ID.String())
+	}
+
 	if !m.allowPeer(peer.ID) {
 		return ierrors.WithMessagef(network.ErrMaxAutopeeringPeersReached, "peer %s is not allowed", peer.ID.String())
 	}
 
 	cancelCtx := ctx
 
-	stream, err := m.P2PHost().NewStream(cancelCtx, peer.ID, network.CoreProtocolID)
+	// prefer version 2 of the core protocol and fall back to version 1 for peers that don't support it.
+	stream, err := m.P2PHost().NewStream(cancelCtx, peer.ID, network.CoreProtocolV2ID, network.CoreProtocolID)
 	if err != nil {
 		return ierrors.Wrapf(err, "dial %s / %s failed to open stream for proto %s", peer.PeerAddresses, peer.ID.String(), network.CoreProtocolID)
 	}
 
-	ps := NewPacketsStream(stream, m.protocolHandler.PacketFactory)
+	// the host may dial any known address of the peer, so the address filter is applied to the established connection.
+	if !m.peerFilter.AllowsAddress(stream.Conn().RemoteMultiaddr()) {
+		m.closeStream(stream)
+
+		return ierrors.WithMessagef(network.ErrPeerFiltered, "address %s of peer %s is not allowed", stream.Conn().RemoteMultiaddr(), peer.ID.String())
+	}
+
+	ps := m.newPacketsStream(stream)
 	if err := ps.sendNegotiation(); err != nil {
 		m.closeStream(stream)
 
-		return ierrors.Wrapf(err, "dial %s / %s failed to send negotiation for proto %s", peer.PeerAddresses, peer.ID.String(), network.CoreProtocolID)
+		return ierrors.Wrapf(err, "dial %s / %s failed to send negotiation for proto %s", peer.PeerAddresses, peer.ID.String(), stream.Protocol())
 	}
 
-	m.logger.LogDebugf("outgoing stream negotiated, id: %s, addr: %s, proto: %s", peer.ID, ps.Conn().RemoteMultiaddr(), network.CoreProtocolID)
+	m.logger.LogDebugf("outgoing stream negotiated, id: %s, addr: %s, proto: %s", peer.ID, ps.Conn().RemoteMultiaddr(), stream.Protocol())
 
 	if err := m.addNeighbor(peer, ps, m.onBlockSentCallback); err != nil {
 		m.closeStream(stream)
@@ -152,6 +197,12 @@ func (m *Manager) Start(ctx context.Context, networkID string, bootstrapPeers []
 
 	m.manualPeering.Start()
 
+	if m.optsTopologyRole == TopologyRoleValidator {
+		m.logger.LogInfo("Running as validator behind sentry nodes, autopeering is disabled")
+
+		return nil
+	}
+
 	if m.autoPeering.MaxNeighbors() > 0 {
 		return m.autoPeering.Start(ctx, networkID, bootstrapPeers)
 	}
@@ -318,6 +369,20 @@ func (m *Manager) handleStream(stream p2pnetwork.Stream) {
 
 	peerID := stream.Conn().RemotePeer()
 
+	if m.isBanned(peerID, stream.Conn().RemoteMultiaddr()) {
+		m.logger.LogDebugf("peer %s is banned", peerID.String())
+		m.closeStream(stream)
+
+		return
+	}
+
+	if !m.peerFilter.Allows(peerID, stream.Conn().RemoteMultiaddr()) {
+		m.logger.LogDebugf("peer %s / %s is rejected by the peer filter", peerID.String(), stream.Conn().RemoteMultiaddr())
+		m.closeStream(stream)
+
+		return
+	}
+
 	if !m.allowPeer(peerID) {
 		m.logger.LogDebugf("peer %s is not allowed", peerID.String())
 		m.closeStream(stream)
@@ -325,7 +390,7 @@ func (m *Manager) handleStream(stream p2pnetwork.Stream) {
 		return
 	}
 
-	ps := NewPacketsStream(stream, m.protocolHandler.PacketFactory)
+	ps := m.newPacketsStream(stream)
 	if err := ps.receiveNegotiation(); err != nil {
 		m.logger.LogError("failed to receive negotiation message")
 		m.closeStream(stream)
@@ -347,6 +412,15 @@ func (m *Manager) handleStream(stream p2pnetwork.Stream) {
 	}
 }
 
+// newPacketsStream creates a PacketsStream for the negotiated version of the core protocol.
+func (m *Manager) newPacketsStream(stream p2pnetwork.Stream) *PacketsStream {
+	if stream.Protocol() == network.CoreProtocolV2ID {
+		return NewPacketsStream(stream, m.protocolHandler.PacketFactory, WithFrames(m.optsCompression))
+	}
+
+	return NewPacketsStream(stream, m.protocolHandler.PacketFactory)
+}
+
 func (m *Manager) closeStream(s p2pnetwork.Stream) {
 	if err := s.Reset(); err != nil {
 		m.logger.LogWarnf("close error, error: %s", err.Error())
@@ -384,11 +458,21 @@ func (m *Manager) addNeighbor(peer *network.Peer, ps *PacketsStream, onBlockSent
 		return ierrors.WithStack(network.ErrDuplicatePeer)
 	}
 
+	// every neighbor gets its own token buckets, so that a flooding neighbor does not affect the others.
+	peerRateLimiter := m.rateLimiter.newPeerRateLimiter(peer.ID)
+
+	// only peers that support version 2 of the core protocol are able to receive batches.
+	var batchSize int
+	if ps.IsFramed() {
+		batchSize = m.optsBatchSize
+	}
+
 	var innerErr error
 	nbr := newNeighbor(m.logger,
 		peer,
 		ps,
-		func(nbr *neighbor, packet proto.Message) {
+		batchSize,
+		func(nbr *neighbor, packet proto.Message, wireSize int) {
 			m.protocolHandlerMutex.RLock()
 			defer m.protocolHandlerMutex.RUnlock()
 
@@ -396,8 +480,12 @@ func (m *Manager) addNeighbor(peer *network.Peer, ps *PacketsStream, onBlockSent
 				nbr.logger.LogError("Can't handle packet as no protocol is registered")
 				return
 			}
-			if err := m.protocolHandler.PacketHandler(nbr.Peer().ID, packet); err != nil {
-				nbr.logger.LogDebugf("Can't handle packet, error: %s", err.Error())
+
+			// the packets of a batch are handled one by one.
+			for _, receivedPacket := range peerRateLimiter.Allow(packet, wireSize) {
+				if err := m.protocolHandler.PacketHandler(nbr.Peer().ID, receivedPacket); err != nil {
+					nbr.logger.LogDebugf("Can't handle packet, error: %s", err.Error())
+				}
 			}
 		},
 		onBlockSentCallback,
@@ -463,6 +551,13 @@ func (m *Manager) allowPeer(id peer.ID) (allow bool) {
 		return true
 	}
 
+	// A validator behind sentry nodes only talks to its manual peers, which are its sentries
+	if m.optsTopologyRole == TopologyRoleValidator {
+		m.logger.LogDebugf("Disallow peer %s, only sentry nodes are allowed", id.String())
+
+		return false
+	}
+
 	// Only allow up to the maximum number of autopeered neighbors
 	autopeeredNeighborsCount := len(m.AutopeeringNeighbors())
 	if autopeeredNeighborsCount < m.autoPeering.MaxNeighbors() {
@@ -475,3 +570,141 @@ func (m *Manager) allowPeer(id peer.ID) (allow bool) {
 
 	return false
 }
+
+// ReportMisbehavior decreases the reputation of the peer and bans it if it misbehaved too often.
+// Manual peers are trusted by the node operator, so they are never banned automatically.
+func (m *Manager) ReportMisbehavior(peerID peer.ID, misbehavior network.Misbehavior, reason error) {
+	score, reachedBanThreshold := m.reputation.Penalize(peerID, misbehavior)
+
+	m.logger.LogDebugf("peer %s misbehaved (%s), score: %.2f, reason: %s", peerID.String(), misbehavior, score, reason)
+
+	if !reachedBanThreshold {
+		return
+	}
+
+	if m.manualPeering.IsPeerKnown(peerID) {
+		m.logger.LogWarnf("manual peer %s reached the ban threshold (last misbehavior: %s, reason: %s), but manual peers are not banned automatically", peerID.String(), misbehavior, reason)
+
+		return
+	}
+
+	ban, err := m.BanPeer(peerID, nil, 0, fmt.Sprintf("%s: %s", misbehavior, reason))
+	if err != nil {
+		m.logger.LogErrorf("failed to ban peer %s: %s", peerID.String(), err)
+
+		return
+	}
+
+	m.logger.LogWarnf("banned peer %s with IPs %v until %s, reason: %s", peerID.String(), ban.IPs, ban.ExpiresAt.Format(time.RFC3339), ban.Reason)
+}
+
+// BanPeer bans the peer with the given ID and IP addresses for the given duration (0 for the default duration) and disconnects it.
+// The IP addresses the peer is currently connected from are banned as well.
+func (m *Manager) BanPeer(peerID peer.ID, ips []net.IP, duration time.Duration, reason string) (*network.Ban, error) {
+	if peerID == m.libp2pHost.ID() {
+		return nil, ierrors.WithStack(network.ErrLoopbackPeer)
+	}
+
+	if duration == 0 {
+		duration = m.reputation.BanDuration()
+	}
+
+	if peerID != "" {
+		ips = append(ips, m.neighborIPs(peerID)...)
+	}
+
+	bannedIPs := make([]string, 0, len(ips))
+	for _, ip := range ips {
+		bannedIPs = append(bannedIPs, ip.String())
+	}
+
+	now := time.Now()
+	ban := &network.Ban{
+		PeerID:    peerID,
+		IPs:       mergeIPs(nil, bannedIPs),
+		Reason:    reason,
+		BannedAt:  now,
+		ExpiresAt: now.Add(duration),
+	}
+
+	if err := m.reputation.BanList().Ban(ban); err != nil {
+		return nil, err
+	}
+
+	m.reputation.Forget(peerID)
+
+	// disconnect all neighbors that are affected by the ban
+	for _, nbr := range m.allNeighbors() {
+		if ban.Matches(nbr.Peer().ID, m.neighborIPs(nbr.Peer().ID)...) {
+			nbr.Close()
+		}
+	}
+
+	return ban, nil
+}
+
+// Unban lifts the ban with the given key (peer ID or IP address).
+func (m *Manager) Unban(key string) error {
+	return m.reputation.BanList().Unban(key)
+}
+
+// Bans returns all active bans.
+func (m *Manager) Bans() []*network.Ban {
+	return m.reputation.BanList().Bans()
+}
+
+// isBanned checks if the peer ID or one of the IP addresses of the given multi addresses is banned.
+func (m *Manager) isBanned(id peer.ID, addrs ...multiaddr.Multiaddr) bool {
+	ips := make([]net.IP, 0, len(addrs))
+	for _, addr := range addrs {
+		if ip, err := manet.ToIP(addr); err == nil {
+			ips = append(ips, ip)
+		}
+	}
+
+	return m.reputation.BanList().IsBanned(id, ips...)
+}
+
+// neighborIPs returns the IP addresses the given peer is connected from.
+func (m *Manager) neighborIPs(id peer.ID) []net.IP {
+	conns := m.libp2pHost.Network().ConnsToPeer(id)
+
+	ips := make([]net.IP, 0, len(conns))
+	for _, conn := range conns {
+		if ip, err := manet.ToIP(conn.RemoteMultiaddr()); err == nil {
+			ips = append(ips, ip)
+		}
+	}
+
+	return ips
+}
+
+// WithTopologyRole sets the role of the node in a sentry-node topology.
+func WithTopologyRole(role TopologyRole) options.Option[Manager] {
+	return func(m *Manager) {
+		m.optsTopologyRole = role
+	}
+}
+
+// WithPrivatePeers sets the peers whose addresses are never shared with other peers (the validators behind a sentry).
+func WithPrivatePeers(peerIDs ...peer.ID) options.Option[Manager] {
+	return func(m *Manager) {
+		for _, peerID := range peerIDs {
+			m.optsPrivatePeerIDs[peerID] = struct{}{}
+		}
+	}
+}
+
+// WithCompression enables the compression of the packets sent to neighbors that support it.
+func WithCompression(compression bool) options.Option[Manager] {
+	return func(m *Manager) {
+		m.optsCompression = compression
+	}
+}
+
+// WithBatchSize sets the maximum number of queued packets that are sent as a single batch to neighbors that support it.
+func WithBatchSize(batchSize int) options.Option[Manager] {
+	return func(m *Manager) {
+		m.optsBatchSize = batchSize
+	}
+}
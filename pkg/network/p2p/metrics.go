@@ -12,4 +12,6 @@ type Metrics struct {
 	IncomingNewBlocks atomic.Uint32
 	// The number of sent blocks.
 	OutgoingBlocks atomic.Uint32
+	// The number of received packets that were dropped because a neighbor exceeded its rate limit, by packet type.
+	RateLimitedPackets [PacketTypeCount]atomic.Uint64
 }
@@ -26,7 +26,7 @@ type queuedPacket struct {
 }
 
 type (
-	PacketReceivedFunc       func(neighbor *neighbor, packet proto.Message)
+	PacketReceivedFunc       func(neighbor *neighbor, packet proto.Message, wireSize int)
 	NeighborConnectedFunc    func(neighbor *neighbor)
 	NeighborDisconnectedFunc func(neighbor *neighbor)
 )
@@ -52,6 +52,8 @@ type neighbor struct {
 	loopCtxCancel context.CancelFunc
 
 	stream *PacketsStream
+	// batchSize is the maximum number of queued packets that are sent as a single batch.
+	batchSize int
 
 	sendQueue chan *queuedPacket
 }
@@ -59,7 +61,7 @@ type neighbor struct {
 var _ network.Neighbor = (*neighbor)(nil)
 
 // newNeighbor creates a new neighbor from the provided peer and connection.
-func newNeighbor(parentLogger log.Logger, p *network.Peer, stream *PacketsStream, packetReceivedCallback PacketReceivedFunc, onBlockSentCallback func(), connectedCallback NeighborConnectedFunc, disconnectedCallback NeighborDisconnectedFunc) *neighbor {
+func newNeighbor(parentLogger log.Logger, p *network.Peer, stream *PacketsStream, batchSize int, packetReceivedCallback PacketReceivedFunc, onBlockSentCallback func(), connectedCallback NeighborConnectedFunc, disconnectedCallback NeighborDisconnectedFunc) *neighbor {
 	ctx, cancel := context.WithCancel(context.Background())
 
 	n := &neighbor{
@@ -72,6 +74,7 @@ func newNeighbor(parentLogger log.Logger, p *network.Peer, stream *PacketsStream
 		loopCtx:             ctx,
 		loopCtxCancel:       cancel,
 		stream:              stream,
+		batchSize:           batchSize,
 		sendQueue:           make(chan *queuedPacket, NeighborsSendQueueSize),
 	}
 
@@ -129,7 +132,7 @@ func (n *neighbor) readLoop() {
 			// the disconnect call is protected with sync.Once, so in case another goroutine called it before us,
 			// we won't execute it twice.
 			packet := stream.packetFactory()
-			err := stream.ReadPacket(packet)
+			wireSize, err := stream.ReadPacket(packet)
 			if err != nil {
 				n.logger.LogInfof("Stream read packet error: %s", err.Error())
 				if disconnectErr := n.disconnect(); disconnectErr != nil {
@@ -141,7 +144,7 @@ func (n *neighbor) readLoop() {
 			n.connectOnce.Do(func() {
 				n.connectedFunc(n)
 			})
-			n.packetReceivedFunc(n, packet)
+			n.packetReceivedFunc(n, packet, wireSize)
 		}
 	}(n.stream)
 }
@@ -165,7 +168,9 @@ func (n *neighbor) writeLoop() {
 					return
 				}
 
-				if err := n.stream.WritePacket(sendPacket.packet); err != nil {
+				packet := n.batchQueuedPackets(sendPacket.packet)
+
+				if err := n.stream.WritePacket(packet); err != nil {
 					n.logger.LogWarnf("send error, peerID: %s, error: %s", n.Peer().ID.String(), err.Error())
 					if disconnectErr := n.disconnect(); disconnectErr != nil {
 						n.logger.LogWarnf("Failed to disconnect, error: %s", disconnectErr.Error())
@@ -176,8 +181,10 @@ func (n *neighbor) writeLoop() {
 
 				if n.onBlockSentCallback != nil {
 					//nolint:forcetypeassert // we know that the packet is a nwmodels.Packet
-					if block := sendPacket.packet.(*nwmodels.Packet).GetBlock(); block != nil {
-						n.onBlockSentCallback()
+					for _, sentPacket := range unbatchPackets(packet.(*nwmodels.Packet)) {
+						if block := sentPacket.GetBlock(); block != nil {
+							n.onBlockSentCallback()
+						}
 					}
 				}
 			}
@@ -185,6 +192,28 @@ func (n *neighbor) writeLoop() {
 	}()
 }
 
+// batchQueuedPackets combines the given packet with the packets that are already waiting in the send queue into a
+// single batch, so that they are sent (and compressed) together. It never waits for new packets to arrive.
+func (n *neighbor) batchQueuedPackets(packet proto.Message) proto.Message {
+	if n.batchSize <= 1 || len(n.sendQueue) == 0 {
+		return packet
+	}
+
+	//nolint:forcetypeassert // we know that the packet is a nwmodels.Packet
+	packets := []*nwmodels.Packet{packet.(*nwmodels.Packet)}
+	for len(packets) < n.batchSize {
+		select {
+		case queuedPacket := <-n.sendQueue:
+			//nolint:forcetypeassert // we know that the packet is a nwmodels.Packet
+			packets = append(packets, queuedPacket.packet.(*nwmodels.Packet))
+		default:
+			return newPacketBatch(packets)
+		}
+	}
+
+	return newPacketBatch(packets)
+}
+
 // Close closes the connection with the neighbor.
 func (n *neighbor) Close() {
 	if err := n.disconnect(); err != nil {
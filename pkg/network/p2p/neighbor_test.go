@@ -51,7 +51,7 @@ func TestNeighborWrite(t *testing.T) {
 	defer teardown()
 
 	var countA uint32
-	neighborA := newTestNeighbor("A", a, func(neighbor *neighbor, packet proto.Message) {
+	neighborA := newTestNeighbor("A", a, func(neighbor *neighbor, packet proto.Message, _ int) {
 		_ = packet.(*p2pproto.Negotiation)
 		atomic.AddUint32(&countA, 1)
 	})
@@ -59,7 +59,7 @@ func TestNeighborWrite(t *testing.T) {
 	neighborA.readLoop()
 
 	var countB uint32
-	neighborB := newTestNeighbor("B", b, func(neighbor *neighbor, packet proto.Message) {
+	neighborB := newTestNeighbor("B", b, func(neighbor *neighbor, packet proto.Message, _ int) {
 		_ = packet.(*p2pproto.Negotiation)
 		atomic.AddUint32(&countB, 1)
 	})
@@ -80,10 +80,10 @@ func newTestNeighbor(name string, stream p2pnetwork.Stream, packetReceivedFunc .
 	if len(packetReceivedFunc) > 0 {
 		packetReceived = packetReceivedFunc[0]
 	} else {
-		packetReceived = func(neighbor *neighbor, packet proto.Message) {}
+		packetReceived = func(neighbor *neighbor, packet proto.Message, _ int) {}
 	}
 
-	return newNeighbor(lo.Return1(testLogger.NewChildLogger(name)), newTestPeer(name), NewPacketsStream(stream, packetFactory), packetReceived, func() {}, func(neighbor *neighbor) {}, func(neighbor *neighbor) {})
+	return newNeighbor(lo.Return1(testLogger.NewChildLogger(name)), newTestPeer(name), NewPacketsStream(stream, packetFactory), 0, packetReceived, func() {}, func(neighbor *neighbor) {}, func(neighbor *neighbor) {})
 }
 
 func packetFactory() proto.Message {
@@ -1,16 +1,33 @@
 package p2p
 
 import (
+	"github.com/klauspost/compress/zstd"
 	p2pnetwork "github.com/libp2p/go-libp2p/core/network"
 	"go.uber.org/atomic"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/runtime/options"
 	"github.com/iotaledger/hive.go/runtime/syncutils"
 	"github.com/iotaledger/iota-core/pkg/libp2putil"
 	pp "github.com/iotaledger/iota-core/pkg/network/p2p/proto"
 )
 
+const (
+	// minCompressionSize is the size of packets from which on they are compressed, smaller packets don't benefit from it.
+	minCompressionSize = 256
+	// maxDecompressedPacketSize is the maximum size of a compressed packet after decompression.
+	maxDecompressedPacketSize = 64 << 20
+)
+
+var (
+	// frameEncoder is used to compress the packets of all streams, EncodeAll is safe for concurrent use.
+	frameEncoder = lo.PanicOnErr(zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedFastest), zstd.WithEncoderConcurrency(1)))
+	// frameDecoder is used to decompress the packets of all streams, DecodeAll is safe for concurrent use.
+	frameDecoder = lo.PanicOnErr(zstd.NewReader(nil, zstd.WithDecoderMaxMemory(maxDecompressedPacketSize), zstd.WithDecoderConcurrency(0)))
+)
+
 // PacketsStream represents a stream of packets.
 type PacketsStream struct {
 	p2pnetwork.Stream
@@ -22,24 +39,39 @@ type PacketsStream struct {
 	writer         *libp2putil.UvarintWriter
 	packetsRead    *atomic.Uint64
 	packetsWritten *atomic.Uint64
+
+	// optsFramed is true if every packet of the stream is wrapped in a frame (version 2 of the core protocol).
+	optsFramed bool
+	// optsCompression is true if the packets written to a framed stream are compressed.
+	optsCompression bool
 }
 
 // NewPacketsStream creates a new PacketsStream.
-func NewPacketsStream(stream p2pnetwork.Stream, packetFactory func() proto.Message) *PacketsStream {
-	return &PacketsStream{
+func NewPacketsStream(stream p2pnetwork.Stream, packetFactory func() proto.Message, opts ...options.Option[PacketsStream]) *PacketsStream {
+	return options.Apply(&PacketsStream{
 		Stream:         stream,
 		packetFactory:  packetFactory,
 		reader:         libp2putil.NewDelimitedReader(stream),
 		writer:         libp2putil.NewDelimitedWriter(stream),
 		packetsRead:    atomic.NewUint64(0),
 		packetsWritten: atomic.NewUint64(0),
-	}
+	}, opts)
 }
 
 // WritePacket writes a packet to the stream.
 func (ps *PacketsStream) WritePacket(message proto.Message) error {
 	ps.writerLock.Lock()
 	defer ps.writerLock.Unlock()
+
+	if ps.optsFramed {
+		frame, err := ps.frame(message)
+		if err != nil {
+			return err
+		}
+
+		message = frame
+	}
+
 	if err := ps.writer.WriteBlk(message); err != nil {
 		return ierrors.WithStack(err)
 	}
@@ -48,15 +80,68 @@ func (ps *PacketsStream) WritePacket(message proto.Message) error {
 	return nil
 }
 
-// ReadPacket reads a packet from the stream.
-func (ps *PacketsStream) ReadPacket(message proto.Message) error {
+// ReadPacket reads a packet from the stream and returns its (possibly compressed) size on the wire.
+func (ps *PacketsStream) ReadPacket(message proto.Message) (int, error) {
 	ps.readerLock.Lock()
 	defer ps.readerLock.Unlock()
-	if err := ps.reader.ReadBlk(message); err != nil {
-		return ierrors.WithStack(err)
+
+	if !ps.optsFramed {
+		if err := ps.reader.ReadBlk(message); err != nil {
+			return 0, ierrors.WithStack(err)
+		}
+		ps.packetsRead.Inc()
+
+		return proto.Size(message), nil
+	}
+
+	frame := &pp.Frame{}
+	if err := ps.reader.ReadBlk(frame); err != nil {
+		return 0, ierrors.WithStack(err)
+	}
+
+	if err := unframe(frame, message); err != nil {
+		return 0, err
 	}
 	ps.packetsRead.Inc()
 
+	return proto.Size(frame), nil
+}
+
+// IsFramed returns true if the packets of the stream are wrapped in frames.
+func (ps *PacketsStream) IsFramed() bool {
+	return ps.optsFramed
+}
+
+// frame wraps the message in a frame and compresses it if enabled.
+func (ps *PacketsStream) frame(message proto.Message) (*pp.Frame, error) {
+	payload, err := proto.Marshal(message)
+	if err != nil {
+		return nil, ierrors.Wrap(err, "failed to marshal packet")
+	}
+
+	if ps.optsCompression && len(payload) >= minCompressionSize {
+		if compressedPayload := frameEncoder.EncodeAll(payload, nil); len(compressedPayload) < len(payload) {
+			return &pp.Frame{Compressed: true, Payload: compressedPayload}, nil
+		}
+	}
+
+	return &pp.Frame{Payload: payload}, nil
+}
+
+// unframe unmarshals the (decompressed) payload of the frame into the message.
+func unframe(frame *pp.Frame, message proto.Message) error {
+	payload := frame.GetPayload()
+	if frame.GetCompressed() {
+		var err error
+		if payload, err = frameDecoder.DecodeAll(payload, nil); err != nil {
+			return ierrors.Wrap(err, "failed to decompress packet")
+		}
+	}
+
+	if err := proto.Unmarshal(payload, message); err != nil {
+		return ierrors.Wrap(err, "failed to unmarshal packet")
+	}
+
 	return nil
 }
 
@@ -65,5 +150,16 @@ func (ps *PacketsStream) sendNegotiation() error {
 }
 
 func (ps *PacketsStream) receiveNegotiation() (err error) {
-	return ierrors.WithStack(ps.ReadPacket(&pp.Negotiation{}))
+	_, err = ps.ReadPacket(&pp.Negotiation{})
+
+	return ierrors.WithStack(err)
+}
+
+// WithFrames wraps all packets of the stream in frames, which is used by version 2 of the core protocol.
+// If compression is enabled, the written packets are compressed.
+func WithFrames(compression bool) options.Option[PacketsStream] {
+	return func(ps *PacketsStream) {
+		ps.optsFramed = true
+		ps.optsCompression = compression
+	}
 }
@@ -0,0 +1,122 @@
+package p2p
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	nwmodels "github.com/iotaledger/iota-core/pkg/network/protocols/core/models"
+)
+
+func newTestBlockPacket(size int) *nwmodels.Packet {
+	return &nwmodels.Packet{Body: &nwmodels.Packet_Block{Block: &nwmodels.Block{
+		Bytes: bytes.Repeat([]byte{0x42}, size),
+	}}}
+}
+
+func corePacketFactory() proto.Message {
+	return &nwmodels.Packet{}
+}
+
+func TestPacketsStream_Frames(t *testing.T) {
+	for name, opts := range map[string]struct {
+		framed      bool
+		compression bool
+	}{
+		"unframed":              {},
+		"framed":                {framed: true},
+		"framed and compressed": {framed: true, compression: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			a, b, teardown := newStreamsPipe(t)
+			defer teardown()
+
+			var psA, psB *PacketsStream
+			if opts.framed {
+				psA = NewPacketsStream(a, corePacketFactory, WithFrames(opts.compression))
+				psB = NewPacketsStream(b, corePacketFactory, WithFrames(opts.compression))
+			} else {
+				psA = NewPacketsStream(a, corePacketFactory)
+				psB = NewPacketsStream(b, corePacketFactory)
+			}
+
+			// small packets are never compressed, big ones only if it is enabled.
+			for _, packet := range []*nwmodels.Packet{newTestBlockPacket(10), newTestBlockPacket(100_000)} {
+				require.NoError(t, psA.WritePacket(packet))
+
+				receivedPacket := &nwmodels.Packet{}
+				wireSize, err := psB.ReadPacket(receivedPacket)
+				require.NoError(t, err)
+				require.True(t, proto.Equal(packet, receivedPacket))
+
+				// compressed packets are smaller on the wire than the decoded packet.
+				if opts.compression && proto.Size(packet) >= minCompressionSize {
+					require.Less(t, wireSize, proto.Size(packet))
+				} else {
+					require.GreaterOrEqual(t, wireSize, proto.Size(packet))
+				}
+			}
+
+			require.Equal(t, opts.framed, psA.IsFramed())
+			require.EqualValues(t, 2, psA.packetsWritten.Load())
+			require.EqualValues(t, 2, psB.packetsRead.Load())
+		})
+	}
+}
+
+func TestPacketsStream_FrameCompression(t *testing.T) {
+	ps := NewPacketsStream(nil, corePacketFactory, WithFrames(true))
+
+	smallFrame, err := ps.frame(newTestBlockPacket(10))
+	require.NoError(t, err)
+	require.False(t, smallFrame.GetCompressed())
+
+	bigPacket := newTestBlockPacket(100_000)
+	bigFrame, err := ps.frame(bigPacket)
+	require.NoError(t, err)
+	require.True(t, bigFrame.GetCompressed())
+	require.Less(t, len(bigFrame.GetPayload()), proto.Size(bigPacket))
+
+	unframedPacket := &nwmodels.Packet{}
+	require.NoError(t, unframe(bigFrame, unframedPacket))
+	require.True(t, proto.Equal(bigPacket, unframedPacket))
+}
+
+func TestPacketBatch(t *testing.T) {
+	packets := []*nwmodels.Packet{newTestBlockPacket(1), newTestBlockPacket(2), newTestBlockPacket(3)}
+
+	// a single packet is not wrapped in a batch.
+	require.Same(t, packets[0], newPacketBatch(packets[:1]))
+	require.Equal(t, packets[:1], unbatchPackets(packets[0]))
+
+	batch := newPacketBatch(packets)
+	require.NotNil(t, batch.GetBatch())
+	require.Equal(t, packets, unbatchPackets(batch))
+
+	received := receivedPackets(batch)
+	require.Len(t, received, len(packets))
+	for i, packet := range received {
+		require.Same(t, packets[i], packet)
+	}
+
+	negotiation := packetFactory()
+	require.Equal(t, []proto.Message{negotiation}, receivedPackets(negotiation))
+}
+
+func TestNeighborBatchQueuedPackets(t *testing.T) {
+	n := &neighbor{batchSize: 2, sendQueue: make(chan *queuedPacket, NeighborsSendQueueSize)}
+
+	packets := []*nwmodels.Packet{newTestBlockPacket(1), newTestBlockPacket(2), newTestBlockPacket(3)}
+	n.sendQueue <- &queuedPacket{packet: packets[1]}
+	n.sendQueue <- &queuedPacket{packet: packets[2]}
+
+	// the batch is limited by the batch size.
+	//nolint:forcetypeassert // we know that the packet is a nwmodels.Packet
+	batch := n.batchQueuedPackets(packets[0]).(*nwmodels.Packet)
+	require.Equal(t, packets[:2], unbatchPackets(batch))
+
+	// the last packet is not batched as the queue is empty.
+	require.Same(t, packets[2], n.batchQueuedPackets((<-n.sendQueue).packet))
+}
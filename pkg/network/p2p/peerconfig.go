@@ -10,15 +10,22 @@ import (
 
 // ComparablePeerID implements the constraints.ComparableStringer interface for the onChangeMap.
 type ComparablePeerID struct {
+	peerID       peer.ID
 	peerIDBase58 string
 }
 
 func NewComparablePeerID(peerID peer.ID) *ComparablePeerID {
 	return &ComparablePeerID{
+		peerID:       peerID,
 		peerIDBase58: peerID.String(),
 	}
 }
 
+// PeerID returns the ID of the peer.
+func (c *ComparablePeerID) PeerID() peer.ID {
+	return c.peerID
+}
+
 func (c *ComparablePeerID) Key() string {
 	return c.peerIDBase58
 }
@@ -0,0 +1,173 @@
+package p2p
+
+import (
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"golang.org/x/time/rate"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/iotaledger/hive.go/lo"
+	nwmodels "github.com/iotaledger/iota-core/pkg/network/protocols/core/models"
+)
+
+// PacketType is the type of packet a rate limit applies to.
+type PacketType uint8
+
+const (
+	// PacketTypeBlock is the type of packets that contain a block.
+	PacketTypeBlock PacketType = iota
+	// PacketTypeBlockRequest is the type of packets that request a block.
+	PacketTypeBlockRequest
+	// PacketTypeSlotCommitmentRequest is the type of packets that request a slot commitment.
+	PacketTypeSlotCommitmentRequest
+	// PacketTypeAttestationsRequest is the type of packets that request the attestations of a slot.
+	PacketTypeAttestationsRequest
+	// PacketTypeWarpSyncRequest is the type of packets that request the warp-sync data of a slot.
+	PacketTypeWarpSyncRequest
+	// PacketTypeOther is the type of all other packets, which are only subject to the bandwidth limit.
+	PacketTypeOther
+
+	// PacketTypeCount is the number of packet types.
+	PacketTypeCount = int(PacketTypeOther) + 1
+)
+
+// String returns a human-readable representation of the PacketType.
+func (p PacketType) String() string {
+	switch p {
+	case PacketTypeBlock:
+		return "Block"
+	case PacketTypeBlockRequest:
+		return "BlockRequest"
+	case PacketTypeSlotCommitmentRequest:
+		return "SlotCommitmentRequest"
+	case PacketTypeAttestationsRequest:
+		return "AttestationsRequest"
+	case PacketTypeWarpSyncRequest:
+		return "WarpSyncRequest"
+	default:
+		return "Other"
+	}
+}
+
+// packetTypeOf returns the PacketType of the given packet.
+func packetTypeOf(packet proto.Message) PacketType {
+	corePacket, isCorePacket := packet.(*nwmodels.Packet)
+	if !isCorePacket {
+		return PacketTypeOther
+	}
+
+	switch corePacket.GetBody().(type) {
+	case *nwmodels.Packet_Block:
+		return PacketTypeBlock
+	case *nwmodels.Packet_BlockRequest:
+		return PacketTypeBlockRequest
+	case *nwmodels.Packet_SlotCommitmentRequest:
+		return PacketTypeSlotCommitmentRequest
+	case *nwmodels.Packet_AttestationsRequest:
+		return PacketTypeAttestationsRequest
+	case *nwmodels.Packet_WarpSyncRequest:
+		return PacketTypeWarpSyncRequest
+	default:
+		return PacketTypeOther
+	}
+}
+
+// RateLimit defines a token bucket that is refilled with Rate tokens per second and holds at most Burst tokens.
+// A RateLimit with a Rate of 0 does not limit anything.
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+// newLimiter returns a new token bucket for the RateLimit or nil if the RateLimit is disabled.
+func (r RateLimit) newLimiter() *rate.Limiter {
+	if r.Rate <= 0 {
+		return nil
+	}
+
+	return rate.NewLimiter(rate.Limit(r.Rate), max(r.Burst, 1))
+}
+
+// RateLimiter limits the bandwidth and the number of packets per packet type that every neighbor is allowed to send.
+// Packets that exceed the limits are dropped.
+type RateLimiter struct {
+	bandwidthLimit        RateLimit
+	packetLimits          map[PacketType]RateLimit
+	droppedPacketCallback func(peerID peer.ID, packetType PacketType)
+}
+
+// NewRateLimiter creates a new RateLimiter with the given bandwidth limit (in bytes per second) and packet limits
+// (in packets per second). The callback is called for every packet that is dropped.
+func NewRateLimiter(bandwidthLimit RateLimit, packetLimits map[PacketType]RateLimit, droppedPacketCallback func(peerID peer.ID, packetType PacketType)) *RateLimiter {
+	return &RateLimiter{
+		bandwidthLimit:        bandwidthLimit,
+		packetLimits:          packetLimits,
+		droppedPacketCallback: droppedPacketCallback,
+	}
+}
+
+// newPeerRateLimiter creates the token buckets of a single neighbor.
+func (r *RateLimiter) newPeerRateLimiter(peerID peer.ID) *peerRateLimiter {
+	if r == nil {
+		return nil
+	}
+
+	p := &peerRateLimiter{
+		peerID:                peerID,
+		bandwidth:             r.bandwidthLimit.newLimiter(),
+		droppedPacketCallback: r.droppedPacketCallback,
+	}
+
+	for packetType, packetLimit := range r.packetLimits {
+		p.packets[packetType] = packetLimit.newLimiter()
+	}
+
+	return p
+}
+
+// peerRateLimiter contains the token buckets of a single neighbor.
+type peerRateLimiter struct {
+	peerID                peer.ID
+	bandwidth             *rate.Limiter
+	packets               [PacketTypeCount]*rate.Limiter
+	droppedPacketCallback func(peerID peer.ID, packetType PacketType)
+}
+
+// Allow consumes the tokens for a packet that was received from the neighbor with the given size on the wire and
+// returns the packets it contains that the neighbor is allowed to send. The bandwidth limit applies to the size on
+// the wire, which might be compressed and cover a whole batch, while the packet limits apply to each contained packet.
+func (p *peerRateLimiter) Allow(packet proto.Message, wireSize int) []proto.Message {
+	packets := receivedPackets(packet)
+	if p == nil {
+		return packets
+	}
+
+	now := time.Now()
+
+	// packets that are bigger than the burst can never be allowed, so they consume the whole bucket instead.
+	if p.bandwidth != nil && !p.bandwidth.AllowN(now, min(wireSize, p.bandwidth.Burst())) {
+		for _, droppedPacket := range packets {
+			p.dropped(packetTypeOf(droppedPacket))
+		}
+
+		return nil
+	}
+
+	return lo.Filter(packets, func(receivedPacket proto.Message) bool {
+		packetType := packetTypeOf(receivedPacket)
+		if packetLimiter := p.packets[packetType]; packetLimiter != nil && !packetLimiter.AllowN(now, 1) {
+			p.dropped(packetType)
+
+			return false
+		}
+
+		return true
+	})
+}
+
+func (p *peerRateLimiter) dropped(packetType PacketType) {
+	if p.droppedPacketCallback != nil {
+		p.droppedPacketCallback(p.peerID, packetType)
+	}
+}
@@ -0,0 +1,108 @@
+package p2p
+
+import (
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	p2pproto "github.com/iotaledger/iota-core/pkg/network/p2p/proto"
+	nwmodels "github.com/iotaledger/iota-core/pkg/network/protocols/core/models"
+)
+
+// allowed returns true if the given packet is allowed by the peerRateLimiter.
+func allowed(p *peerRateLimiter, packet proto.Message) bool {
+	return len(p.Allow(packet, proto.Size(packet))) == 1
+}
+
+func TestRateLimiter_Packets(t *testing.T) {
+	var dropped [PacketTypeCount]int
+	rateLimiter := NewRateLimiter(RateLimit{}, map[PacketType]RateLimit{
+		PacketTypeBlockRequest:    {Rate: 0.001, Burst: 2},
+		PacketTypeWarpSyncRequest: {Rate: 0.001, Burst: 1},
+	}, func(_ peer.ID, packetType PacketType) {
+		dropped[packetType]++
+	})
+
+	peerID, _ := newTestPeerMultiAddr(t, 15600)
+	otherPeerID, _ := newTestPeerMultiAddr(t, 15601)
+
+	peerRateLimiter := rateLimiter.newPeerRateLimiter(peerID)
+	otherPeerRateLimiter := rateLimiter.newPeerRateLimiter(otherPeerID)
+
+	blockRequest := &nwmodels.Packet{Body: &nwmodels.Packet_BlockRequest{BlockRequest: &nwmodels.BlockRequest{}}}
+	warpSyncRequest := &nwmodels.Packet{Body: &nwmodels.Packet_WarpSyncRequest{WarpSyncRequest: &nwmodels.WarpSyncRequest{}}}
+	block := &nwmodels.Packet{Body: &nwmodels.Packet_Block{Block: &nwmodels.Block{}}}
+
+	require.True(t, allowed(peerRateLimiter, blockRequest))
+	require.True(t, allowed(peerRateLimiter, blockRequest))
+	require.False(t, allowed(peerRateLimiter, blockRequest))
+
+	// the limits of the packet types are independent
+	require.True(t, allowed(peerRateLimiter, warpSyncRequest))
+	require.False(t, allowed(peerRateLimiter, warpSyncRequest))
+
+	// packet types without a limit are not limited
+	for range 100 {
+		require.True(t, allowed(peerRateLimiter, block))
+	}
+
+	// the limits of the neighbors are independent
+	require.True(t, allowed(otherPeerRateLimiter, blockRequest))
+
+	require.Equal(t, 1, dropped[PacketTypeBlockRequest])
+	require.Equal(t, 1, dropped[PacketTypeWarpSyncRequest])
+	require.Zero(t, dropped[PacketTypeBlock])
+}
+
+func TestRateLimiter_Bandwidth(t *testing.T) {
+	droppedPackets := 0
+	rateLimiter := NewRateLimiter(RateLimit{Rate: 0.001, Burst: 100}, nil, func(_ peer.ID, packetType PacketType) {
+		require.Equal(t, PacketTypeBlock, packetType)
+		droppedPackets++
+	})
+
+	peerID, _ := newTestPeerMultiAddr(t, 15600)
+	peerRateLimiter := rateLimiter.newPeerRateLimiter(peerID)
+
+	// the bandwidth is measured on the size on the wire, which might be smaller than the decoded packet.
+	block := &nwmodels.Packet{Body: &nwmodels.Packet_Block{Block: &nwmodels.Block{Bytes: make([]byte, 1000)}}}
+	require.Len(t, peerRateLimiter.Allow(block, 40), 1)
+	require.Len(t, peerRateLimiter.Allow(block, 40), 1)
+	require.Empty(t, peerRateLimiter.Allow(block, 40))
+	require.Equal(t, 1, droppedPackets)
+
+	// a packet bigger than the burst is allowed if the bucket is full
+	require.Len(t, rateLimiter.newPeerRateLimiter(peerID).Allow(block, proto.Size(block)), 1)
+
+	// a batch that exceeds the bandwidth is dropped as a whole
+	batch := newPacketBatch([]*nwmodels.Packet{block, block})
+	require.Empty(t, peerRateLimiter.Allow(batch, 40))
+	require.Equal(t, 3, droppedPackets)
+
+	// a disabled rate limiter allows everything
+	var disabledRateLimiter *RateLimiter
+	require.Len(t, disabledRateLimiter.newPeerRateLimiter(peerID).Allow(&p2pproto.Negotiation{}, 0), 1)
+	require.Len(t, disabledRateLimiter.newPeerRateLimiter(peerID).Allow(batch, 0), 2)
+}
+
+func TestRateLimiter_Batch(t *testing.T) {
+	rateLimiter := NewRateLimiter(RateLimit{}, map[PacketType]RateLimit{
+		PacketTypeBlockRequest: {Rate: 0.001, Burst: 2},
+	}, nil)
+
+	peerID, _ := newTestPeerMultiAddr(t, 15600)
+	peerRateLimiter := rateLimiter.newPeerRateLimiter(peerID)
+
+	blockRequest := &nwmodels.Packet{Body: &nwmodels.Packet_BlockRequest{BlockRequest: &nwmodels.BlockRequest{}}}
+	block := &nwmodels.Packet{Body: &nwmodels.Packet_Block{Block: &nwmodels.Block{}}}
+	batch := newPacketBatch([]*nwmodels.Packet{blockRequest, block, blockRequest, blockRequest})
+
+	// the packet limits apply to every packet of the batch
+	allowedPackets := peerRateLimiter.Allow(batch, proto.Size(batch))
+	require.Len(t, allowedPackets, 3)
+	require.Equal(t, PacketTypeBlockRequest, packetTypeOf(allowedPackets[0]))
+	require.Equal(t, PacketTypeBlock, packetTypeOf(allowedPackets[1]))
+	require.Equal(t, PacketTypeBlockRequest, packetTypeOf(allowedPackets[2]))
+}
@@ -0,0 +1,143 @@
+package p2p
+
+import (
+	"math"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/hive.go/runtime/syncutils"
+	"github.com/iotaledger/iota-core/pkg/network"
+)
+
+// misbehaviorPenalties contains the score that is added to the misbehavior score of a peer for each kind of misbehavior.
+var misbehaviorPenalties = map[network.Misbehavior]float64{
+	network.MisbehaviorMalformedPacket:      25,
+	network.MisbehaviorInvalidBlock:         10,
+	network.MisbehaviorInvalidAttestations:  50,
+	network.MisbehaviorInvalidWarpSyncProof: 50,
+}
+
+// Reputation keeps track of the misbehavior of peers.
+// Every misbehavior increases the score of a peer, which decays over time, and a peer gets banned once its score
+// reaches the ban threshold.
+type Reputation struct {
+	banList *BanList
+	scores  map[peer.ID]*misbehaviorScore
+	mutex   syncutils.Mutex
+
+	optsBanThreshold  float64
+	optsBanDuration   time.Duration
+	optsScoreHalfLife time.Duration
+}
+
+// misbehaviorScore is the decaying misbehavior score of a peer.
+type misbehaviorScore struct {
+	value       float64
+	lastUpdated time.Time
+}
+
+// NewReputation creates a new Reputation that bans peers on the given BanList.
+func NewReputation(banList *BanList, opts ...options.Option[Reputation]) *Reputation {
+	return options.Apply(&Reputation{
+		banList:           banList,
+		scores:            make(map[peer.ID]*misbehaviorScore),
+		optsBanThreshold:  100,
+		optsBanDuration:   time.Hour,
+		optsScoreHalfLife: 10 * time.Minute,
+	}, opts)
+}
+
+// BanList returns the list of banned peers.
+func (r *Reputation) BanList() *BanList {
+	return r.banList
+}
+
+// BanDuration returns the duration misbehaving peers get banned for.
+func (r *Reputation) BanDuration() time.Duration {
+	return r.optsBanDuration
+}
+
+// Score returns the current misbehavior score of the given peer.
+func (r *Reputation) Score(peerID peer.ID) float64 {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	score, exists := r.scores[peerID]
+	if !exists {
+		return 0
+	}
+
+	return r.decayedScore(score, time.Now())
+}
+
+// Penalize adds the penalty of the given misbehavior to the score of the peer and returns the new score.
+// It returns true if the score reached the ban threshold, in which case the score of the peer is reset.
+func (r *Reputation) Penalize(peerID peer.ID, misbehavior network.Misbehavior) (score float64, reachedBanThreshold bool) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	now := time.Now()
+
+	// drop the scores of other peers that decayed to (almost) zero, so that the scores of disconnected peers don't pile up.
+	for otherPeerID, otherScore := range r.scores {
+		if otherPeerID != peerID && r.decayedScore(otherScore, now) < 1 {
+			delete(r.scores, otherPeerID)
+		}
+	}
+
+	currentScore, exists := r.scores[peerID]
+	if !exists {
+		currentScore = &misbehaviorScore{}
+		r.scores[peerID] = currentScore
+	}
+
+	currentScore.value = r.decayedScore(currentScore, now) + misbehaviorPenalties[misbehavior]
+	currentScore.lastUpdated = now
+
+	if score = currentScore.value; score < r.optsBanThreshold {
+		return score, false
+	}
+
+	delete(r.scores, peerID)
+
+	return score, true
+}
+
+// Forget removes the score of the given peer.
+func (r *Reputation) Forget(peerID peer.ID) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	delete(r.scores, peerID)
+}
+
+func (r *Reputation) decayedScore(score *misbehaviorScore, now time.Time) float64 {
+	if r.optsScoreHalfLife <= 0 {
+		return score.value
+	}
+
+	return score.value * math.Pow(0.5, float64(now.Sub(score.lastUpdated))/float64(r.optsScoreHalfLife))
+}
+
+// WithBanThreshold sets the misbehavior score at which a peer gets banned.
+func WithBanThreshold(banThreshold float64) options.Option[Reputation] {
+	return func(r *Reputation) {
+		r.optsBanThreshold = banThreshold
+	}
+}
+
+// WithBanDuration sets the duration misbehaving peers get banned for.
+func WithBanDuration(banDuration time.Duration) options.Option[Reputation] {
+	return func(r *Reputation) {
+		r.optsBanDuration = banDuration
+	}
+}
+
+// WithScoreHalfLife sets the duration after which the misbehavior score of a peer is halved.
+func WithScoreHalfLife(scoreHalfLife time.Duration) options.Option[Reputation] {
+	return func(r *Reputation) {
+		r.optsScoreHalfLife = scoreHalfLife
+	}
+}
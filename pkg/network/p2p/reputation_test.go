@@ -0,0 +1,56 @@
+package p2p
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/iota-core/pkg/network"
+)
+
+func TestReputation_Penalize(t *testing.T) {
+	reputation := NewReputation(nil, WithBanThreshold(100), WithScoreHalfLife(0))
+
+	peerID, _ := newTestPeerMultiAddr(t, 15600)
+	otherPeerID, _ := newTestPeerMultiAddr(t, 15601)
+
+	score, reachedBanThreshold := reputation.Penalize(peerID, network.MisbehaviorInvalidAttestations)
+	require.Equal(t, 50.0, score)
+	require.False(t, reachedBanThreshold)
+
+	score, reachedBanThreshold = reputation.Penalize(otherPeerID, network.MisbehaviorInvalidBlock)
+	require.Equal(t, 10.0, score)
+	require.False(t, reachedBanThreshold)
+
+	score, reachedBanThreshold = reputation.Penalize(peerID, network.MisbehaviorMalformedPacket)
+	require.Equal(t, 75.0, score)
+	require.False(t, reachedBanThreshold)
+
+	score, reachedBanThreshold = reputation.Penalize(peerID, network.MisbehaviorInvalidWarpSyncProof)
+	require.Equal(t, 125.0, score)
+	require.True(t, reachedBanThreshold)
+
+	// the score is reset once the ban threshold was reached
+	require.Zero(t, reputation.Score(peerID))
+	require.Equal(t, 10.0, reputation.Score(otherPeerID))
+
+	reputation.Forget(otherPeerID)
+	require.Zero(t, reputation.Score(otherPeerID))
+}
+
+func TestReputation_Decay(t *testing.T) {
+	reputation := NewReputation(nil, WithScoreHalfLife(time.Hour))
+
+	peerID, _ := newTestPeerMultiAddr(t, 15600)
+
+	reputation.Penalize(peerID, network.MisbehaviorInvalidAttestations)
+
+	// pretend the misbehavior happened one half-life ago
+	reputation.scores[peerID].lastUpdated = reputation.scores[peerID].lastUpdated.Add(-time.Hour)
+	require.InDelta(t, 25.0, reputation.Score(peerID), 0.1)
+
+	score, reachedBanThreshold := reputation.Penalize(peerID, network.MisbehaviorInvalidAttestations)
+	require.InDelta(t, 75.0, score, 0.1)
+	require.False(t, reachedBanThreshold)
+}
@@ -0,0 +1,106 @@
+package p2p
+
+import (
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/peerstore"
+	"github.com/multiformats/go-multiaddr"
+
+	"github.com/iotaledger/hive.go/ierrors"
+)
+
+// TopologyRole is the role of the node in a sentry-node topology.
+type TopologyRole string
+
+const (
+	// TopologyRoleNone is the role of a node that is not part of a sentry-node topology.
+	TopologyRoleNone TopologyRole = "none"
+	// TopologyRoleValidator is the role of a validator that is only connected to its sentry nodes.
+	// It never runs autopeering, so it is neither advertised in nor discovered through the DHT, and it only accepts
+	// connections from its manual peers, which are its sentries.
+	TopologyRoleValidator TopologyRole = "validator"
+	// TopologyRoleSentry is the role of a node that shields validators from the rest of the network.
+	// It takes part in autopeering, but never shares the addresses of its private peers (the validators).
+	TopologyRoleSentry TopologyRole = "sentry"
+)
+
+// ParseTopologyRole parses the given string into a TopologyRole. An empty string is treated as TopologyRoleNone.
+func ParseTopologyRole(role string) (TopologyRole, error) {
+	switch TopologyRole(role) {
+	case "", TopologyRoleNone:
+		return TopologyRoleNone, nil
+	case TopologyRoleValidator, TopologyRoleSentry:
+		return TopologyRole(role), nil
+	default:
+		return "", ierrors.Errorf("unknown topology role %s", role)
+	}
+}
+
+// privatePeersHost wraps a libp2p host and hides the addresses of the private peers in its peer store.
+// It is handed to the autopeering DHT of a sentry, so that the DHT never answers queries with the addresses of the
+// validators behind the sentry.
+type privatePeersHost struct {
+	host.Host
+
+	peerstore *privatePeersPeerstore
+}
+
+func newPrivatePeersHost(libp2pHost host.Host, privatePeerIDs map[peer.ID]struct{}) *privatePeersHost {
+	return &privatePeersHost{
+		Host: libp2pHost,
+		peerstore: &privatePeersPeerstore{
+			Peerstore:      libp2pHost.Peerstore(),
+			privatePeerIDs: privatePeerIDs,
+		},
+	}
+}
+
+// Peerstore returns the peer store that hides the addresses of the private peers.
+func (h *privatePeersHost) Peerstore() peerstore.Peerstore {
+	return h.peerstore
+}
+
+// privatePeersPeerstore wraps a peer store and hides the addresses of the private peers.
+type privatePeersPeerstore struct {
+	peerstore.Peerstore
+
+	privatePeerIDs map[peer.ID]struct{}
+}
+
+// Addrs returns the known addresses of the peer, or none if it is a private peer.
+func (p *privatePeersPeerstore) Addrs(peerID peer.ID) []multiaddr.Multiaddr {
+	if p.isPrivate(peerID) {
+		return nil
+	}
+
+	return p.Peerstore.Addrs(peerID)
+}
+
+// PeerInfo returns the AddrInfo of the peer, without any addresses if it is a private peer.
+func (p *privatePeersPeerstore) PeerInfo(peerID peer.ID) peer.AddrInfo {
+	if p.isPrivate(peerID) {
+		return peer.AddrInfo{ID: peerID}
+	}
+
+	return p.Peerstore.PeerInfo(peerID)
+}
+
+// PeersWithAddrs returns all peers with known addresses, except the private peers.
+func (p *privatePeersPeerstore) PeersWithAddrs() peer.IDSlice {
+	peersWithAddrs := p.Peerstore.PeersWithAddrs()
+
+	publicPeers := make(peer.IDSlice, 0, len(peersWithAddrs))
+	for _, peerID := range peersWithAddrs {
+		if !p.isPrivate(peerID) {
+			publicPeers = append(publicPeers, peerID)
+		}
+	}
+
+	return publicPeers
+}
+
+func (p *privatePeersPeerstore) isPrivate(peerID peer.ID) bool {
+	_, isPrivate := p.privatePeerIDs[peerID]
+
+	return isPrivate
+}
@@ -0,0 +1,58 @@
+package p2p
+
+import (
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/p2p/host/peerstore/pstoremem"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTopologyRole(t *testing.T) {
+	for input, expectedRole := range map[string]TopologyRole{
+		"":          TopologyRoleNone,
+		"none":      TopologyRoleNone,
+		"validator": TopologyRoleValidator,
+		"sentry":    TopologyRoleSentry,
+	} {
+		role, err := ParseTopologyRole(input)
+		require.NoError(t, err)
+		require.Equal(t, expectedRole, role)
+	}
+
+	_, err := ParseTopologyRole("relay")
+	require.Error(t, err)
+}
+
+func TestPrivatePeersPeerstore(t *testing.T) {
+	peerstore, err := pstoremem.NewPeerstore()
+	require.NoError(t, err)
+	defer peerstore.Close()
+
+	privatePeerID, privateMultiAddr := newTestPeerMultiAddr(t, 15600)
+	publicPeerID, publicMultiAddr := newTestPeerMultiAddr(t, 15601)
+
+	privateAddrInfo, err := peer.AddrInfoFromP2pAddr(privateMultiAddr)
+	require.NoError(t, err)
+	publicAddrInfo, err := peer.AddrInfoFromP2pAddr(publicMultiAddr)
+	require.NoError(t, err)
+
+	peerstore.AddAddrs(privatePeerID, privateAddrInfo.Addrs, time.Hour)
+	peerstore.AddAddrs(publicPeerID, publicAddrInfo.Addrs, time.Hour)
+
+	privatePeerstore := &privatePeersPeerstore{
+		Peerstore:      peerstore,
+		privatePeerIDs: map[peer.ID]struct{}{privatePeerID: {}},
+	}
+
+	require.Empty(t, privatePeerstore.Addrs(privatePeerID))
+	require.Empty(t, privatePeerstore.PeerInfo(privatePeerID).Addrs)
+	require.Equal(t, privatePeerID, privatePeerstore.PeerInfo(privatePeerID).ID)
+	require.Equal(t, publicAddrInfo.Addrs, privatePeerstore.Addrs(publicPeerID))
+	require.Equal(t, publicAddrInfo.Addrs, privatePeerstore.PeerInfo(publicPeerID).Addrs)
+	require.ElementsMatch(t, peer.IDSlice{publicPeerID}, privatePeerstore.PeersWithAddrs())
+
+	// the wrapped peer store still knows the private addresses
+	require.Equal(t, privateAddrInfo.Addrs, peerstore.Addrs(privatePeerID))
+}
@@ -35,6 +35,9 @@ type Protocol struct {
 	requestedBlockHashesMutex syncutils.Mutex
 
 	shutdown reactive.Event
+
+	// optsTracer records the packets that are received from and sent to neighbors (nil if tracing is disabled).
+	optsTracer *Tracer
 }
 
 func NewProtocol(network network.Endpoint, workerPool *workerpool.WorkerPool, apiProvider iotago.APIProvider, opts ...options.Option[Protocol]) (protocol *Protocol) {
@@ -53,7 +56,7 @@ func NewProtocol(network network.Endpoint, workerPool *workerpool.WorkerPool, ap
 }
 
 func (p *Protocol) SendBlock(block *model.Block, to ...peer.ID) {
-	p.network.Send(&nwmodels.Packet{Body: &nwmodels.Packet_Block{Block: &nwmodels.Block{
+	p.send(&nwmodels.Packet{Body: &nwmodels.Packet_Block{Block: &nwmodels.Block{
 		Bytes: block.Data(),
 	}}}, to...)
 }
@@ -63,13 +66,13 @@ func (p *Protocol) RequestBlock(id iotago.BlockID, to ...peer.ID) {
 	p.requestedBlockHashes.Set(id.Identifier(), types.Void)
 	p.requestedBlockHashesMutex.Unlock()
 
-	p.network.Send(&nwmodels.Packet{Body: &nwmodels.Packet_BlockRequest{BlockRequest: &nwmodels.BlockRequest{
+	p.send(&nwmodels.Packet{Body: &nwmodels.Packet_BlockRequest{BlockRequest: &nwmodels.BlockRequest{
 		BlockId: id[:],
 	}}}, to...)
 }
 
 func (p *Protocol) SendSlotCommitment(cm *model.Commitment, to ...peer.ID) {
-	p.network.Send(&nwmodels.Packet{Body: &nwmodels.Packet_SlotCommitment{SlotCommitment: &nwmodels.SlotCommitment{
+	p.send(&nwmodels.Packet{Body: &nwmodels.Packet_SlotCommitment{SlotCommitment: &nwmodels.SlotCommitment{
 		Bytes: cm.Data(),
 	}}}, to...)
 }
@@ -89,7 +92,7 @@ func (p *Protocol) SendAttestations(cm *model.Commitment, attestations []*iotago
 		return err
 	}
 
-	p.network.Send(&nwmodels.Packet{Body: &nwmodels.Packet_Attestations{Attestations: &nwmodels.Attestations{
+	p.send(&nwmodels.Packet{Body: &nwmodels.Packet_Attestations{Attestations: &nwmodels.Attestations{
 		Commitment:   cm.Data(),
 		Attestations: lo.PanicOnErr(byteBuffer.Bytes()),
 		MerkleProof:  lo.PanicOnErr(merkleProof.Bytes()),
@@ -99,13 +102,13 @@ func (p *Protocol) SendAttestations(cm *model.Commitment, attestations []*iotago
 }
 
 func (p *Protocol) RequestSlotCommitment(id iotago.CommitmentID, to ...peer.ID) {
-	p.network.Send(&nwmodels.Packet{Body: &nwmodels.Packet_SlotCommitmentRequest{SlotCommitmentRequest: &nwmodels.SlotCommitmentRequest{
+	p.send(&nwmodels.Packet{Body: &nwmodels.Packet_SlotCommitmentRequest{SlotCommitmentRequest: &nwmodels.SlotCommitmentRequest{
 		CommitmentId: id[:],
 	}}}, to...)
 }
 
 func (p *Protocol) RequestAttestations(id iotago.CommitmentID, to ...peer.ID) {
-	p.network.Send(&nwmodels.Packet{Body: &nwmodels.Packet_AttestationsRequest{AttestationsRequest: &nwmodels.AttestationsRequest{
+	p.send(&nwmodels.Packet{Body: &nwmodels.Packet_AttestationsRequest{AttestationsRequest: &nwmodels.AttestationsRequest{
 		CommitmentId: lo.PanicOnErr(id.Bytes()),
 	}}}, to...)
 }
@@ -159,7 +162,20 @@ func (p *Protocol) OnShutdown(callback func()) (unsubscribe func()) {
 	return p.shutdown.OnTrigger(callback)
 }
 
+// send sends the packet to the given neighbors (or to all neighbors if none are given).
+func (p *Protocol) send(packet *nwmodels.Packet, to ...peer.ID) {
+	if p.optsTracer != nil {
+		p.tracePacket(PacketDirectionOutbound, packet, to...)
+	}
+
+	p.network.Send(packet, to...)
+}
+
 func (p *Protocol) handlePacket(nbr peer.ID, packet proto.Message) (err error) {
+	if p.optsTracer != nil {
+		p.tracePacket(PacketDirectionInbound, packet.(*nwmodels.Packet), nbr)
+	}
+
 	switch packetBody := packet.(*nwmodels.Packet).GetBody().(type) {
 	case *nwmodels.Packet_Block:
 		p.workerPool.Submit(func() { p.onBlock(packetBody.Block.GetBytes(), nbr) })
@@ -191,7 +207,7 @@ func (p *Protocol) handlePacket(nbr peer.ID, packet proto.Message) (err error) {
 func (p *Protocol) onBlock(blockData []byte, id peer.ID) {
 	blockIdentifier, err := iotago.BlockIdentifierFromBlockBytes(blockData)
 	if err != nil {
-		p.Events.Error.Trigger(ierrors.Wrap(err, "failed to deserialize block"), id)
+		p.Events.Error.Trigger(ierrors.Chain(network.ErrMalformedPacket, ierrors.Wrap(err, "failed to deserialize block")), id)
 		return
 	}
 
@@ -207,16 +223,30 @@ func (p *Protocol) onBlock(blockData []byte, id peer.ID) {
 
 	block, err := model.BlockFromBlockIdentifierAndBytes(blockIdentifier, blockData, p.apiProvider)
 	if err != nil {
-		p.Events.Error.Trigger(ierrors.Wrap(err, "failed to deserialize block"), id)
+		p.Events.Error.Trigger(p.blockDeserializationError(blockData, err), id)
 		return
 	}
 
 	p.Events.BlockReceived.Trigger(block, id)
 }
 
+// blockDeserializationError returns the error of a block that failed to deserialize. The block is only considered invalid
+// if the node knows its protocol version, as blocks of newer protocol versions might still be valid.
+func (p *Protocol) blockDeserializationError(blockData []byte, err error) error {
+	err = ierrors.Wrap(err, "failed to deserialize block")
+
+	if version, _, versionErr := iotago.VersionFromBytes(blockData); versionErr == nil {
+		if _, apiErr := p.apiProvider.APIForVersion(version); apiErr == nil {
+			return ierrors.Chain(network.ErrInvalidBlock, err)
+		}
+	}
+
+	return err
+}
+
 func (p *Protocol) onBlockRequest(idBytes []byte, id peer.ID) {
 	if len(idBytes) != iotago.BlockIDLength {
-		p.Events.Error.Trigger(ierrors.New("failed to deserialize block request: invalid block id length"), id)
+		p.Events.Error.Trigger(ierrors.WithMessage(network.ErrMalformedPacket, "failed to deserialize block request: invalid block id length"), id)
 
 		return
 	}
@@ -237,7 +267,7 @@ func (p *Protocol) onSlotCommitment(commitmentBytes []byte, id peer.ID) {
 
 func (p *Protocol) onSlotCommitmentRequest(idBytes []byte, id peer.ID) {
 	if len(idBytes) != iotago.CommitmentIDLength {
-		p.Events.Error.Trigger(ierrors.New("failed to deserialize slot commitment request: invalid commitment id length"), id)
+		p.Events.Error.Trigger(ierrors.WithMessage(network.ErrMalformedPacket, "failed to deserialize slot commitment request: invalid commitment id length"), id)
 
 		return
 	}
@@ -257,7 +287,7 @@ func (p *Protocol) onAttestations(commitmentBytes []byte, attestationsBytes []by
 
 	attestationsCount, err := stream.PeekSize(reader, serializer.SeriLengthPrefixTypeAsUint32)
 	if err != nil {
-		p.Events.Error.Trigger(ierrors.New("failed peek attestations count"), id)
+		p.Events.Error.Trigger(ierrors.WithMessage(network.ErrMalformedPacket, "failed peek attestations count"), id)
 
 		return
 	}
@@ -284,7 +314,7 @@ func (p *Protocol) onAttestations(commitmentBytes []byte, attestationsBytes []by
 
 	proof, _, err := merklehasher.ProofFromBytes[iotago.Identifier](merkleProof)
 	if err != nil {
-		p.Events.Error.Trigger(ierrors.Wrapf(err, "failed to deserialize merkle proof when receiving attestations for commitment %s", cm.ID()), id)
+		p.Events.Error.Trigger(ierrors.Chain(network.ErrMalformedPacket, ierrors.Wrapf(err, "failed to deserialize merkle proof when receiving attestations for commitment %s", cm.ID())), id)
 
 		return
 	}
@@ -294,7 +324,7 @@ func (p *Protocol) onAttestations(commitmentBytes []byte, attestationsBytes []by
 
 func (p *Protocol) onAttestationsRequest(commitmentIDBytes []byte, id peer.ID) {
 	if len(commitmentIDBytes) != iotago.CommitmentIDLength {
-		p.Events.Error.Trigger(ierrors.New("failed to deserialize commitmentID in attestations request: invalid commitment id length"), id)
+		p.Events.Error.Trigger(ierrors.WithMessage(network.ErrMalformedPacket, "failed to deserialize commitmentID in attestations request: invalid commitment id length"), id)
 
 		return
 	}
@@ -305,3 +335,10 @@ func (p *Protocol) onAttestationsRequest(commitmentIDBytes []byte, id peer.ID) {
 func newPacket() proto.Message {
 	return &nwmodels.Packet{}
 }
+
+// WithTracer sets the Tracer that records the packets that are received from and sent to neighbors.
+func WithTracer(tracer *Tracer) options.Option[Protocol] {
+	return func(p *Protocol) {
+		p.optsTracer = tracer
+	}
+}
@@ -0,0 +1,351 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/runtime/ioutils"
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/hive.go/runtime/syncutils"
+	"github.com/iotaledger/iota-core/pkg/model"
+	nwmodels "github.com/iotaledger/iota-core/pkg/network/protocols/core/models"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+const (
+	// PacketDirectionInbound is the direction of packets that were received from a neighbor.
+	PacketDirectionInbound = "inbound"
+	// PacketDirectionOutbound is the direction of packets that were sent to neighbors.
+	PacketDirectionOutbound = "outbound"
+)
+
+// PacketTrace is the record of a packet that was received from or sent to neighbors.
+type PacketTrace struct {
+	// Time is the time the packet was received or sent.
+	Time time.Time `json:"time"`
+	// Direction is the direction of the packet (inbound or outbound).
+	Direction string `json:"direction"`
+	// Type is the type of the packet.
+	Type string `json:"type"`
+	// Size is the size of the packet in bytes.
+	Size int `json:"size"`
+	// PeerID is the neighbor the packet was received from or sent to (empty if it was sent to all neighbors).
+	PeerID string `json:"peerId,omitempty"`
+	// ID is the ID of the block or commitment the packet contains or requests.
+	ID string `json:"id,omitempty"`
+	// Latency is the time between the issuing of a block and the packet being received or sent.
+	Latency string `json:"latency,omitempty"`
+}
+
+// TraceFilter selects the packet traces that are returned by the Tracer.
+type TraceFilter struct {
+	// PeerID selects the traces of the given neighbor (all neighbors if empty).
+	PeerID string
+	// ID selects the traces of the given block or commitment (all packets if empty).
+	ID string
+	// Type selects the traces of the given packet type (all types if empty).
+	Type string
+	// Limit is the maximum number of (most recent) traces that are returned (all traces if 0).
+	Limit int
+}
+
+// matches returns true if the trace is selected by the filter.
+func (f *TraceFilter) matches(trace *PacketTrace) bool {
+	return (f.PeerID == "" || f.PeerID == trace.PeerID) &&
+		(f.ID == "" || f.ID == trace.ID) &&
+		(f.Type == "" || f.Type == trace.Type)
+}
+
+// Tracer records the packets that are received from and sent to neighbors, so that the propagation of blocks through
+// the network can be reconstructed. The most recent traces are kept in memory and all traces can be written to a file
+// that is rotated once it reaches its maximum size.
+type Tracer struct {
+	traces      []PacketTrace
+	nextIndex   int
+	tracesCount int
+	file        *os.File
+	fileSize    int64
+	mutex       syncutils.Mutex
+
+	optsRingSize    int
+	optsFilePath    string
+	optsMaxFileSize int64
+	optsMaxFiles    int
+}
+
+// NewTracer creates a new Tracer and opens the trace file, if one is configured.
+func NewTracer(opts ...options.Option[Tracer]) (*Tracer, error) {
+	t := options.Apply(&Tracer{
+		optsRingSize:    10000,
+		optsMaxFileSize: 100 << 20,
+		optsMaxFiles:    5,
+	}, opts)
+
+	t.traces = make([]PacketTrace, t.optsRingSize)
+
+	if t.optsFilePath != "" {
+		if err := ioutils.CreateDirectory(filepath.Dir(t.optsFilePath), 0o700); err != nil {
+			return nil, ierrors.Wrap(err, "failed to create trace file directory")
+		}
+
+		if err := t.openFile(); err != nil {
+			return nil, err
+		}
+	}
+
+	return t, nil
+}
+
+// Trace records the given packet trace.
+func (t *Tracer) Trace(trace *PacketTrace) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if len(t.traces) > 0 {
+		t.traces[t.nextIndex] = *trace
+		t.nextIndex = (t.nextIndex + 1) % len(t.traces)
+		t.tracesCount = min(t.tracesCount+1, len(t.traces))
+	}
+
+	if t.file != nil {
+		// the tracing is best effort, so failing to write a trace must not affect the node.
+		_ = t.writeToFile(trace)
+	}
+}
+
+// Traces returns the traces in memory that are selected by the filter, ordered from the oldest to the newest one.
+func (t *Tracer) Traces(filter *TraceFilter) []*PacketTrace {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	traces := make([]*PacketTrace, 0)
+	for i := range t.tracesCount {
+		trace := t.traces[(t.nextIndex-t.tracesCount+i+len(t.traces))%len(t.traces)]
+		if filter.matches(&trace) {
+			traces = append(traces, &trace)
+		}
+	}
+
+	if filter.Limit > 0 && len(traces) > filter.Limit {
+		traces = traces[len(traces)-filter.Limit:]
+	}
+
+	return traces
+}
+
+// Close closes the trace file.
+func (t *Tracer) Close() error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if t.file == nil {
+		return nil
+	}
+
+	err := t.file.Close()
+	t.file = nil
+
+	return err
+}
+
+// writeToFile appends the trace to the trace file and rotates it if it reached its maximum size.
+func (t *Tracer) writeToFile(trace *PacketTrace) error {
+	traceJSON, err := json.Marshal(trace)
+	if err != nil {
+		return ierrors.Wrap(err, "failed to marshal packet trace")
+	}
+
+	if t.optsMaxFileSize > 0 && t.fileSize+int64(len(traceJSON))+1 > t.optsMaxFileSize {
+		if err := t.rotateFile(); err != nil {
+			return err
+		}
+	}
+
+	written, err := t.file.Write(append(traceJSON, '\n'))
+	t.fileSize += int64(written)
+
+	return err
+}
+
+// rotateFile moves the current trace file to the first backup (shifting the existing backups) and opens a new one.
+func (t *Tracer) rotateFile() error {
+	if err := t.file.Close(); err != nil {
+		return ierrors.Wrap(err, "failed to close trace file")
+	}
+	t.file = nil
+
+	for i := t.optsMaxFiles - 1; i > 0; i-- {
+		if err := os.Rename(t.backupFilePath(i-1), t.backupFilePath(i)); err != nil && !os.IsNotExist(err) {
+			return ierrors.Wrap(err, "failed to rotate trace file")
+		}
+	}
+
+	if t.optsMaxFiles <= 1 {
+		if err := os.Remove(t.optsFilePath); err != nil && !os.IsNotExist(err) {
+			return ierrors.Wrap(err, "failed to remove trace file")
+		}
+	}
+
+	return t.openFile()
+}
+
+// backupFilePath returns the path of the trace file with the given backup index (0 is the current file).
+func (t *Tracer) backupFilePath(index int) string {
+	if index == 0 {
+		return t.optsFilePath
+	}
+
+	return fmt.Sprintf("%s.%d", t.optsFilePath, index)
+}
+
+func (t *Tracer) openFile() error {
+	file, err := os.OpenFile(t.optsFilePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return ierrors.Wrapf(err, "failed to open trace file %s", t.optsFilePath)
+	}
+
+	fileInfo, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+
+		return ierrors.Wrapf(err, "failed to read the size of trace file %s", t.optsFilePath)
+	}
+
+	t.file = file
+	t.fileSize = fileInfo.Size()
+
+	return nil
+}
+
+// WithRingSize sets the number of most recent traces that are kept in memory.
+func WithRingSize(ringSize int) options.Option[Tracer] {
+	return func(t *Tracer) {
+		t.optsRingSize = ringSize
+	}
+}
+
+// WithTraceFile sets the file all traces are written to (traces are only kept in memory if it is empty).
+func WithTraceFile(filePath string) options.Option[Tracer] {
+	return func(t *Tracer) {
+		t.optsFilePath = filePath
+	}
+}
+
+// WithMaxFileSize sets the size at which the trace file is rotated (0 to disable the rotation).
+func WithMaxFileSize(maxFileSize int64) options.Option[Tracer] {
+	return func(t *Tracer) {
+		t.optsMaxFileSize = maxFileSize
+	}
+}
+
+// WithMaxFiles sets the number of trace files that are kept, including the current one.
+func WithMaxFiles(maxFiles int) options.Option[Tracer] {
+	return func(t *Tracer) {
+		t.optsMaxFiles = maxFiles
+	}
+}
+
+// tracePacket records the packet that was received from or sent to the given neighbors. A trace is recorded for every
+// neighbor the packet was sent to, or a single one if it was sent to all neighbors.
+func (p *Protocol) tracePacket(direction string, packet *nwmodels.Packet, peers ...peer.ID) {
+	trace := &PacketTrace{
+		Time:      time.Now(),
+		Direction: direction,
+		Size:      proto.Size(packet),
+	}
+
+	switch packetBody := packet.GetBody().(type) {
+	case *nwmodels.Packet_Block:
+		trace.Type = "block"
+		if blockID, issuingTime, err := p.blockIDFromBytes(packetBody.Block.GetBytes()); err == nil {
+			trace.ID = blockID.ToHex()
+			trace.Latency = trace.Time.Sub(issuingTime).String()
+		}
+	case *nwmodels.Packet_BlockRequest:
+		trace.Type = "blockRequest"
+		trace.ID = hexID[iotago.BlockID](packetBody.BlockRequest.GetBlockId())
+	case *nwmodels.Packet_SlotCommitment:
+		trace.Type = "slotCommitment"
+		trace.ID = p.commitmentIDFromBytes(packetBody.SlotCommitment.GetBytes())
+	case *nwmodels.Packet_SlotCommitmentRequest:
+		trace.Type = "slotCommitmentRequest"
+		trace.ID = hexID[iotago.CommitmentID](packetBody.SlotCommitmentRequest.GetCommitmentId())
+	case *nwmodels.Packet_Attestations:
+		trace.Type = "attestations"
+		trace.ID = p.commitmentIDFromBytes(packetBody.Attestations.GetCommitment())
+	case *nwmodels.Packet_AttestationsRequest:
+		trace.Type = "attestationsRequest"
+		trace.ID = hexID[iotago.CommitmentID](packetBody.AttestationsRequest.GetCommitmentId())
+	case *nwmodels.Packet_WarpSyncRequest:
+		trace.Type = "warpSyncRequest"
+		trace.ID = hexID[iotago.CommitmentID](packetBody.WarpSyncRequest.GetCommitmentId())
+	case *nwmodels.Packet_WarpSyncResponse:
+		trace.Type = "warpSyncResponse"
+		trace.ID = hexID[iotago.CommitmentID](packetBody.WarpSyncResponse.GetCommitmentId())
+	default:
+		trace.Type = "unknown"
+	}
+
+	if len(peers) == 0 {
+		p.optsTracer.Trace(trace)
+
+		return
+	}
+
+	for _, peerID := range peers {
+		peerTrace := *trace
+		peerTrace.PeerID = peerID.String()
+
+		p.optsTracer.Trace(&peerTrace)
+	}
+}
+
+// blockIDFromBytes returns the ID and the issuing time of the serialized block, only decoding its header.
+func (p *Protocol) blockIDFromBytes(blockBytes []byte) (iotago.BlockID, time.Time, error) {
+	blockIdentifier, err := iotago.BlockIdentifierFromBlockBytes(blockBytes)
+	if err != nil {
+		return iotago.EmptyBlockID, time.Time{}, err
+	}
+
+	apiForVersion, err := p.apiProvider.APIForVersion(iotago.Version(blockBytes[0]))
+	if err != nil {
+		return iotago.EmptyBlockID, time.Time{}, err
+	}
+
+	header := new(iotago.BlockHeader)
+	if _, err = apiForVersion.Decode(blockBytes[:iotago.BlockHeaderLength], header); err != nil {
+		return iotago.EmptyBlockID, time.Time{}, err
+	}
+
+	return iotago.NewBlockID(apiForVersion.TimeProvider().SlotFromTime(header.IssuingTime), blockIdentifier), header.IssuingTime, nil
+}
+
+// commitmentIDFromBytes returns the hex encoded ID of the serialized commitment (empty if it is invalid).
+func (p *Protocol) commitmentIDFromBytes(commitmentBytes []byte) string {
+	commitment, _, err := model.CommitmentFromBytes(p.apiProvider)(commitmentBytes)
+	if err != nil {
+		return ""
+	}
+
+	return commitment.ID().ToHex()
+}
+
+// hexID returns the hex encoded ID of the given bytes (empty if they have the wrong length).
+func hexID[ID interface {
+	iotago.BlockID | iotago.CommitmentID
+	ToHex() string
+}](idBytes []byte) string {
+	var id ID
+	if len(idBytes) != len(id) {
+		return ""
+	}
+
+	return ID(idBytes).ToHex()
+}
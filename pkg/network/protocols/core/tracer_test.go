@@ -0,0 +1,113 @@
+package core
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/iota-core/pkg/model"
+	nwmodels "github.com/iotaledger/iota-core/pkg/network/protocols/core/models"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/tpkg"
+)
+
+func TestTracer_Traces(t *testing.T) {
+	tracer, err := NewTracer(WithRingSize(3))
+	require.NoError(t, err)
+
+	for i := range 5 {
+		tracer.Trace(&PacketTrace{
+			Direction: PacketDirectionInbound,
+			Type:      "block",
+			PeerID:    fmt.Sprintf("peer%d", i%2),
+			ID:        fmt.Sprintf("block%d", i),
+		})
+	}
+
+	// only the most recent traces are kept, from the oldest to the newest one.
+	traces := tracer.Traces(&TraceFilter{})
+	require.Len(t, traces, 3)
+	require.Equal(t, []string{"block2", "block3", "block4"}, lo.Map(traces, func(trace *PacketTrace) string { return trace.ID }))
+
+	traces = tracer.Traces(&TraceFilter{PeerID: "peer0"})
+	require.Equal(t, []string{"block2", "block4"}, lo.Map(traces, func(trace *PacketTrace) string { return trace.ID }))
+
+	traces = tracer.Traces(&TraceFilter{Limit: 1})
+	require.Equal(t, []string{"block4"}, lo.Map(traces, func(trace *PacketTrace) string { return trace.ID }))
+
+	require.Empty(t, tracer.Traces(&TraceFilter{Type: "blockRequest"}))
+	require.NoError(t, tracer.Close())
+}
+
+func TestTracer_FileRotation(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "traces", "network.log")
+
+	tracer, err := NewTracer(WithTraceFile(filePath), WithMaxFileSize(200), WithMaxFiles(2))
+	require.NoError(t, err)
+
+	for i := range 10 {
+		tracer.Trace(&PacketTrace{Direction: PacketDirectionOutbound, Type: "block", ID: fmt.Sprintf("block%d", i)})
+	}
+	require.NoError(t, tracer.Close())
+
+	// only the current file and a single backup are kept.
+	require.FileExists(t, filePath)
+	require.FileExists(t, filePath+".1")
+	require.NoFileExists(t, filePath+".2")
+
+	for _, path := range []string{filePath, filePath + ".1"} {
+		content, err := os.ReadFile(path)
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(content), 200)
+	}
+
+	content, err := os.ReadFile(filePath)
+	require.NoError(t, err)
+	require.True(t, strings.HasSuffix(strings.TrimSpace(string(content)), `"id":"block9"}`))
+}
+
+func TestProtocol_TracePacket(t *testing.T) {
+	tracer, err := NewTracer()
+	require.NoError(t, err)
+
+	p := &Protocol{
+		apiProvider: iotago.SingleVersionProvider(tpkg.ZeroCostTestAPI),
+		optsTracer:  tracer,
+	}
+
+	block, err := model.BlockFromBlock(tpkg.RandBasicBlockWithIssuerAndRMC(tpkg.ZeroCostTestAPI, tpkg.RandAccountID(), 0))
+	require.NoError(t, err)
+
+	p.tracePacket(PacketDirectionOutbound, &nwmodels.Packet{Body: &nwmodels.Packet_Block{Block: &nwmodels.Block{
+		Bytes: block.Data(),
+	}}}, peer.ID("peer1"), peer.ID("peer2"))
+
+	blockID := tpkg.RandBlockID()
+	p.tracePacket(PacketDirectionInbound, &nwmodels.Packet{Body: &nwmodels.Packet_BlockRequest{BlockRequest: &nwmodels.BlockRequest{
+		BlockId: blockID[:],
+	}}})
+
+	traces := tracer.Traces(&TraceFilter{})
+	require.Len(t, traces, 3)
+
+	// a trace is recorded for every peer the packet was sent to.
+	for i, peerID := range []string{peer.ID("peer1").String(), peer.ID("peer2").String()} {
+		require.Equal(t, PacketDirectionOutbound, traces[i].Direction)
+		require.Equal(t, "block", traces[i].Type)
+		require.Equal(t, peerID, traces[i].PeerID)
+		require.Equal(t, block.ID().ToHex(), traces[i].ID)
+		require.NotEmpty(t, traces[i].Latency)
+		require.Positive(t, traces[i].Size)
+	}
+
+	require.Equal(t, PacketDirectionInbound, traces[2].Direction)
+	require.Equal(t, "blockRequest", traces[2].Type)
+	require.Empty(t, traces[2].PeerID)
+	require.Equal(t, blockID.ToHex(), traces[2].ID)
+}
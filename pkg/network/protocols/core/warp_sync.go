@@ -6,6 +6,7 @@ import (
 	"github.com/iotaledger/hive.go/ierrors"
 	"github.com/iotaledger/hive.go/lo"
 	"github.com/iotaledger/hive.go/serializer/v2/serix"
+	"github.com/iotaledger/iota-core/pkg/network"
 	nwmodels "github.com/iotaledger/iota-core/pkg/network/protocols/core/models"
 	iotago "github.com/iotaledger/iota.go/v4"
 	"github.com/iotaledger/iota.go/v4/merklehasher"
@@ -19,7 +20,7 @@ type WarpSyncPayload struct {
 }
 
 func (p *Protocol) SendWarpSyncRequest(id iotago.CommitmentID, to ...peer.ID) {
-	p.network.Send(&nwmodels.Packet{Body: &nwmodels.Packet_WarpSyncRequest{
+	p.send(&nwmodels.Packet{Body: &nwmodels.Packet_WarpSyncRequest{
 		WarpSyncRequest: &nwmodels.WarpSyncRequest{
 			CommitmentId: lo.PanicOnErr(id.Bytes()),
 		},
@@ -36,7 +37,7 @@ func (p *Protocol) SendWarpSyncResponse(id iotago.CommitmentID, blockIDsBySlotCo
 		MutationsMerkleProof:       mutationsMerkleProof,
 	}
 
-	p.network.Send(&nwmodels.Packet{Body: &nwmodels.Packet_WarpSyncResponse{
+	p.send(&nwmodels.Packet{Body: &nwmodels.Packet_WarpSyncResponse{
 		WarpSyncResponse: &nwmodels.WarpSyncResponse{
 			CommitmentId: lo.PanicOnErr(id.Bytes()),
 			Payload:      lo.PanicOnErr(serializer.Encode(payload)),
@@ -48,7 +49,7 @@ func (p *Protocol) handleWarpSyncRequest(commitmentIDBytes []byte, id peer.ID) {
 	p.workerPool.Submit(func() {
 		commitmentID, _, err := iotago.CommitmentIDFromBytes(commitmentIDBytes)
 		if err != nil {
-			p.Events.Error.Trigger(ierrors.Wrap(err, "failed to deserialize commitmentID in warp sync request"), id)
+			p.Events.Error.Trigger(ierrors.Chain(network.ErrMalformedPacket, ierrors.Wrap(err, "failed to deserialize commitmentID in warp sync request")), id)
 
 			return
 		}
@@ -61,7 +62,7 @@ func (p *Protocol) handleWarpSyncResponse(commitmentIDBytes []byte, payloadBytes
 	p.workerPool.Submit(func() {
 		commitmentID, _, err := iotago.CommitmentIDFromBytes(commitmentIDBytes)
 		if err != nil {
-			p.Events.Error.Trigger(ierrors.Wrap(err, "failed to deserialize commitmentID in warp sync response"), id)
+			p.Events.Error.Trigger(ierrors.Chain(network.ErrMalformedPacket, ierrors.Wrap(err, "failed to deserialize commitmentID in warp sync response")), id)
 
 			return
 		}
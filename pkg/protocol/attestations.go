@@ -10,6 +10,7 @@ import (
 	"github.com/iotaledger/hive.go/log"
 	"github.com/iotaledger/hive.go/runtime/workerpool"
 	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/network"
 	iotago "github.com/iotaledger/iota.go/v4"
 	"github.com/iotaledger/iota.go/v4/merklehasher"
 )
@@ -191,6 +192,11 @@ func (a *Attestations) processResponse(commitment *model.Commitment, attestation
 				if err != nil {
 					a.LogError("failed to verify commitment", "commitment", publishedCommitment.LogName(), "error", err)
 
+					// the committee and the weight depend on the local view of the node, so only provably invalid attestations are reported.
+					if isAttestationsMisbehavior(err) {
+						a.protocol.reportMisbehavior(from, network.MisbehaviorInvalidAttestations, err)
+					}
+
 					return currentWeight
 				}
 
@@ -11,6 +11,7 @@ import (
 	"github.com/iotaledger/hive.go/log"
 	"github.com/iotaledger/hive.go/runtime/workerpool"
 	"github.com/iotaledger/iota-core/pkg/core/buffer"
+	"github.com/iotaledger/iota-core/pkg/core/requester"
 	"github.com/iotaledger/iota-core/pkg/model"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
@@ -35,6 +36,9 @@ type Blocks struct {
 	// at a later point in time (to make tests more reliable as we have no continuous activity).
 	droppedBlocksBuffer *buffer.UnsolidCommitmentBuffer[*types.Tuple[*model.Block, peer.ID]]
 
+	// requester decides which peers missing blocks are requested from and prevents duplicate requests.
+	requester *requester.Requester[iotago.SlotIndex, iotago.BlockID]
+
 	// Logger embeds a logger that can be used to log messages emitted by this chain.
 	log.Logger
 }
@@ -48,6 +52,8 @@ func newBlocks(protocol *Protocol) *Blocks {
 		droppedBlocksBuffer: buffer.NewUnsolidCommitmentBuffer[*types.Tuple[*model.Block, peer.ID]](20, 100),
 	}
 
+	b.requester = requester.New[iotago.SlotIndex, iotago.BlockID](b.sendRequest, protocol.Options.BlockRequesterOptions...)
+
 	protocol.ConstructedEvent().OnTrigger(func() {
 		protocol.Commitments.WithElements(func(commitment *Commitment) (shutdown func()) {
 			return commitment.ReplayDroppedBlocks.OnUpdate(func(_ bool, replayBlocks bool) {
@@ -64,6 +70,14 @@ func newBlocks(protocol *Protocol) *Blocks {
 		protocol.Chains.WithInitializedEngines(func(chain *Chain, engine *engine.Engine) (shutdown func()) {
 			return lo.BatchReverse(
 				engine.Events.BlockRequester.Tick.Hook(b.SendRequest).Unhook,
+				engine.Events.BlockRequester.TickerStopped.Hook(b.requester.Complete).Unhook,
+				engine.Events.BlockRequester.TickerFailed.Hook(b.requester.Complete).Unhook,
+				engine.Events.Evict.Hook(func(slot iotago.SlotIndex) {
+					// the requests are shared by all engines, so they are only evicted together with the main engine.
+					if engine == b.protocol.Engines.Main.Get() {
+						b.requester.EvictUntil(slot)
+					}
+				}).Unhook,
 				engine.Events.Scheduler.BlockScheduled.Hook(func(block *blocks.Block) {
 					if !chain.WarpSyncMode.Get() {
 						b.SendResponse(block.ModelBlock())
@@ -83,15 +97,33 @@ func newBlocks(protocol *Protocol) *Blocks {
 	return b
 }
 
-// SendRequest sends a request for the given block to all peers.
+// SendRequest sends a request for the given block to the peer that most likely knows it, or to all peers if none of
+// them delivered it in time. The request is ignored if the block is already being requested.
 func (b *Blocks) SendRequest(blockID iotago.BlockID) {
+	b.requester.Request(blockID)
+}
+
+// sendRequest sends a request for the given block to the given peers (or to all peers if none are given).
+func (b *Blocks) sendRequest(blockID iotago.BlockID, to ...peer.ID) {
 	b.workerPool.Submit(func() {
-		b.protocol.Network.RequestBlock(blockID)
+		b.protocol.Network.RequestBlock(blockID, to...)
 
-		b.LogTrace("request", "blockID", blockID)
+		b.LogTrace("request", "blockID", blockID, "to", to)
 	})
 }
 
+// isMissing returns true if the given block is not known to the main engine, so that it will most likely be requested.
+func (b *Blocks) isMissing(blockID iotago.BlockID) bool {
+	mainEngine := b.protocol.Engines.Main.Get()
+	if mainEngine == nil {
+		return true
+	}
+
+	cachedBlock, exists := mainEngine.BlockCache.Block(blockID)
+
+	return !exists || cachedBlock.IsMissing()
+}
+
 // SendResponse sends the given block to all peers.
 func (b *Blocks) SendResponse(block *model.Block) {
 	b.workerPool.Submit(func() {
@@ -104,6 +136,17 @@ func (b *Blocks) SendResponse(block *model.Block) {
 // ProcessResponse processes the given block response.
 func (b *Blocks) ProcessResponse(block *model.Block, from peer.ID) {
 	b.workerPool.Submit(func() {
+		b.requester.Complete(block.ID())
+
+		// the sender of a block most likely knows its parents, so it is asked first if one of them is missing.
+		if from != "self" {
+			for _, parentID := range block.ProtocolBlock().Parents() {
+				if b.isMissing(parentID) {
+					b.requester.AddHint(parentID, from)
+				}
+			}
+		}
+
 		// this check must happen before the block reaches the Engine. The Protocol needs a perception of the current time,
 		// otherwise a malicous actor might trigger a chain switch by sending a block with a commitment in the future.
 		if timeDelta := time.Since(block.ProtocolBlock().Header.IssuingTime); timeDelta < -b.protocol.Options.MaxAllowedWallClockDrift {
@@ -171,5 +214,6 @@ func (b *Blocks) ProcessRequest(blockID iotago.BlockID, from peer.ID) {
 
 // Shutdown shuts down the blocks protocol and waits for all pending requests to be finished.
 func (b *Blocks) Shutdown() {
+	b.requester.Shutdown()
 	b.workerPool.Shutdown().ShutdownComplete.Wait()
 }
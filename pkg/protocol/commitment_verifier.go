@@ -72,7 +72,7 @@ func (c *CommitmentVerifier) verifyCommitment(commitment *Commitment, attestatio
 		}
 	}
 	if !iotago.VerifyProof(merkleProof, tree.Root(), commitment.RootsID()) {
-		return nil, 0, ierrors.Errorf("invalid merkle proof for attestations for commitment %s", commitment.ID())
+		return nil, 0, ierrors.WithMessagef(ErrorInvalidAttestationsProof, "commitment %s", commitment.ID())
 	}
 
 	// 2. Update validatorAccountsData if fork happened across epoch boundaries.
@@ -134,6 +134,14 @@ func (c *CommitmentVerifier) verifyCommitment(commitment *Commitment, attestatio
 	return blockIDs, seatCount, nil
 }
 
+// isAttestationsMisbehavior returns true if the given error of verifyCommitment proves that the attestations are invalid,
+// independent of the committee and the accounts known to the node.
+func isAttestationsMisbehavior(err error) bool {
+	return ierrors.Is(err, ErrorInvalidAttestationsProof) ||
+		ierrors.Is(err, ErrorInvalidAttestationSignature) ||
+		ierrors.Is(err, ErrorDuplicateAttestationIssuer)
+}
+
 func (c *CommitmentVerifier) verifyAttestations(attestations []*iotago.Attestation) (iotago.BlockIDs, uint64, error) {
 	c.mutex.RLock()
 	defer c.mutex.RUnlock()
@@ -173,15 +181,15 @@ func (c *CommitmentVerifier) verifyAttestations(attestations []*iotago.Attestati
 		// 2. Verify the signature of the attestation.
 		if valid, err := att.VerifySignature(); !valid {
 			if err != nil {
-				return nil, 0, ierrors.Wrap(err, "error validating attestation signature")
+				return nil, 0, ierrors.Chain(ErrorInvalidAttestationSignature, ierrors.Wrap(err, "error validating attestation signature"))
 			}
 
-			return nil, 0, ierrors.New("invalid attestation signature")
+			return nil, 0, ErrorInvalidAttestationSignature
 		}
 
 		// 3. A valid set of attestations can't contain multiple attestations from the same issuerID.
 		if visitedIdentities.Has(att.Header.IssuerID) {
-			return nil, 0, ierrors.Errorf("issuerID %s contained in multiple attestations", att.Header.IssuerID)
+			return nil, 0, ierrors.WithMessagef(ErrorDuplicateAttestationIssuer, "issuerID %s contained in multiple attestations", att.Header.IssuerID)
 		}
 
 		attestationBlockID, err := att.BlockID()
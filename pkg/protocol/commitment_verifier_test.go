@@ -0,0 +1,109 @@
+package protocol
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/iota-core/pkg/core/account"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/accounts"
+	"github.com/iotaledger/iota-core/pkg/protocol/sybilprotection"
+	"github.com/iotaledger/iota-core/pkg/protocol/sybilprotection/seatmanager"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/builder"
+	"github.com/iotaledger/iota.go/v4/tpkg"
+)
+
+// committeeSybilProtection is a SybilProtection that only provides a SeatManager with a fixed committee.
+type committeeSybilProtection struct {
+	sybilprotection.SybilProtection
+
+	committee *account.SeatedAccounts
+}
+
+func (c *committeeSybilProtection) SeatManager() seatmanager.SeatManager {
+	return &committeeSeatManager{committee: c.committee}
+}
+
+// committeeSeatManager is a SeatManager that returns the same committee for every slot, if it is set.
+type committeeSeatManager struct {
+	seatmanager.SeatManager
+
+	committee *account.SeatedAccounts
+}
+
+func (c *committeeSeatManager) CommitteeInSlot(iotago.SlotIndex) (*account.SeatedAccounts, bool) {
+	return c.committee, c.committee != nil
+}
+
+func TestCommitmentVerifier_VerifyAttestations(t *testing.T) {
+	issuerID := tpkg.RandAccountID()
+
+	block, err := builder.NewBasicBlockBuilder(tpkg.ZeroCostTestAPI).
+		SlotCommitmentID(tpkg.RandCommitmentID()).
+		Sign(issuerID, tpkg.RandEd25519PrivateKey()).
+		Build()
+	require.NoError(t, err)
+
+	signature, isEd25519Signature := block.Signature.(*iotago.Ed25519Signature)
+	require.True(t, isEd25519Signature)
+
+	attestation := iotago.NewAttestation(tpkg.ZeroCostTestAPI, block)
+
+	tamperedAttestation := *attestation
+	tamperedAttestation.BodyHash = tpkg.Rand32ByteArray()
+
+	committeeAccounts := account.NewAccounts()
+	require.NoError(t, committeeAccounts.Set(issuerID, &account.Pool{PoolStake: 1, ValidatorStake: 1, FixedCost: 1}))
+
+	newVerifier := func(committee *account.SeatedAccounts) *CommitmentVerifier {
+		return &CommitmentVerifier{
+			engine: &engine.Engine{SybilProtection: &committeeSybilProtection{committee: committee}},
+			validatorAccountsData: map[iotago.AccountID]*accounts.AccountData{
+				issuerID: accounts.NewAccountData(issuerID, accounts.WithBlockIssuerKeys(iotago.Ed25519PublicKeyHashBlockIssuerKeyFromPublicKey(signature.PublicKey))),
+			},
+		}
+	}
+
+	verifier := newVerifier(committeeAccounts.SeatedAccounts())
+
+	t.Run("valid attestations", func(t *testing.T) {
+		blockIDs, seatCount, err := verifier.verifyAttestations([]*iotago.Attestation{attestation})
+		require.NoError(t, err)
+		require.Equal(t, iotago.BlockIDs{lo.PanicOnErr(attestation.BlockID())}, blockIDs)
+		require.EqualValues(t, 1, seatCount)
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		_, _, err := verifier.verifyAttestations([]*iotago.Attestation{&tamperedAttestation})
+		require.ErrorIs(t, err, ErrorInvalidAttestationSignature)
+		require.True(t, isAttestationsMisbehavior(err))
+	})
+
+	t.Run("duplicate issuer", func(t *testing.T) {
+		_, _, err := verifier.verifyAttestations([]*iotago.Attestation{attestation, attestation})
+		require.ErrorIs(t, err, ErrorDuplicateAttestationIssuer)
+		require.True(t, isAttestationsMisbehavior(err))
+	})
+
+	t.Run("unknown committee", func(t *testing.T) {
+		_, _, err := newVerifier(nil).verifyAttestations([]*iotago.Attestation{attestation})
+		require.Error(t, err)
+		require.False(t, isAttestationsMisbehavior(err))
+	})
+}
+
+func TestIsAttestationsMisbehavior(t *testing.T) {
+	commitmentID := tpkg.RandCommitmentID()
+
+	// errors are wrapped the same way as in verifyCommitment.
+	require.True(t, isAttestationsMisbehavior(ierrors.WithMessagef(ErrorInvalidAttestationsProof, "commitment %s", commitmentID)))
+	require.True(t, isAttestationsMisbehavior(ierrors.Wrapf(ErrorInvalidAttestationSignature, "error validating attestations for commitment %s", commitmentID)))
+	require.True(t, isAttestationsMisbehavior(ierrors.Wrapf(ErrorDuplicateAttestationIssuer, "error validating attestations for commitment %s", commitmentID)))
+
+	require.False(t, isAttestationsMisbehavior(ierrors.Errorf("calculated weight from attestations (%d) is higher than weight of commitment (%d) for commitment %s", 2, 1, commitmentID)))
+	require.False(t, isAttestationsMisbehavior(ierrors.Wrapf(ierrors.Errorf("committee for slot %d does not exist", 1), "error validating attestations for commitment %s", commitmentID)))
+}
@@ -146,6 +146,16 @@ func (m *Manager) AccountsTreeRoot() iotago.Identifier {
 	return root
 }
 
+// ForEachAccount calls the consumer for every account in the Account tree at the latest committed slot.
+func (m *Manager) ForEachAccount(consumer func(accountData *accounts.AccountData) error) error {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return m.accountsTree.Stream(func(_ iotago.AccountID, accountData *accounts.AccountData) error {
+		return consumer(accountData)
+	})
+}
+
 // ApplyDiff applies the given accountDiff to the Account tree.
 func (m *Manager) ApplyDiff(
 	slot iotago.SlotIndex,
@@ -2,6 +2,11 @@ package accountsledger_test
 
 import (
 	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/accounts"
+	iotago "github.com/iotaledger/iota.go/v4"
 )
 
 func TestManager_Scenario1(t *testing.T) {
@@ -235,3 +240,41 @@ func TestManager_Scenario5(t *testing.T) {
 		},
 	})
 }
+
+func TestManager_ForEachAccount(t *testing.T) {
+	ts := NewTestSuite(t)
+
+	ts.ApplySlotActions(1, 5, map[string]*AccountActions{
+		"A": {
+			TotalAllotments: 10,
+			NumBlocks:       1,
+			AddedKeys:       []string{"A.P1"},
+
+			NewOutputID: "A1",
+		},
+		"B": {
+			TotalAllotments: 20,
+			NumBlocks:       2,
+			AddedKeys:       []string{"B.P1"},
+
+			NewOutputID: "B1",
+		},
+	})
+
+	ts.ApplySlotActions(2, 1, map[string]*AccountActions{
+		"A": {
+			Destroyed: true,
+		},
+	})
+
+	visitedAccounts := make(map[iotago.AccountID]iotago.OutputID)
+	require.NoError(t, ts.Instance.ForEachAccount(func(accountData *accounts.AccountData) error {
+		visitedAccounts[accountData.ID()] = accountData.OutputID()
+
+		return nil
+	}))
+
+	require.Equal(t, map[iotago.AccountID]iotago.OutputID{
+		ts.AccountID("B", false): ts.OutputID("B1", false),
+	}, visitedAccounts)
+}
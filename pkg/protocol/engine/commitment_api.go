@@ -1,6 +1,8 @@
 package engine
 
 import (
+	"crypto"
+
 	"github.com/iotaledger/hive.go/ads"
 	"github.com/iotaledger/hive.go/ierrors"
 	"github.com/iotaledger/iota-core/pkg/model"
@@ -64,6 +66,35 @@ func (c *CommitmentAPI) Attestations() (commitment *model.Commitment, attestatio
 	return commitment, attestations, roots.AttestationsProof(), nil
 }
 
+// StateRootProof returns the commitment, the state root and the merkle proof of the state root of the slot.
+func (c *CommitmentAPI) StateRootProof() (commitment *model.Commitment, stateRoot iotago.Identifier, stateRootProof *merklehasher.Proof[iotago.Identifier], err error) {
+	commitment, err = c.Commitment()
+	if err != nil {
+		return nil, iotago.EmptyIdentifier, nil, ierrors.Wrap(err, "failed to load commitment")
+	}
+
+	roots, err := c.Roots()
+	if err != nil {
+		return nil, iotago.EmptyIdentifier, nil, err
+	}
+
+	// the values need to be in the same order as they are hashed in iotago.Roots.ID().
+	if stateRootProof, err = merklehasher.NewHasher[iotago.Identifier](crypto.BLAKE2b_256).ComputeProofForIndex([]iotago.Identifier{
+		roots.TangleRoot,
+		roots.StateMutationRoot,
+		roots.StateRoot,
+		roots.AccountRoot,
+		roots.AttestationsRoot,
+		roots.CommitteeRoot,
+		roots.RewardsRoot,
+		roots.ProtocolParametersHash,
+	}, 2); err != nil {
+		return nil, iotago.EmptyIdentifier, nil, ierrors.Wrap(err, "failed to compute state root proof")
+	}
+
+	return commitment, roots.StateRoot, stateRootProof, nil
+}
+
 // Mutations returns all accepted block IDs, the tangle proof, all accepted transaction IDs and the ledger state
 // mutation proof of the slot.
 func (c *CommitmentAPI) Mutations() (acceptedBlocksBySlotCommitment map[iotago.CommitmentID]iotago.BlockIDs, acceptedBlocksProof *merklehasher.Proof[iotago.Identifier], acceptedTransactionIDs iotago.TransactionIDs, acceptedTransactionsProof *merklehasher.Proof[iotago.Identifier], err error) {
@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -45,6 +46,7 @@ import (
 	"github.com/iotaledger/iota-core/pkg/retainer"
 	"github.com/iotaledger/iota-core/pkg/storage"
 	"github.com/iotaledger/iota-core/pkg/storage/database"
+	"github.com/iotaledger/iota-core/pkg/storage/snapshot"
 	iotago "github.com/iotaledger/iota.go/v4"
 )
 
@@ -96,6 +98,7 @@ type Engine struct {
 	isSnapshotting atomic.Bool
 
 	optsSnapshotPath     string
+	optsSnapshotReader   io.Reader
 	optsEntryPointsDepth int
 	optsSnapshotDepth    int
 	optsCheckCommitment  bool
@@ -129,8 +132,7 @@ func New(
 	opts ...options.Option[Engine],
 ) (engine *Engine) {
 	var importSnapshot bool
-	var file *os.File
-	var fileErr error
+	var file *snapshotFile
 
 	return options.Apply(
 		&Engine{
@@ -153,13 +155,19 @@ func New(
 			}
 
 			// Import the settings from the snapshot file if needed.
-			if importSnapshot = !e.Storage.Settings().IsSnapshotImported() && e.optsSnapshotPath != ""; importSnapshot {
-				file, fileErr = os.Open(e.optsSnapshotPath)
-				if fileErr != nil {
-					panic(ierrors.Wrap(fileErr, "failed to open snapshot file"))
+			if importSnapshot = !e.Storage.Settings().IsSnapshotImported() && (e.optsSnapshotReader != nil || e.optsSnapshotPath != ""); importSnapshot {
+				var err error
+				if e.optsSnapshotReader != nil {
+					file, err = openSnapshotStream(e.optsSnapshotReader)
+				} else {
+					file, err = openSnapshotFile(e.optsSnapshotPath)
 				}
 
-				if err := e.ImportSettings(file); err != nil {
+				if err != nil {
+					panic(err)
+				}
+
+				if err = file.importSections(e.snapshotSections()[:1]); err != nil {
 					panic(ierrors.Wrap(err, "failed to import snapshot settings"))
 				}
 			}
@@ -208,7 +216,7 @@ func New(
 
 			// Import the rest of the snapshot if needed.
 			if importSnapshot {
-				if err := e.ImportContents(file); err != nil {
+				if err := file.importSections(e.snapshotSections()[1:]); err != nil {
 					panic(ierrors.Wrap(err, "failed to import snapshot contents"))
 				}
 
@@ -380,37 +388,74 @@ func (e *Engine) IsSnapshotting() bool {
 }
 
 func (e *Engine) WriteSnapshot(filePath string, targetSlot ...iotago.SlotIndex) error {
+	if len(targetSlot) == 0 {
+		targetSlot = append(targetSlot, e.Storage.Settings().LatestCommitment().Slot())
+	}
+
+	return e.writeSnapshot(context.Background(), filePath, targetSlot[0], nil)
+}
+
+// writeSnapshot writes the snapshot for the given slot to a temporary file that is renamed to the given file path once it
+// is complete, so that a partially written snapshot is never visible under its final name.
+// If the context is canceled or writing fails, the partially written file is removed.
+func (e *Engine) writeSnapshot(ctx context.Context, filePath string, targetSlot iotago.SlotIndex, progressFunc func(bytesWritten int64)) error {
 	if e.isSnapshotting.Swap(true) {
 		return ErrSnapshottingInProgress
 	}
 	defer e.isSnapshotting.Store(false)
 
-	latestCommittedSlot := e.Storage.Settings().LatestCommitment().Slot()
-
-	if len(targetSlot) == 0 {
-		targetSlot = append(targetSlot, latestCommittedSlot)
+	if err := e.checkSnapshotTargetSlot(targetSlot); err != nil {
+		return err
 	}
 
-	if targetSlot[0] > latestCommittedSlot {
-		return ierrors.Errorf("impossible to create a snapshot for slot %d because it is not committed yet (latest committed slot %d)", targetSlot[0], latestCommittedSlot)
-	}
+	tempFilePath := filePath + snapshot.TempFileSuffix
 
-	if lastPrunedEpoch, hasPruned := e.Storage.LastPrunedEpoch(); hasPruned && e.APIForSlot(targetSlot[0]).TimeProvider().EpochFromSlot(targetSlot[0]) <= lastPrunedEpoch {
-		return ierrors.Errorf("impossible to create a snapshot for slot %d because it is pruned (last pruned slot %d)", targetSlot[0], lo.Return1(e.Storage.LastPrunedEpoch()))
+	fileHandle, err := os.Create(tempFilePath)
+	if err != nil {
+		return ierrors.Wrap(err, "failed to create snapshot file")
 	}
 
-	if fileHandle, err := os.Create(filePath); err != nil {
-		return ierrors.Wrap(err, "failed to create snapshot file")
-	} else if err = e.Export(fileHandle, targetSlot[0]); err != nil {
+	if err = e.Export(newSnapshotFileWriter(ctx, fileHandle, progressFunc), targetSlot); err != nil {
+		_ = fileHandle.Close()
+		_ = os.Remove(tempFilePath)
+
 		return ierrors.Wrap(err, "failed to write snapshot")
-	} else if err = fileHandle.Close(); err != nil {
+	}
+
+	if err = fileHandle.Close(); err != nil {
+		_ = os.Remove(tempFilePath)
+
 		return ierrors.Wrap(err, "failed to close snapshot file")
 	}
 
+	if err = os.Rename(tempFilePath, filePath); err != nil {
+		_ = os.Remove(tempFilePath)
+
+		return ierrors.Wrap(err, "failed to rename snapshot file")
+	}
+
+	return nil
+}
+
+func (e *Engine) checkSnapshotTargetSlot(targetSlot iotago.SlotIndex) error {
+	if latestCommittedSlot := e.Storage.Settings().LatestCommitment().Slot(); targetSlot > latestCommittedSlot {
+		return ierrors.Errorf("impossible to create a snapshot for slot %d because it is not committed yet (latest committed slot %d)", targetSlot, latestCommittedSlot)
+	}
+
+	if lastPrunedEpoch, hasPruned := e.Storage.LastPrunedEpoch(); hasPruned && e.APIForSlot(targetSlot).TimeProvider().EpochFromSlot(targetSlot) <= lastPrunedEpoch {
+		return ierrors.Errorf("impossible to create a snapshot for slot %d because it is pruned (last pruned slot %d)", targetSlot, lo.Return1(e.Storage.LastPrunedEpoch()))
+	}
+
 	return nil
 }
 
 func (e *Engine) ExportSnapshot(filePath string, addSlotToFileName bool, useFinalized bool) (iotago.SlotIndex, string, error) {
+	return e.ExportSnapshotWithContext(context.Background(), filePath, addSlotToFileName, useFinalized, nil)
+}
+
+// ExportSnapshotWithContext works like ExportSnapshot, but aborts writing the snapshot if the context is canceled.
+// The optional progressFunc is called with the number of bytes written to the snapshot file so far.
+func (e *Engine) ExportSnapshotWithContext(ctx context.Context, filePath string, addSlotToFileName bool, useFinalized bool, progressFunc func(bytesWritten int64)) (iotago.SlotIndex, string, error) {
 	// we need to create snapshots always for the last slot of the previous epoch
 	var targetSlot iotago.SlotIndex
 
@@ -444,7 +489,7 @@ func (e *Engine) ExportSnapshot(filePath string, addSlotToFileName bool, useFina
 		filePath = filepath.Join(directory, fmt.Sprintf("%s_%d%s", fileNameWithoutExt, targetSlot, fileExt))
 	}
 
-	if err := e.WriteSnapshot(filePath, targetSlot); err != nil {
+	if err := e.writeSnapshot(ctx, filePath, targetSlot, progressFunc); err != nil {
 		return 0, "", err
 	}
 
@@ -452,31 +497,11 @@ func (e *Engine) ExportSnapshot(filePath string, addSlotToFileName bool, useFina
 }
 
 func (e *Engine) ImportSettings(reader io.ReadSeeker) (err error) {
-	if err = e.Storage.Settings().Import(reader); err != nil {
-		return ierrors.Wrap(err, "failed to import settings")
-	}
-
-	return
+	return importSections(reader, e.snapshotSections()[:1])
 }
 
 func (e *Engine) ImportContents(reader io.ReadSeeker) (err error) {
-	if err = e.Storage.Commitments().Import(reader); err != nil {
-		return ierrors.Wrap(err, "failed to import commitments")
-	} else if err = e.Ledger.Import(reader); err != nil {
-		return ierrors.Wrap(err, "failed to import ledger")
-	} else if err := e.SybilProtection.Import(reader); err != nil {
-		return ierrors.Wrap(err, "failed to import sybil protection")
-	} else if err = e.EvictionState.Import(reader); err != nil {
-		return ierrors.Wrap(err, "failed to import eviction state")
-	} else if err = e.Attestations.Import(reader); err != nil {
-		return ierrors.Wrap(err, "failed to import attestation state")
-	} else if err = e.UpgradeOrchestrator.Import(reader); err != nil {
-		return ierrors.Wrap(err, "failed to import upgrade orchestrator")
-	} else if err = e.Storage.ImportRoots(reader, e.Storage.Settings().LatestCommitment()); err != nil {
-		return ierrors.Wrap(err, "failed to import roots")
-	}
-
-	return
+	return importSections(reader, e.snapshotSections()[1:])
 }
 
 func (e *Engine) Export(writer io.WriteSeeker, targetSlot iotago.SlotIndex) (err error) {
@@ -485,26 +510,13 @@ func (e *Engine) Export(writer io.WriteSeeker, targetSlot iotago.SlotIndex) (err
 		return ierrors.Wrapf(err, "failed to load target commitment at slot %d", targetSlot)
 	}
 
-	if err = e.Storage.Settings().Export(writer, targetCommitment.Commitment()); err != nil {
-		return ierrors.Wrap(err, "failed to export settings")
-	} else if err = e.Storage.Commitments().Export(writer, targetSlot); err != nil {
-		return ierrors.Wrap(err, "failed to export commitments")
-	} else if err = e.Ledger.Export(writer, targetSlot); err != nil {
-		return ierrors.Wrap(err, "failed to export ledger")
-	} else if err := e.SybilProtection.Export(writer, targetSlot); err != nil {
-		return ierrors.Wrap(err, "failed to export sybil protection")
-	} else if err = e.EvictionState.Export(writer, targetSlot); err != nil {
-		// The rootcommitment is determined from the rootblocks. Therefore, we need to export starting from the last finalized slot.
-		return ierrors.Wrap(err, "failed to export eviction state")
-	} else if err = e.Attestations.Export(writer, targetSlot); err != nil {
-		return ierrors.Wrap(err, "failed to export attestation state")
-	} else if err = e.UpgradeOrchestrator.Export(writer, targetSlot); err != nil {
-		return ierrors.Wrap(err, "failed to export upgrade orchestrator")
-	} else if err = e.Storage.ExportRoots(writer, targetCommitment.Commitment()); err != nil {
-		return ierrors.Wrap(err, "failed to export roots")
+	for _, section := range e.snapshotSections() {
+		if err = section.exportFunc(writer, targetCommitment); err != nil {
+			return ierrors.Wrapf(err, "failed to export %s", section.name)
+		}
 	}
 
-	return
+	return nil
 }
 
 // RemoveFromFilesystem removes the directory of the engine from the filesystem.
@@ -957,6 +969,14 @@ func WithSnapshotPath(snapshotPath string) options.Option[Engine] {
 	}
 }
 
+// WithSnapshotReader imports the snapshot from the given reader instead of the snapshot path (e.g. from stdin or an HTTP response).
+// The reader is not seekable, so the snapshot has to be in the streaming container format.
+func WithSnapshotReader(reader io.Reader) options.Option[Engine] {
+	return func(e *Engine) {
+		e.optsSnapshotReader = reader
+	}
+}
+
 func WithCommitmentCheck(checkCommitment bool) options.Option[Engine] {
 	return func(e *Engine) {
 		e.optsCheckCommitment = checkCommitment
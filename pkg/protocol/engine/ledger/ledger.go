@@ -28,6 +28,10 @@ type Ledger interface {
 	Output(id iotago.OutputID) (*utxoledger.Output, error)
 	OutputOrSpent(id iotago.OutputID) (output *utxoledger.Output, spent *utxoledger.Spent, err error)
 	ForEachUnspentOutput(consumer func(output *utxoledger.Output) bool) error
+	ForEachUnspentOutputAtSlot(slot iotago.SlotIndex, consumer func(output *utxoledger.Output) bool, options ...utxoledger.IterateOption) error
+	ComputeLedgerBalanceAtSlot(slot iotago.SlotIndex, options ...utxoledger.IterateOption) (balance iotago.BaseToken, storedMana iotago.Mana, count int, err error)
+	IsUnspentAtSlot(id iotago.OutputID, slot iotago.SlotIndex) (bool, error)
+	StateTreeProof(id iotago.OutputID) (*utxoledger.StateTreeProof, error)
 	AddGenesisUnspentOutput(unspentOutput *utxoledger.Output) error
 
 	SpendDAG() spenddag.SpendDAG[iotago.TransactionID, mempool.StateID, BlockVoteRank]
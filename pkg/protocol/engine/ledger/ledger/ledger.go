@@ -330,6 +330,22 @@ func (l *Ledger) ForEachUnspentOutput(consumer func(output *utxoledger.Output) b
 	return l.utxoLedger.ForEachUnspentOutput(consumer)
 }
 
+func (l *Ledger) ForEachUnspentOutputAtSlot(slot iotago.SlotIndex, consumer func(output *utxoledger.Output) bool, options ...utxoledger.IterateOption) error {
+	return l.utxoLedger.ForEachUnspentOutputAtSlot(slot, consumer, options...)
+}
+
+func (l *Ledger) ComputeLedgerBalanceAtSlot(slot iotago.SlotIndex, options ...utxoledger.IterateOption) (balance iotago.BaseToken, storedMana iotago.Mana, count int, err error) {
+	return l.utxoLedger.ComputeLedgerBalanceAtSlot(slot, options...)
+}
+
+func (l *Ledger) IsUnspentAtSlot(outputID iotago.OutputID, slot iotago.SlotIndex) (bool, error) {
+	return l.utxoLedger.IsUnspentAtSlot(outputID, slot)
+}
+
+func (l *Ledger) StateTreeProof(outputID iotago.OutputID) (*utxoledger.StateTreeProof, error) {
+	return l.utxoLedger.StateTreeProof(outputID)
+}
+
 func (l *Ledger) SlotDiffs(slot iotago.SlotIndex) (*utxoledger.SlotDiff, error) {
 	return l.utxoLedger.SlotDiffWithoutLocking(slot)
 }
@@ -0,0 +1,326 @@
+package engine
+
+import (
+	"context"
+	"io"
+	"os"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/hive.go/serializer/v2"
+	"github.com/iotaledger/hive.go/serializer/v2/stream"
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/storage/snapshot"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// snapshotSectionSettings is the name of the first snapshot section, which starts with the target commitment.
+const snapshotSectionSettings = "settings"
+
+// snapshotSection is a part of the snapshot that is written and read by a single component.
+// The legacy format contains the sections back to back, the streaming format stores them as named and checksummed sections.
+type snapshotSection struct {
+	name       string
+	exportFunc func(writer io.WriteSeeker, targetCommitment *model.Commitment) error
+	importFunc func(reader io.ReadSeeker) error
+}
+
+// snapshotSections returns the sections of a snapshot in the order they are written and read.
+func (e *Engine) snapshotSections() []*snapshotSection {
+	return []*snapshotSection{
+		{
+			name: snapshotSectionSettings,
+			exportFunc: func(writer io.WriteSeeker, targetCommitment *model.Commitment) error {
+				return e.Storage.Settings().Export(writer, targetCommitment.Commitment())
+			},
+			importFunc: e.Storage.Settings().Import,
+		},
+		{
+			name: "commitments",
+			exportFunc: func(writer io.WriteSeeker, targetCommitment *model.Commitment) error {
+				return e.Storage.Commitments().Export(writer, targetCommitment.Slot())
+			},
+			importFunc: func(reader io.ReadSeeker) error {
+				return e.Storage.Commitments().Import(reader)
+			},
+		},
+		{
+			name: "ledger",
+			exportFunc: func(writer io.WriteSeeker, targetCommitment *model.Commitment) error {
+				return e.Ledger.Export(writer, targetCommitment.Slot())
+			},
+			importFunc: func(reader io.ReadSeeker) error {
+				return e.Ledger.Import(reader)
+			},
+		},
+		{
+			name: "sybil protection",
+			exportFunc: func(writer io.WriteSeeker, targetCommitment *model.Commitment) error {
+				return e.SybilProtection.Export(writer, targetCommitment.Slot())
+			},
+			importFunc: func(reader io.ReadSeeker) error {
+				return e.SybilProtection.Import(reader)
+			},
+		},
+		{
+			name: "eviction state",
+			exportFunc: func(writer io.WriteSeeker, targetCommitment *model.Commitment) error {
+				// The rootcommitment is determined from the rootblocks. Therefore, we need to export starting from the last finalized slot.
+				return e.EvictionState.Export(writer, targetCommitment.Slot())
+			},
+			importFunc: func(reader io.ReadSeeker) error {
+				return e.EvictionState.Import(reader)
+			},
+		},
+		{
+			name: "attestation state",
+			exportFunc: func(writer io.WriteSeeker, targetCommitment *model.Commitment) error {
+				return e.Attestations.Export(writer, targetCommitment.Slot())
+			},
+			importFunc: func(reader io.ReadSeeker) error {
+				return e.Attestations.Import(reader)
+			},
+		},
+		{
+			name: "upgrade orchestrator",
+			exportFunc: func(writer io.WriteSeeker, targetCommitment *model.Commitment) error {
+				return e.UpgradeOrchestrator.Export(writer, targetCommitment.Slot())
+			},
+			importFunc: func(reader io.ReadSeeker) error {
+				return e.UpgradeOrchestrator.Import(reader)
+			},
+		},
+		{
+			name: "roots",
+			exportFunc: func(writer io.WriteSeeker, targetCommitment *model.Commitment) error {
+				return e.Storage.ExportRoots(writer, targetCommitment.Commitment())
+			},
+			importFunc: func(reader io.ReadSeeker) error {
+				return e.Storage.ImportRoots(reader, e.Storage.Settings().LatestCommitment())
+			},
+		},
+	}
+}
+
+// ExportStream exports the snapshot for the given slot in the streaming container format to the given writer.
+// Contrary to Export, the writer does not need to be seekable, so the snapshot can be piped to any destination.
+func (e *Engine) ExportStream(writer io.Writer, targetSlot iotago.SlotIndex, opts ...options.Option[snapshot.Writer]) error {
+	targetCommitment, err := e.Storage.Commitments().Load(targetSlot)
+	if err != nil {
+		return ierrors.Wrapf(err, "failed to load target commitment at slot %d", targetSlot)
+	}
+
+	snapshotWriter, err := snapshot.NewWriter(writer, opts...)
+	if err != nil {
+		return ierrors.Wrap(err, "failed to create snapshot writer")
+	}
+
+	for _, section := range e.snapshotSections() {
+		if err = snapshotWriter.WriteSection(section.name, func(sectionWriter io.WriteSeeker) error {
+			return section.exportFunc(sectionWriter, targetCommitment)
+		}); err != nil {
+			return ierrors.Wrapf(err, "failed to export %s", section.name)
+		}
+	}
+
+	if err = snapshotWriter.Close(); err != nil {
+		return ierrors.Wrap(err, "failed to close snapshot writer")
+	}
+
+	return nil
+}
+
+// WriteSnapshotStream writes the snapshot for the given slot in the streaming container format to the given writer.
+func (e *Engine) WriteSnapshotStream(writer io.Writer, targetSlot iotago.SlotIndex, opts ...options.Option[snapshot.Writer]) error {
+	if e.isSnapshotting.Swap(true) {
+		return ErrSnapshottingInProgress
+	}
+	defer e.isSnapshotting.Store(false)
+
+	if err := e.checkSnapshotTargetSlot(targetSlot); err != nil {
+		return err
+	}
+
+	if err := e.ExportStream(writer, targetSlot, opts...); err != nil {
+		return ierrors.Wrap(err, "failed to write snapshot")
+	}
+
+	return nil
+}
+
+// snapshotFile is a snapshot file on disk in either the legacy or the streaming container format,
+// or a snapshot in the streaming container format that is read from an io.Reader (in which case file is nil).
+type snapshotFile struct {
+	file         *os.File
+	streamReader *snapshot.Reader
+}
+
+// openSnapshotFile opens the snapshot file at the given path and detects its format.
+func openSnapshotFile(filePath string) (*snapshotFile, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, ierrors.Wrap(err, "failed to open snapshot file")
+	}
+
+	isStreamFormat, err := snapshot.IsStreamFormat(file)
+	if err != nil {
+		_ = file.Close()
+
+		return nil, ierrors.Wrap(err, "failed to detect snapshot format")
+	}
+
+	s := &snapshotFile{file: file}
+	if isStreamFormat {
+		if s.streamReader, err = snapshot.NewReader(file); err != nil {
+			_ = file.Close()
+
+			return nil, ierrors.Wrap(err, "failed to read snapshot header")
+		}
+	}
+
+	return s, nil
+}
+
+// openSnapshotStream reads a snapshot in the streaming container format from the given reader.
+func openSnapshotStream(reader io.Reader) (*snapshotFile, error) {
+	streamReader, err := snapshot.NewReader(reader)
+	if err != nil {
+		return nil, ierrors.Wrap(err, "failed to read snapshot header")
+	}
+
+	return &snapshotFile{streamReader: streamReader}, nil
+}
+
+// importSections imports the given sections from the snapshot file.
+func (s *snapshotFile) importSections(sections []*snapshotSection) error {
+	if s.streamReader == nil {
+		return importSections(s.file, sections)
+	}
+
+	for _, section := range sections {
+		if err := s.streamReader.ReadSection(section.name, section.importFunc); err != nil {
+			return ierrors.Wrapf(err, "failed to import %s", section.name)
+		}
+	}
+
+	return nil
+}
+
+// importSections imports the given sections from a snapshot in the legacy format.
+func importSections(reader io.ReadSeeker, sections []*snapshotSection) error {
+	for _, section := range sections {
+		if err := section.importFunc(reader); err != nil {
+			return ierrors.Wrapf(err, "failed to import %s", section.name)
+		}
+	}
+
+	return nil
+}
+
+// snapshotFileWriter writes a snapshot to a file, aborts as soon as the context is canceled and reports the written bytes.
+type snapshotFileWriter struct {
+	ctx          context.Context
+	file         *os.File
+	bytesWritten int64
+	progressFunc func(bytesWritten int64)
+}
+
+func newSnapshotFileWriter(ctx context.Context, file *os.File, progressFunc func(bytesWritten int64)) *snapshotFileWriter {
+	return &snapshotFileWriter{
+		ctx:          ctx,
+		file:         file,
+		progressFunc: progressFunc,
+	}
+}
+
+func (w *snapshotFileWriter) Write(p []byte) (int, error) {
+	if err := w.ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	n, err := w.file.Write(p)
+	w.bytesWritten += int64(n)
+
+	if w.progressFunc != nil {
+		w.progressFunc(w.bytesWritten)
+	}
+
+	return n, err
+}
+
+func (w *snapshotFileWriter) Seek(offset int64, whence int) (int64, error) {
+	return w.file.Seek(offset, whence)
+}
+
+// ReadSnapshotCommitment reads the target commitment of the snapshot file at the given path without importing it.
+func ReadSnapshotCommitment(filePath string) (*iotago.Commitment, error) {
+	file, err := openSnapshotFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.release()
+
+	// the target commitment is the first element of the settings section.
+	var commitmentBytes []byte
+	readCommitment := func(reader io.ReadSeeker) (err error) {
+		commitmentBytes, err = stream.ReadBytesWithSize(reader, serializer.SeriLengthPrefixTypeAsUint16)
+
+		return err
+	}
+
+	if file.streamReader == nil {
+		err = readCommitment(file.file)
+	} else {
+		err = file.streamReader.ReadSection(snapshotSectionSettings, func(reader io.ReadSeeker) error {
+			if err := readCommitment(reader); err != nil {
+				return err
+			}
+
+			// consume the rest of the section to verify its checksum.
+			_, err := io.Copy(io.Discard, reader)
+
+			return err
+		})
+	}
+
+	if err != nil {
+		return nil, ierrors.Wrap(err, "failed to read target commitment")
+	}
+
+	commitment := new(iotago.Commitment)
+	if _, err = iotago.CommonSerixAPI().Decode(context.Background(), commitmentBytes, commitment); err != nil {
+		return nil, ierrors.Wrap(err, "failed to decode target commitment")
+	}
+
+	return commitment, nil
+}
+
+// Close verifies that the snapshot was read completely and closes the file.
+func (s *snapshotFile) Close() error {
+	if s.streamReader != nil {
+		if err := s.streamReader.Finish(); err != nil {
+			s.release()
+
+			return ierrors.Wrap(err, "failed to finish reading snapshot")
+		}
+
+		s.streamReader.Close()
+	}
+
+	if s.file == nil {
+		return nil
+	}
+
+	return s.file.Close()
+}
+
+// release closes the file without verifying that the snapshot was read completely.
+func (s *snapshotFile) release() {
+	if s.streamReader != nil {
+		s.streamReader.Close()
+	}
+
+	if s.file != nil {
+		_ = s.file.Close()
+	}
+}
@@ -0,0 +1,245 @@
+package utxoledger
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/hive.go/serializer/v2/byteutils"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// AddressIndexBatchSize is the number of outputs that are added to the address index per batch when the index is built.
+const AddressIndexBatchSize = 10_000
+
+// addressIndexMarkerKey is set once the address index was built for all outputs of the ledger.
+var addressIndexMarkerKey = []byte{StoreKeyPrefixAddressOutputs}
+
+// ownerUnlockConditionTypes are the types of the unlock conditions that contain an owner address, in the order in
+// which their addresses are returned.
+var ownerUnlockConditionTypes = []iotago.UnlockConditionType{
+	iotago.UnlockConditionAddress,
+	iotago.UnlockConditionStorageDepositReturn,
+	iotago.UnlockConditionExpiration,
+	iotago.UnlockConditionStateControllerAddress,
+	iotago.UnlockConditionGovernorAddress,
+	iotago.UnlockConditionImmutableAccount,
+}
+
+// OwnerAddressesOptions are the options of OwnerAddresses and UnlockConditionAddresses.
+type OwnerAddressesOptions struct {
+	// includeReturnAddresses defines whether the return addresses of the storage deposit return and expiration unlock
+	// conditions are considered as owners.
+	includeReturnAddresses bool
+}
+
+// WithReturnAddresses defines whether the return addresses of the storage deposit return and expiration unlock
+// conditions are considered as owners of an output.
+func WithReturnAddresses(includeReturnAddresses bool) options.Option[OwnerAddressesOptions] {
+	return func(o *OwnerAddressesOptions) {
+		o.includeReturnAddresses = includeReturnAddresses
+	}
+}
+
+// UnlockConditionAddresses returns the owner addresses of the given output by the type of the unlock condition that
+// contains them.
+func UnlockConditionAddresses(output iotago.Output, opts ...options.Option[OwnerAddressesOptions]) map[iotago.UnlockConditionType]iotago.Address {
+	ownerAddressesOptions := options.Apply(new(OwnerAddressesOptions), opts)
+	unlockConditions := output.UnlockConditionSet()
+
+	addresses := make(map[iotago.UnlockConditionType]iotago.Address)
+	if unlockCondition := unlockConditions.Address(); unlockCondition != nil {
+		addresses[iotago.UnlockConditionAddress] = unlockCondition.Address
+	}
+
+	if ownerAddressesOptions.includeReturnAddresses {
+		if unlockCondition := unlockConditions.StorageDepositReturn(); unlockCondition != nil {
+			addresses[iotago.UnlockConditionStorageDepositReturn] = unlockCondition.ReturnAddress
+		}
+
+		if unlockCondition := unlockConditions.Expiration(); unlockCondition != nil {
+			addresses[iotago.UnlockConditionExpiration] = unlockCondition.ReturnAddress
+		}
+	}
+
+	if unlockCondition := unlockConditions.StateControllerAddress(); unlockCondition != nil {
+		addresses[iotago.UnlockConditionStateControllerAddress] = unlockCondition.Address
+	}
+
+	if unlockCondition := unlockConditions.GovernorAddress(); unlockCondition != nil {
+		addresses[iotago.UnlockConditionGovernorAddress] = unlockCondition.Address
+	}
+
+	if unlockCondition := unlockConditions.ImmutableAccount(); unlockCondition != nil {
+		addresses[iotago.UnlockConditionImmutableAccount] = unlockCondition.Address
+	}
+
+	return addresses
+}
+
+// OwnerAddresses returns the distinct addresses that own the given output. Besides the address unlock condition,
+// the state controller and governor of anchors and the account that controls a foundry are considered as owners.
+func OwnerAddresses(output iotago.Output, opts ...options.Option[OwnerAddressesOptions]) []iotago.Address {
+	unlockConditionAddresses := UnlockConditionAddresses(output, opts...)
+
+	var addresses []iotago.Address
+	addAddress := func(address iotago.Address) {
+		for _, existing := range addresses {
+			if existing.Equal(address) {
+				return
+			}
+		}
+
+		addresses = append(addresses, address)
+	}
+
+	for _, unlockConditionType := range ownerUnlockConditionTypes {
+		if address, exists := unlockConditionAddresses[unlockConditionType]; exists {
+			addAddress(address)
+		}
+	}
+
+	return addresses
+}
+
+// OutputOwnedByAddress returns whether the given address is one of the owners of the output.
+func OutputOwnedByAddress(output iotago.Output, address iotago.Address) bool {
+	for _, owner := range OwnerAddresses(output) {
+		if owner.Equal(address) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func addressOutputsPrefix(address iotago.Address) []byte {
+	return byteutils.ConcatBytes([]byte{StoreKeyPrefixAddressOutputs}, address.ID())
+}
+
+func addressOutputStorageKey(address iotago.Address, outputID iotago.OutputID) []byte {
+	return byteutils.ConcatBytes(addressOutputsPrefix(address), outputID[:])
+}
+
+func storeAddressIndex(output *Output, mutations kvstore.BatchedMutations) error {
+	for _, address := range OwnerAddresses(output.Output()) {
+		if err := mutations.Set(addressOutputStorageKey(address, output.OutputID()), []byte{}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func deleteAddressIndex(output *Output, mutations kvstore.BatchedMutations) error {
+	for _, address := range OwnerAddresses(output.Output()) {
+		if err := mutations.Delete(addressOutputStorageKey(address, output.OutputID())); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// BuildAddressIndexIfMissing adds all outputs of the ledger to the address index
+// if the ledger was created before the address index existed.
+// The index is committed in batches of AddressIndexBatchSize outputs and the marker is only set with the last batch,
+// so an interrupted build is started over on the next call.
+func (m *Manager) BuildAddressIndexIfMissing() error {
+	m.WriteLockLedger()
+	defer m.WriteUnlockLedger()
+
+	built, err := m.store.Has(addressIndexMarkerKey)
+	if err != nil {
+		return ierrors.Wrap(err, "failed to check address index marker")
+	}
+
+	if built {
+		return nil
+	}
+
+	mutations, err := m.store.Batched()
+	if err != nil {
+		return err
+	}
+
+	// cancelMutations cancels the current batch, unless it was already committed.
+	cancelMutations := func() {
+		if mutations != nil {
+			mutations.Cancel()
+		}
+	}
+
+	var batchedOutputs int
+	var innerErr error
+	if err := m.ForEachOutput(func(output *Output) bool {
+		if innerErr = storeAddressIndex(output, mutations); innerErr != nil {
+			return false
+		}
+
+		if batchedOutputs++; batchedOutputs < AddressIndexBatchSize {
+			return true
+		}
+
+		innerErr = mutations.Commit()
+		mutations, batchedOutputs = nil, 0
+		if innerErr != nil {
+			return false
+		}
+
+		mutations, innerErr = m.store.Batched()
+
+		return innerErr == nil
+	}, ReadLockLedger(false)); err != nil {
+		cancelMutations()
+
+		return ierrors.Wrap(err, "failed to iterate outputs")
+	}
+
+	if innerErr != nil {
+		cancelMutations()
+
+		return ierrors.Wrap(innerErr, "failed to add outputs to address index")
+	}
+
+	if err := mutations.Set(addressIndexMarkerKey, []byte{}); err != nil {
+		cancelMutations()
+
+		return err
+	}
+
+	return mutations.Commit()
+}
+
+// outputIDsOfAddressInChunkWithoutLocking returns the sorted IDs of the outputs in the address index that start with
+// the given chunk byte and are not lower than the given start output ID. This includes spent outputs that were not pruned yet.
+func (m *Manager) outputIDsOfAddressInChunkWithoutLocking(address iotago.Address, chunk byte, startOutputID iotago.OutputID) (iotago.OutputIDs, error) {
+	prefix := addressOutputsPrefix(address)
+
+	var outputIDs iotago.OutputIDs
+	if err := m.store.IterateKeys(byteutils.ConcatBytes(prefix, []byte{chunk}), func(key kvstore.Key) bool {
+		// other addresses might share the prefix if their ID is longer, so only keys of the exact length are considered.
+		if len(key) != len(prefix)+iotago.OutputIDLength {
+			return true
+		}
+
+		var outputID iotago.OutputID
+		copy(outputID[:], key[len(prefix):])
+
+		if bytes.Compare(outputID[:], startOutputID[:]) >= 0 {
+			outputIDs = append(outputIDs, outputID)
+		}
+
+		return true
+	}); err != nil {
+		return nil, ierrors.Wrapf(err, "failed to iterate address index chunk %d", chunk)
+	}
+
+	sort.Slice(outputIDs, func(i, j int) bool {
+		return bytes.Compare(outputIDs[i][:], outputIDs[j][:]) < 0
+	})
+
+	return outputIDs, nil
+}
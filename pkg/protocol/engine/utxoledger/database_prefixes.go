@@ -14,6 +14,9 @@ const (
 	StoreKeyPrefixSlotDiffs byte = 4
 
 	StoreKeyPrefixStateTree byte = 5
+
+	// StoreKeyPrefixAddressOutputs defines the prefix for the index of outputs by their owner addresses.
+	StoreKeyPrefixAddressOutputs byte = 6
 )
 
 /*
@@ -69,4 +72,16 @@ const (
        OutputCount  +  OutputCount  *  iotago.OutputID   + SpentCount +  SpentCount *    iotago.OutputID
          4 bytes    +  (OutputCount *    34 bytes)       +   4 bytes  + (SpentCount *       34 bytes)
 
+
+   Address outputs:
+   ================
+   Key:
+       StoreKeyPrefixAddressOutputs + iotago.Address.ID() + iotago.OutputID
+                  1 byte            +      X bytes        +     34 bytes
+
+   Value:
+       Empty
+
+   The key that only consists of StoreKeyPrefixAddressOutputs marks that the index was built for all outputs.
+
 */
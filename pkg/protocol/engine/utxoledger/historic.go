@@ -0,0 +1,286 @@
+package utxoledger
+
+import (
+	"sort"
+
+	"github.com/iotaledger/hive.go/core/safemath"
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/kvstore"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+var (
+	// ErrSlotNotCommitted is returned if the ledger state of a slot is requested that was not applied to the ledger yet.
+	ErrSlotNotCommitted = ierrors.New("slot was not applied to the ledger yet")
+	// ErrSlotPruned is returned if the ledger state of a slot can't be reconstructed because the required slot diffs were pruned.
+	ErrSlotPruned = ierrors.New("slot diffs required to reconstruct the ledger state were pruned")
+)
+
+// ForEachUnspentOutputAtSlot iterates over all outputs that were unspent after the given slot was applied to the ledger.
+// The state is reconstructed by rolling back the slot diffs from the latest ledger index to the given slot,
+// which is only possible as long as these slot diffs were not pruned.
+// If an address filter is given, the outputs of the address are iterated in the order of their output IDs.
+func (m *Manager) ForEachUnspentOutputAtSlot(slot iotago.SlotIndex, consumer OutputConsumer, options ...IterateOption) error {
+	opt := iterateOptions(options)
+
+	if opt.address != nil {
+		return m.forEachUnspentOutputOfAddressAtSlot(slot, consumer, opt)
+	}
+
+	if opt.readLockLedger {
+		m.ReadLockLedger()
+		defer m.ReadUnlockLedger()
+	}
+
+	createdAfterSlot, spentAfterSlot, err := m.diffsAfterSlotWithoutLocking(slot)
+	if err != nil {
+		return err
+	}
+
+	consumed := true
+	if err := m.ForEachUnspentOutput(func(output *Output) bool {
+		if _, created := createdAfterSlot[output.OutputID()]; created {
+			return true
+		}
+
+		consumed = consumer(output)
+
+		return consumed
+	}, ReadLockLedger(false)); err != nil {
+		return err
+	}
+
+	for _, output := range spentAfterSlot {
+		if !consumed {
+			break
+		}
+
+		consumed = consumer(output)
+	}
+
+	return nil
+}
+
+// OutputsAtSlot returns all outputs that were unspent after the given slot was applied to the ledger.
+func (m *Manager) OutputsAtSlot(slot iotago.SlotIndex, options ...IterateOption) (Outputs, error) {
+	var outputs Outputs
+	consumerFunc := func(output *Output) bool {
+		outputs = append(outputs, output)
+
+		return true
+	}
+
+	if err := m.ForEachUnspentOutputAtSlot(slot, consumerFunc, options...); err != nil {
+		return nil, err
+	}
+
+	return outputs, nil
+}
+
+// ComputeLedgerBalanceAtSlot returns the sum of the base tokens, the sum of the stored mana and the number of all outputs
+// that were unspent after the given slot was applied to the ledger.
+func (m *Manager) ComputeLedgerBalanceAtSlot(slot iotago.SlotIndex, options ...IterateOption) (balance iotago.BaseToken, storedMana iotago.Mana, count int, err error) {
+	var innerErr error
+	consumerFunc := func(output *Output) bool {
+		count++
+
+		if balance, innerErr = safemath.SafeAdd(balance, output.BaseTokenAmount()); innerErr != nil {
+			innerErr = ierrors.Wrap(innerErr, "failed to sum up base tokens")
+
+			return false
+		}
+
+		if storedMana, innerErr = safemath.SafeAdd(storedMana, output.StoredMana()); innerErr != nil {
+			innerErr = ierrors.Wrap(innerErr, "failed to sum up stored mana")
+
+			return false
+		}
+
+		return true
+	}
+
+	if err := m.ForEachUnspentOutputAtSlot(slot, consumerFunc, options...); err != nil {
+		return 0, 0, 0, err
+	}
+
+	if innerErr != nil {
+		return 0, 0, 0, innerErr
+	}
+
+	return balance, storedMana, count, nil
+}
+
+// IsUnspentAtSlot returns whether the output with the given ID existed and was unspent after the given slot was applied to the ledger.
+func (m *Manager) IsUnspentAtSlot(outputID iotago.OutputID, slot iotago.SlotIndex) (bool, error) {
+	m.ReadLockLedger()
+	defer m.ReadUnlockLedger()
+
+	if err := m.checkSlotAvailableWithoutLocking(slot); err != nil {
+		return false, err
+	}
+
+	output, err := m.ReadOutputByOutputIDWithoutLocking(outputID)
+	if err != nil {
+		// outputs are only removed from the ledger if the slot they were spent in is pruned,
+		// so the output was either spent before the given slot or never existed.
+		if ierrors.Is(err, kvstore.ErrKeyNotFound) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return m.outputUnspentAtSlotWithoutLocking(output, slot)
+}
+
+// outputUnspentAtSlotWithoutLocking returns whether the given output was booked and not spent yet after the given slot was applied to the ledger.
+func (m *Manager) outputUnspentAtSlotWithoutLocking(output *Output, slot iotago.SlotIndex) (bool, error) {
+	if output.SlotBooked() > slot {
+		return false, nil
+	}
+
+	spent, err := m.ReadSpentForOutputIDWithoutLocking(output.OutputID())
+	if err != nil {
+		if ierrors.Is(err, kvstore.ErrKeyNotFound) {
+			return true, nil
+		}
+
+		return false, err
+	}
+
+	return spent.SlotSpent() > slot, nil
+}
+
+// forEachUnspentOutputOfAddressAtSlot iterates over the outputs of the address index that were unspent after the given slot
+// was applied to the ledger. The address index is split into chunks by the first byte of the output IDs
+// and the ledger is only locked while a single chunk is collected, so the ledger is not blocked for the whole iteration.
+func (m *Manager) forEachUnspentOutputOfAddressAtSlot(slot iotago.SlotIndex, consumer OutputConsumer, opt *IterateOptions) error {
+	for chunk := int(opt.startOutputID[0]); chunk <= 0xff; chunk++ {
+		outputs, err := m.unspentOutputsOfAddressAtSlotInChunk(slot, byte(chunk), opt)
+		if err != nil {
+			return err
+		}
+
+		for _, output := range outputs {
+			if !consumer(output) {
+				return nil
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *Manager) unspentOutputsOfAddressAtSlotInChunk(slot iotago.SlotIndex, chunk byte, opt *IterateOptions) (Outputs, error) {
+	if opt.readLockLedger {
+		m.ReadLockLedger()
+		defer m.ReadUnlockLedger()
+	}
+
+	// the slot is checked for every chunk because the slot diffs might have been pruned in the meantime.
+	if err := m.checkSlotAvailableWithoutLocking(slot); err != nil {
+		return nil, err
+	}
+
+	outputIDs, err := m.outputIDsOfAddressInChunkWithoutLocking(opt.address, chunk, opt.startOutputID)
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := make(Outputs, 0, len(outputIDs))
+	for _, outputID := range outputIDs {
+		output, err := m.ReadOutputByOutputIDWithoutLocking(outputID)
+		if err != nil {
+			return nil, ierrors.Wrapf(err, "failed to load output %s of address index", outputID)
+		}
+
+		// the index key of an address might collide with the one of an address with a longer ID.
+		if !OutputOwnedByAddress(output.Output(), opt.address) {
+			continue
+		}
+
+		unspent, err := m.outputUnspentAtSlotWithoutLocking(output, slot)
+		if err != nil {
+			return nil, err
+		}
+
+		if unspent {
+			outputs = append(outputs, output)
+		}
+	}
+
+	return outputs, nil
+}
+
+// checkSlotAvailableWithoutLocking checks that the ledger state of the given slot can be reconstructed.
+func (m *Manager) checkSlotAvailableWithoutLocking(slot iotago.SlotIndex) error {
+	ledgerIndex, err := m.ReadLedgerIndexWithoutLocking()
+	if err != nil {
+		return err
+	}
+
+	if slot > ledgerIndex {
+		return ierrors.Wrapf(ErrSlotNotCommitted, "slot %d, ledger index %d", slot, ledgerIndex)
+	}
+
+	if slot == ledgerIndex {
+		return nil
+	}
+
+	// slot diffs are pruned from the oldest to the newest, so it is enough to check the oldest one that is required.
+	exists, err := m.store.Has(slotDiffKeyForIndex(slot + 1))
+	if err != nil {
+		return ierrors.Wrapf(err, "failed to check slot diff %d", slot+1)
+	}
+
+	if !exists {
+		return ierrors.Wrapf(ErrSlotPruned, "slot %d, ledger index %d", slot, ledgerIndex)
+	}
+
+	return nil
+}
+
+// diffsAfterSlotWithoutLocking collects the outputs that were created and spent after the given slot up to the latest ledger index.
+// Outputs that were created and spent within that range are not part of the returned spent outputs.
+func (m *Manager) diffsAfterSlotWithoutLocking(slot iotago.SlotIndex) (createdAfterSlot map[iotago.OutputID]struct{}, spentAfterSlot Outputs, err error) {
+	if err = m.checkSlotAvailableWithoutLocking(slot); err != nil {
+		return nil, nil, err
+	}
+
+	ledgerIndex, err := m.ReadLedgerIndexWithoutLocking()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	createdAfterSlot = make(map[iotago.OutputID]struct{})
+	spentOutputs := make(map[iotago.OutputID]*Output)
+
+	for diffIndex := slot + 1; diffIndex <= ledgerIndex; diffIndex++ {
+		slotDiff, err := m.SlotDiffWithoutLocking(diffIndex)
+		if err != nil {
+			if ierrors.Is(err, kvstore.ErrKeyNotFound) {
+				return nil, nil, ierrors.Wrapf(ErrSlotPruned, "slot diff %d is missing", diffIndex)
+			}
+
+			return nil, nil, ierrors.Wrapf(err, "failed to load slot diff %d", diffIndex)
+		}
+
+		for _, output := range slotDiff.Outputs {
+			createdAfterSlot[output.OutputID()] = struct{}{}
+		}
+
+		for _, spent := range slotDiff.Spents {
+			spentOutputs[spent.OutputID()] = spent.Output()
+		}
+	}
+
+	spentAfterSlot = make(Outputs, 0, len(spentOutputs))
+	for outputID, output := range spentOutputs {
+		if _, created := createdAfterSlot[outputID]; !created {
+			spentAfterSlot = append(spentAfterSlot, output)
+		}
+	}
+	sort.Sort(LexicalOrderedOutputs(spentAfterSlot))
+
+	return createdAfterSlot, spentAfterSlot, nil
+}
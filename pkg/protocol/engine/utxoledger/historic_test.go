@@ -0,0 +1,266 @@
+package utxoledger_test
+
+import (
+	"bytes"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/utxoledger"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/utxoledger/tpkg"
+	iotago "github.com/iotaledger/iota.go/v4"
+	iotago_tpkg "github.com/iotaledger/iota.go/v4/tpkg"
+)
+
+func randOutputBookedInSlot(slot iotago.SlotIndex) *utxoledger.Output {
+	return tpkg.RandLedgerStateOutputWithType(iotago.OutputBasic).CopyWithBlockIDAndSlotBooked(iotago_tpkg.RandBlockID(), slot)
+}
+
+func requireOutputsAtSlot(t *testing.T, manager *utxoledger.Manager, slot iotago.SlotIndex, expected ...*utxoledger.Output) {
+	t.Helper()
+
+	outputs, err := manager.OutputsAtSlot(slot)
+	require.NoError(t, err)
+
+	expectedOutputIDs := make([]iotago.OutputID, 0, len(expected))
+	expectedBalance := iotago.BaseToken(0)
+	expectedStoredMana := iotago.Mana(0)
+	for _, output := range expected {
+		expectedOutputIDs = append(expectedOutputIDs, output.OutputID())
+		expectedBalance += output.BaseTokenAmount()
+		expectedStoredMana += output.StoredMana()
+	}
+
+	outputIDs := make([]iotago.OutputID, 0, len(outputs))
+	for _, output := range outputs {
+		outputIDs = append(outputIDs, output.OutputID())
+	}
+	require.ElementsMatch(t, expectedOutputIDs, outputIDs)
+
+	balance, storedMana, count, err := manager.ComputeLedgerBalanceAtSlot(slot)
+	require.NoError(t, err)
+	require.Equal(t, expectedBalance, balance)
+	require.Equal(t, expectedStoredMana, storedMana)
+	require.Equal(t, len(expected), count)
+}
+
+func TestHistoricLedgerState(t *testing.T) {
+	manager := utxoledger.New(mapdb.NewMapDB(), iotago.SingleVersionProvider(iotago_tpkg.ZeroCostTestAPI))
+
+	outputA, outputB, outputC := randOutputBookedInSlot(10), randOutputBookedInSlot(10), randOutputBookedInSlot(10)
+	require.NoError(t, lo.Return2(manager.ApplyDiff(10, utxoledger.Outputs{outputA, outputB, outputC}, nil)))
+
+	outputD := randOutputBookedInSlot(11)
+	require.NoError(t, lo.Return2(manager.ApplyDiff(11, utxoledger.Outputs{outputD}, utxoledger.Spents{
+		tpkg.RandLedgerStateSpentWithOutput(outputA, 11),
+	})))
+
+	outputE := randOutputBookedInSlot(12)
+	require.NoError(t, lo.Return2(manager.ApplyDiff(12, utxoledger.Outputs{outputE}, utxoledger.Spents{
+		tpkg.RandLedgerStateSpentWithOutput(outputB, 12),
+		tpkg.RandLedgerStateSpentWithOutput(outputD, 12),
+	})))
+
+	requireOutputsAtSlot(t, manager, 10, outputA, outputB, outputC)
+	requireOutputsAtSlot(t, manager, 11, outputB, outputC, outputD)
+	requireOutputsAtSlot(t, manager, 12, outputC, outputE)
+
+	for _, testCase := range []struct {
+		output   *utxoledger.Output
+		slot     iotago.SlotIndex
+		expected bool
+	}{
+		{outputA, 10, true},
+		{outputA, 11, false},
+		{outputD, 10, false},
+		{outputD, 11, true},
+		{outputD, 12, false},
+		{outputC, 12, true},
+	} {
+		unspent, err := manager.IsUnspentAtSlot(testCase.output.OutputID(), testCase.slot)
+		require.NoError(t, err)
+		require.Equalf(t, testCase.expected, unspent, "output %s at slot %d", testCase.output.OutputID(), testCase.slot)
+	}
+
+	_, err := manager.OutputsAtSlot(13)
+	require.ErrorIs(t, err, utxoledger.ErrSlotNotCommitted)
+
+	manager.WriteLockLedger()
+	require.NoError(t, manager.PruneSlotIndexWithoutLocking(11))
+	manager.WriteUnlockLedger()
+
+	_, err = manager.OutputsAtSlot(10)
+	require.ErrorIs(t, err, utxoledger.ErrSlotPruned)
+
+	_, err = manager.IsUnspentAtSlot(outputA.OutputID(), 10)
+	require.ErrorIs(t, err, utxoledger.ErrSlotPruned)
+
+	requireOutputsAtSlot(t, manager, 11, outputB, outputC, outputD)
+}
+
+func requireOutputsOfAddressAtSlot(t *testing.T, manager *utxoledger.Manager, address iotago.Address, slot iotago.SlotIndex, options []utxoledger.IterateOption, expected ...*utxoledger.Output) {
+	t.Helper()
+
+	outputs, err := manager.OutputsAtSlot(slot, append(options, utxoledger.FilterAddress(address))...)
+	require.NoError(t, err)
+
+	expectedOutputIDs := make(iotago.OutputIDs, 0, len(expected))
+	for _, output := range expected {
+		expectedOutputIDs = append(expectedOutputIDs, output.OutputID())
+	}
+	sort.Slice(expectedOutputIDs, func(i, j int) bool {
+		return bytes.Compare(expectedOutputIDs[i][:], expectedOutputIDs[j][:]) < 0
+	})
+
+	outputIDs := make(iotago.OutputIDs, 0, len(outputs))
+	for _, output := range outputs {
+		outputIDs = append(outputIDs, output.OutputID())
+	}
+	require.Equal(t, expectedOutputIDs, outputIDs)
+}
+
+func TestHistoricLedgerStateOfAddress(t *testing.T) {
+	manager := utxoledger.New(mapdb.NewMapDB(), iotago.SingleVersionProvider(iotago_tpkg.ZeroCostTestAPI))
+
+	address := iotago_tpkg.RandEd25519Address()
+	randOutputOnAddressBookedInSlot := func(address iotago.Address, slot iotago.SlotIndex) *utxoledger.Output {
+		return tpkg.RandLedgerStateOutputOnAddress(iotago.OutputBasic, address).CopyWithBlockIDAndSlotBooked(iotago_tpkg.RandBlockID(), slot)
+	}
+
+	outputA, outputB, outputC := randOutputOnAddressBookedInSlot(address, 10), randOutputOnAddressBookedInSlot(address, 10), randOutputOnAddressBookedInSlot(address, 10)
+	otherOutput := randOutputOnAddressBookedInSlot(iotago_tpkg.RandEd25519Address(), 10)
+	require.NoError(t, lo.Return2(manager.ApplyDiff(10, utxoledger.Outputs{outputA, outputB, outputC, otherOutput}, nil)))
+
+	outputD := randOutputOnAddressBookedInSlot(address, 11)
+	require.NoError(t, lo.Return2(manager.ApplyDiff(11, utxoledger.Outputs{outputD}, utxoledger.Spents{
+		tpkg.RandLedgerStateSpentWithOutput(outputA, 11),
+	})))
+
+	requireOutputsOfAddressAtSlot(t, manager, address, 10, nil, outputA, outputB, outputC)
+	requireOutputsOfAddressAtSlot(t, manager, address, 11, nil, outputB, outputC, outputD)
+
+	// the iteration starts at the given output ID.
+	outputs, err := manager.OutputsAtSlot(11, utxoledger.FilterAddress(address))
+	require.NoError(t, err)
+	requireOutputsOfAddressAtSlot(t, manager, address, 11, []utxoledger.IterateOption{utxoledger.StartOutputID(outputs[1].OutputID())}, outputs[1:]...)
+
+	balance, storedMana, count, err := manager.ComputeLedgerBalanceAtSlot(10, utxoledger.FilterAddress(address))
+	require.NoError(t, err)
+	require.Equal(t, outputA.BaseTokenAmount()+outputB.BaseTokenAmount()+outputC.BaseTokenAmount(), balance)
+	require.Equal(t, outputA.StoredMana()+outputB.StoredMana()+outputC.StoredMana(), storedMana)
+	require.Equal(t, 3, count)
+
+	// the address index is rebuilt if it is missing.
+	require.NoError(t, manager.KVStore().DeletePrefix([]byte{utxoledger.StoreKeyPrefixAddressOutputs}))
+	requireOutputsOfAddressAtSlot(t, manager, address, 11, nil)
+
+	require.NoError(t, manager.BuildAddressIndexIfMissing())
+	requireOutputsOfAddressAtSlot(t, manager, address, 11, nil, outputB, outputC, outputD)
+}
+
+// limitedCommitsStore is a kvstore whose batches fail to commit once the remaining commits are used up.
+type limitedCommitsStore struct {
+	kvstore.KVStore
+
+	limitCommits     bool
+	remainingCommits int
+}
+
+func (s *limitedCommitsStore) Batched() (kvstore.BatchedMutations, error) {
+	mutations, err := s.KVStore.Batched()
+	if err != nil {
+		return nil, err
+	}
+
+	return &limitedCommitsMutations{BatchedMutations: mutations, store: s}, nil
+}
+
+type limitedCommitsMutations struct {
+	kvstore.BatchedMutations
+
+	store *limitedCommitsStore
+}
+
+func (m *limitedCommitsMutations) Commit() error {
+	if m.store.limitCommits {
+		if m.store.remainingCommits == 0 {
+			m.BatchedMutations.Cancel()
+
+			return ierrors.New("commit failed")
+		}
+
+		m.store.remainingCommits--
+	}
+
+	return m.BatchedMutations.Commit()
+}
+
+func TestBuildAddressIndexIfMissing_Batches(t *testing.T) {
+	store := &limitedCommitsStore{KVStore: mapdb.NewMapDB()}
+	manager := utxoledger.New(store, iotago.SingleVersionProvider(iotago_tpkg.ZeroCostTestAPI))
+
+	address := iotago_tpkg.RandEd25519Address()
+	outputs := make(utxoledger.Outputs, 0, 2*utxoledger.AddressIndexBatchSize+1)
+	for range cap(outputs) {
+		outputs = append(outputs, tpkg.RandLedgerStateOutputOnAddress(iotago.OutputBasic, address).CopyWithBlockIDAndSlotBooked(iotago_tpkg.RandBlockID(), 10))
+	}
+	require.NoError(t, lo.Return2(manager.ApplyDiff(10, outputs, nil)))
+
+	addressIndexPrefix := []byte{utxoledger.StoreKeyPrefixAddressOutputs}
+	require.NoError(t, store.DeletePrefix(addressIndexPrefix))
+
+	// the build fails after the first batch was committed, so the marker must not be set.
+	store.limitCommits, store.remainingCommits = true, 1
+	require.Error(t, manager.BuildAddressIndexIfMissing())
+
+	markerSet, err := store.Has(addressIndexPrefix)
+	require.NoError(t, err)
+	require.False(t, markerSet)
+
+	indexedOutputs, err := manager.OutputsAtSlot(10, utxoledger.FilterAddress(address))
+	require.NoError(t, err)
+	require.Len(t, indexedOutputs, utxoledger.AddressIndexBatchSize)
+
+	// the next call builds the complete index.
+	store.limitCommits = false
+	require.NoError(t, manager.BuildAddressIndexIfMissing())
+
+	markerSet, err = store.Has(addressIndexPrefix)
+	require.NoError(t, err)
+	require.True(t, markerSet)
+
+	requireOutputsOfAddressAtSlot(t, manager, address, 10, nil, outputs...)
+}
+
+func TestOwnerAddresses(t *testing.T) {
+	ownerAddress := iotago_tpkg.RandEd25519Address()
+	returnAddress := iotago_tpkg.RandEd25519Address()
+
+	output := &iotago.BasicOutput{
+		Amount: 1_000_000,
+		UnlockConditions: iotago.BasicOutputUnlockConditions{
+			&iotago.AddressUnlockCondition{Address: ownerAddress},
+			&iotago.StorageDepositReturnUnlockCondition{ReturnAddress: returnAddress, Amount: 500_000},
+			&iotago.ExpirationUnlockCondition{ReturnAddress: ownerAddress, Slot: 10},
+		},
+	}
+
+	// the return addresses are only considered as owners if requested.
+	require.Equal(t, []iotago.Address{ownerAddress}, utxoledger.OwnerAddresses(output))
+	require.Equal(t, map[iotago.UnlockConditionType]iotago.Address{
+		iotago.UnlockConditionAddress: ownerAddress,
+	}, utxoledger.UnlockConditionAddresses(output))
+
+	// the same address is only returned once, even if it is contained in several unlock conditions.
+	require.Equal(t, []iotago.Address{ownerAddress, returnAddress}, utxoledger.OwnerAddresses(output, utxoledger.WithReturnAddresses(true)))
+	require.Equal(t, map[iotago.UnlockConditionType]iotago.Address{
+		iotago.UnlockConditionAddress:              ownerAddress,
+		iotago.UnlockConditionStorageDepositReturn: returnAddress,
+		iotago.UnlockConditionExpiration:           ownerAddress,
+	}, utxoledger.UnlockConditionAddresses(output, utxoledger.WithReturnAddresses(true)))
+}
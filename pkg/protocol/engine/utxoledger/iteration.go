@@ -8,6 +8,8 @@ import (
 type IterateOptions struct {
 	readLockLedger bool
 	maxResultCount int
+	address        iotago.Address
+	startOutputID  iotago.OutputID
 }
 
 type IterateOption func(*IterateOptions)
@@ -18,6 +20,22 @@ func ReadLockLedger(lockLedger bool) IterateOption {
 	}
 }
 
+// FilterAddress only iterates over the outputs owned by the given address by using the address index.
+// It is only supported by the iteration over the historic ledger state.
+func FilterAddress(address iotago.Address) IterateOption {
+	return func(args *IterateOptions) {
+		args.address = address
+	}
+}
+
+// StartOutputID skips all outputs with an output ID lower than the given one.
+// It is only supported together with FilterAddress.
+func StartOutputID(outputID iotago.OutputID) IterateOption {
+	return func(args *IterateOptions) {
+		args.startOutputID = outputID
+	}
+}
+
 func iterateOptions(optionalOptions []IterateOption) *IterateOptions {
 	result := &IterateOptions{
 		readLockLedger: true,
@@ -53,7 +53,12 @@ func (m *Manager) ClearLedgerState() (err error) {
 		}
 	}()
 
-	return m.store.Clear()
+	if err := m.store.Clear(); err != nil {
+		return err
+	}
+
+	// the address index of an empty ledger is complete.
+	return m.store.Set(addressIndexMarkerKey, []byte{})
 }
 
 func (m *Manager) ReInitStateTreeWithLocking() {
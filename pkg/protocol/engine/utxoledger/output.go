@@ -249,10 +249,18 @@ func (o *Output) kvStorableLoad(_ *Manager, key []byte, value []byte) error {
 // - Helper
 
 func storeOutput(output *Output, mutations kvstore.BatchedMutations) error {
+	if err := storeAddressIndex(output, mutations); err != nil {
+		return err
+	}
+
 	return mutations.Set(output.KVStorableKey(), output.KVStorableValue())
 }
 
 func deleteOutput(output *Output, mutations kvstore.BatchedMutations) error {
+	if err := deleteAddressIndex(output, mutations); err != nil {
+		return err
+	}
+
 	return mutations.Delete(output.KVStorableKey())
 }
 
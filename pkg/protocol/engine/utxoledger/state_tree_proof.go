@@ -0,0 +1,135 @@
+package utxoledger
+
+import (
+	"crypto/sha256"
+
+	"github.com/pokt-network/smt"
+	smtkvstore "github.com/pokt-network/smt/kvstore"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/lo"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// stateTreeNodesPrefix is the realm in which the authenticated map of hive.go stores the nodes of its sparse merkle tree.
+const stateTreeNodesPrefix byte = 1
+
+// ErrOutputNotInStateTree is returned if an inclusion proof is requested for an output that is not part of the state tree.
+var ErrOutputNotInStateTree = ierrors.New("output is not part of the state tree")
+
+// StateTreeProof is a sparse merkle proof of the inclusion of an unspent output in the state tree of a ledger index.
+type StateTreeProof struct {
+	// Slot is the ledger index the state tree belongs to.
+	Slot iotago.SlotIndex
+	// StateRoot is the root of the state tree.
+	StateRoot iotago.Identifier
+	// OutputID is the key of the proven leaf.
+	OutputID iotago.OutputID
+	// Value is the value of the proven leaf.
+	Value []byte
+	// Proof is the sparse merkle proof of the leaf.
+	Proof *smt.SparseMerkleProof
+}
+
+// Verify checks that the proof anchors the output to the state root.
+func (p *StateTreeProof) Verify() (bool, error) {
+	spec := stateTreeSpec()
+
+	return smt.VerifyProof(p.Proof, p.StateRoot[:], lo.PanicOnErr(p.OutputID.Bytes()), p.Value, &spec)
+}
+
+// StateTreeProof creates an inclusion proof of the given unspent output against the state tree of the current ledger index.
+func (m *Manager) StateTreeProof(outputID iotago.OutputID) (*StateTreeProof, error) {
+	m.ReadLockLedger()
+	defer m.ReadUnlockLedger()
+
+	metadata, exists, err := m.stateTree.Get(outputID)
+	if err != nil {
+		return nil, ierrors.Wrapf(err, "failed to get output %s from state tree", outputID.ToHex())
+	}
+
+	if !exists {
+		return nil, ierrors.Wrapf(ErrOutputNotInStateTree, "output %s", outputID.ToHex())
+	}
+
+	value, err := metadata.Bytes()
+	if err != nil {
+		return nil, ierrors.Wrap(err, "failed to serialize state tree metadata")
+	}
+
+	ledgerIndex, err := m.ReadLedgerIndexWithoutLocking()
+	if err != nil {
+		return nil, err
+	}
+
+	stateRoot := m.stateTree.Root()
+
+	// the authenticated map doesn't expose proofs, so we open the committed nodes of its tree ourselves.
+	nodesStore, err := m.stateTreeKVStore.WithExtendedRealm(kvstore.Realm{stateTreeNodesPrefix})
+	if err != nil {
+		return nil, ierrors.Wrap(err, "failed to access state tree nodes")
+	}
+
+	tree := smt.ImportSparseMerkleTrie(&readOnlyMapStore{store: nodesStore}, sha256.New(), stateRoot[:], smt.WithValueHasher(nil))
+
+	proof, err := tree.Prove(lo.PanicOnErr(outputID.Bytes()))
+	if err != nil {
+		return nil, ierrors.Wrapf(err, "failed to create state tree proof for output %s", outputID.ToHex())
+	}
+
+	stateTreeProof := &StateTreeProof{
+		Slot:      ledgerIndex,
+		StateRoot: stateRoot,
+		OutputID:  outputID,
+		Value:     value,
+		Proof:     proof,
+	}
+
+	// make sure that we never hand out a proof that doesn't match the tree layout we assume above.
+	if valid, err := stateTreeProof.Verify(); err != nil || !valid {
+		return nil, ierrors.Errorf("created invalid state tree proof for output %s", outputID.ToHex())
+	}
+
+	return stateTreeProof, nil
+}
+
+func stateTreeSpec() smt.TrieSpec {
+	return smt.NewTrieSpec(sha256.New(), false, smt.WithValueHasher(nil))
+}
+
+// readOnlyMapStore exposes a KVStore as a read-only MapStore of the sparse merkle tree.
+type readOnlyMapStore struct {
+	store kvstore.KVStore
+}
+
+func (r *readOnlyMapStore) Get(key []byte) ([]byte, error) {
+	return r.store.Get(key)
+}
+
+func (r *readOnlyMapStore) Set(_ []byte, _ []byte) error {
+	return ierrors.New("state tree nodes are read-only")
+}
+
+func (r *readOnlyMapStore) Delete(_ []byte) error {
+	return ierrors.New("state tree nodes are read-only")
+}
+
+func (r *readOnlyMapStore) Len() int {
+	count := 0
+	if err := r.store.IterateKeys(kvstore.EmptyPrefix, func(_ kvstore.Key) bool {
+		count++
+
+		return true
+	}); err != nil {
+		return 0
+	}
+
+	return count
+}
+
+func (r *readOnlyMapStore) ClearAll() error {
+	return ierrors.New("state tree nodes are read-only")
+}
+
+var _ smtkvstore.MapStore = &readOnlyMapStore{}
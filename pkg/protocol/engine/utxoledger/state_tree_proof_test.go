@@ -0,0 +1,51 @@
+package utxoledger_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/utxoledger"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/utxoledger/tpkg"
+	iotago "github.com/iotaledger/iota.go/v4"
+	iotago_tpkg "github.com/iotaledger/iota.go/v4/tpkg"
+)
+
+func TestStateTreeProof(t *testing.T) {
+	manager := utxoledger.New(mapdb.NewMapDB(), iotago.SingleVersionProvider(iotago_tpkg.ZeroCostTestAPI))
+
+	outputs := utxoledger.Outputs{
+		tpkg.RandLedgerStateOutputWithType(iotago.OutputBasic),
+		tpkg.RandLedgerStateOutputWithType(iotago.OutputNFT),
+		tpkg.RandLedgerStateOutputWithType(iotago.OutputAccount),
+		tpkg.RandLedgerStateOutputWithType(iotago.OutputBasic),
+	}
+	require.NoError(t, lo.Return2(manager.ApplyDiff(5, outputs, nil)))
+
+	spent := tpkg.RandLedgerStateSpentWithOutput(outputs[3], 6)
+	require.NoError(t, lo.Return2(manager.ApplyDiff(6, utxoledger.Outputs{tpkg.RandLedgerStateOutputWithType(iotago.OutputBasic)}, utxoledger.Spents{spent})))
+
+	for _, output := range outputs[:3] {
+		proof, err := manager.StateTreeProof(output.OutputID())
+		require.NoError(t, err)
+
+		require.Equal(t, iotago.SlotIndex(6), proof.Slot)
+		require.Equal(t, manager.StateTreeRoot(), proof.StateRoot)
+		require.Equal(t, output.OutputID(), proof.OutputID)
+
+		valid, err := proof.Verify()
+		require.NoError(t, err)
+		require.True(t, valid)
+
+		// the proof must not be valid for a different state root.
+		proof.StateRoot = iotago_tpkg.RandIdentifier()
+		valid, err = proof.Verify()
+		require.NoError(t, err)
+		require.False(t, valid)
+	}
+
+	_, err := manager.StateTreeProof(spent.OutputID())
+	require.ErrorIs(t, err, utxoledger.ErrOutputNotInStateTree)
+}
@@ -281,6 +281,28 @@ func (e *Engines) injectEngineInstances() (shutdown func()) {
 	})
 }
 
+// MainEngineDirectory returns the directory of the main engine instance that is stored in the given base directory.
+func MainEngineDirectory(baseDirectory string) (string, error) {
+	directory := utils.NewDirectory(baseDirectory)
+
+	info := &engineInfo{}
+	if err := ioutils.ReadJSONFromFile(directory.Path(engineInfoFile), info); err != nil {
+		return "", ierrors.Wrap(err, "unable to read engine info file")
+	}
+
+	if len(info.Name) == 0 {
+		return "", ierrors.Errorf("no main engine found in engine info file (%s)", directory.Path(engineInfoFile))
+	}
+
+	if exists, isDirectory, err := ioutils.PathExists(directory.Path(info.Name)); err != nil {
+		return "", ierrors.Wrapf(err, "unable to check engine directory (%s)", directory.Path(info.Name))
+	} else if !exists || !isDirectory {
+		return "", ierrors.Errorf("engine directory (%s) does not exist", directory.Path(info.Name))
+	}
+
+	return directory.Path(info.Name), nil
+}
+
 // engineInfoFile is the name of the engine info file.
 const engineInfoFile = "info"
 
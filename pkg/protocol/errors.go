@@ -10,4 +10,13 @@ var (
 
 	// ErrorSlotEvicted is returned for requests for commitments that belong to evicted slots.
 	ErrorSlotEvicted = ierrors.New("slot evicted")
+
+	// ErrorInvalidAttestationsProof is returned for attestations that are not proven to be part of the commitment.
+	ErrorInvalidAttestationsProof = ierrors.New("invalid merkle proof for attestations")
+
+	// ErrorInvalidAttestationSignature is returned for attestations with a signature that does not match their content.
+	ErrorInvalidAttestationSignature = ierrors.New("invalid attestation signature")
+
+	// ErrorDuplicateAttestationIssuer is returned for sets of attestations that contain the same issuer more than once.
+	ErrorDuplicateAttestationIssuer = ierrors.New("duplicate attestation issuer")
 )
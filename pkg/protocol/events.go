@@ -5,6 +5,7 @@ import (
 
 	"github.com/iotaledger/hive.go/runtime/event"
 	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/network"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine"
 )
 
@@ -15,6 +16,7 @@ import (
 type Events struct {
 	Engine         *engine.Events
 	ProtocolFilter *event.Event1[*BlockFilteredEvent]
+	PeerMisbehaved *event.Event1[*PeerMisbehavedEvent]
 }
 
 // NewEvents creates a new Events instance.
@@ -22,6 +24,7 @@ func NewEvents() *Events {
 	return &Events{
 		Engine:         engine.NewEvents(),
 		ProtocolFilter: event.New1[*BlockFilteredEvent](),
+		PeerMisbehaved: event.New1[*PeerMisbehavedEvent](),
 	}
 }
 
@@ -30,3 +33,10 @@ type BlockFilteredEvent struct {
 	Reason error
 	Source peer.ID
 }
+
+// PeerMisbehavedEvent is triggered when a peer violated the protocol.
+type PeerMisbehavedEvent struct {
+	Peer        peer.ID
+	Misbehavior network.Misbehavior
+	Reason      error
+}
@@ -24,7 +24,7 @@ type Network struct {
 // newNetwork creates a new network protocol instance for the given protocol and network endpoint.
 func newNetwork(protocol *Protocol, networkEndpoint network.Endpoint) *Network {
 	n := &Network{
-		Protocol: core.NewProtocol(networkEndpoint, protocol.Workers.CreatePool("NetworkProtocol"), protocol),
+		Protocol: core.NewProtocol(networkEndpoint, protocol.Workers.CreatePool("NetworkProtocol"), protocol, protocol.Options.NetworkProtocolOptions...),
 		Logger:   protocol.NewChildLogger("Network"),
 		protocol: protocol,
 	}
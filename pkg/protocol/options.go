@@ -6,6 +6,8 @@ import (
 	"github.com/iotaledger/hive.go/core/eventticker"
 	"github.com/iotaledger/hive.go/runtime/module"
 	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/iota-core/pkg/core/requester"
+	"github.com/iotaledger/iota-core/pkg/network/protocols/core"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/attestation"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/attestation/slotattestation"
@@ -66,6 +68,12 @@ type Options struct {
 	// StorageOptions contains the options for the Storage.
 	StorageOptions []options.Option[storage.Storage]
 
+	// NetworkProtocolOptions contains the options for the core network protocol.
+	NetworkProtocolOptions []options.Option[core.Protocol]
+
+	// BlockRequesterOptions contains the options for the requester that decides which peers blocks are requested from.
+	BlockRequesterOptions []options.Option[requester.Requester[iotago.SlotIndex, iotago.BlockID]]
+
 	CommitmentRequesterOptions  []options.Option[eventticker.EventTicker[iotago.SlotIndex, iotago.CommitmentID]]
 	AttestationRequesterOptions []options.Option[eventticker.EventTicker[iotago.SlotIndex, iotago.CommitmentID]]
 	WarpSyncRequesterOptions    []options.Option[eventticker.EventTicker[iotago.SlotIndex, iotago.CommitmentID]]
@@ -319,6 +327,20 @@ func WithStorageOptions(opts ...options.Option[storage.Storage]) options.Option[
 	}
 }
 
+// WithNetworkProtocolOptions is an option for the Protocol that allows to set the options of the core network protocol.
+func WithNetworkProtocolOptions(opts ...options.Option[core.Protocol]) options.Option[Protocol] {
+	return func(p *Protocol) {
+		p.Options.NetworkProtocolOptions = append(p.Options.NetworkProtocolOptions, opts...)
+	}
+}
+
+// WithBlockRequesterOptions is an option for the Protocol that allows to set the options of the block requester.
+func WithBlockRequesterOptions(opts ...options.Option[requester.Requester[iotago.SlotIndex, iotago.BlockID]]) options.Option[Protocol] {
+	return func(p *Protocol) {
+		p.Options.BlockRequesterOptions = append(p.Options.BlockRequesterOptions, opts...)
+	}
+}
+
 func WithCommitmentRequesterOptions(opts ...options.Option[eventticker.EventTicker[iotago.SlotIndex, iotago.CommitmentID]]) options.Option[Protocol] {
 	return func(p *Protocol) {
 		p.Options.CommitmentRequesterOptions = append(p.Options.CommitmentRequesterOptions, opts...)
@@ -191,7 +191,18 @@ func (p *Protocol) initGlobalEventsRedirection() (shutdown func()) {
 // initNetwork initializes the network of the protocol and returns a function that shuts it down.
 func (p *Protocol) initNetwork() (shutdown func()) {
 	return lo.BatchReverse(
-		p.Network.OnError(func(err error, peer peer.ID) { p.LogError("network error", "peer", peer, "error", err) }),
+		p.Network.OnError(func(err error, peer peer.ID) {
+			p.LogError("network error", "peer", peer, "error", err)
+
+			// only violations that don't depend on the view of the node are reported, e.g. blocks
+			// of unknown protocol versions or issued by validators that are unknown to the node are not.
+			switch {
+			case ierrors.Is(err, network.ErrMalformedPacket):
+				p.reportMisbehavior(peer, network.MisbehaviorMalformedPacket, err)
+			case ierrors.Is(err, network.ErrInvalidBlock):
+				p.reportMisbehavior(peer, network.MisbehaviorInvalidBlock, err)
+			}
+		}),
 		p.Network.OnBlockReceived(p.Blocks.ProcessResponse),
 		p.Network.OnBlockRequestReceived(p.Blocks.ProcessRequest),
 		p.Network.OnCommitmentReceived(p.Commitments.processResponse),
@@ -203,6 +214,20 @@ func (p *Protocol) initNetwork() (shutdown func()) {
 	)
 }
 
+// reportMisbehavior triggers the PeerMisbehaved event for blocks and packets that were received from the network.
+func (p *Protocol) reportMisbehavior(source peer.ID, misbehavior network.Misbehavior, reason error) {
+	// blocks issued by the node itself have no source peer.
+	if source == "" || source == "self" {
+		return
+	}
+
+	p.Events.PeerMisbehaved.Trigger(&PeerMisbehavedEvent{
+		Peer:        source,
+		Misbehavior: misbehavior,
+		Reason:      reason,
+	})
+}
+
 // waitInitialized waits until the main engine is initialized (published its root commitment).
 func (p *Protocol) waitInitialized() {
 	var waitInitialized sync.WaitGroup
@@ -13,6 +13,7 @@ import (
 	"github.com/iotaledger/hive.go/kvstore/mapdb"
 	"github.com/iotaledger/hive.go/log"
 	"github.com/iotaledger/hive.go/runtime/workerpool"
+	"github.com/iotaledger/iota-core/pkg/network"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine"
 	iotago "github.com/iotaledger/iota.go/v4"
 	"github.com/iotaledger/iota.go/v4/merklehasher"
@@ -147,6 +148,8 @@ func (w *WarpSync) ProcessResponse(commitmentID iotago.CommitmentID, blockIDsByS
 			if !iotago.VerifyProof(proof, acceptedBlocks.Root(), commitment.RootsID()) {
 				w.LogError("failed to verify blocks proof", "commitment", commitment.LogName(), "blockIDs", blockIDsBySlotCommitment, "proof", proof, "fromPeer", from)
 
+				w.protocol.reportMisbehavior(from, network.MisbehaviorInvalidWarpSyncProof, ierrors.Errorf("failed to verify blocks proof for commitment %s", commitment.ID()))
+
 				return blocksToWarpSync
 			}
 
@@ -158,6 +161,8 @@ func (w *WarpSync) ProcessResponse(commitmentID iotago.CommitmentID, blockIDsByS
 			if !iotago.VerifyProof(mutationProof, acceptedTransactionIDs.Root(), commitment.RootsID()) {
 				w.LogError("failed to verify mutations proof", "commitment", commitment.ID(), commitment.Commitment.Commitment().String(), "acceptedTransactionIDsRoot", acceptedTransactionIDs.Root(), "transactionIDs len()", len(transactionIDs), "proof", mutationProof, "fromPeer", from)
 
+				w.protocol.reportMisbehavior(from, network.MisbehaviorInvalidWarpSyncProof, ierrors.Errorf("failed to verify mutations proof for commitment %s", commitment.ID()))
+
 				return blocksToWarpSync
 			}
 
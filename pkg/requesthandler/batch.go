@@ -0,0 +1,51 @@
+package requesthandler
+
+import (
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/api"
+)
+
+// BatchResult is the result of a single lookup of a batch query, which either contains the result or the error of the lookup.
+type BatchResult[T any] struct {
+	Result T
+	Err    error
+}
+
+// batchLookup executes the lookup for every ID, a failed lookup doesn't abort the remaining ones.
+func batchLookup[ID any, T any](ids []ID, lookup func(ID) (T, error)) []*BatchResult[T] {
+	results := make([]*BatchResult[T], len(ids))
+	for i, id := range ids {
+		result, err := lookup(id)
+		results[i] = &BatchResult[T]{
+			Result: result,
+			Err:    err,
+		}
+	}
+
+	return results
+}
+
+// BlocksFromBlockIDs returns the blocks of the given block IDs, in the order of the IDs.
+func (r *RequestHandler) BlocksFromBlockIDs(blockIDs []iotago.BlockID) []*BatchResult[*iotago.Block] {
+	return batchLookup(blockIDs, r.BlockFromBlockID)
+}
+
+// BlockMetadataFromBlockIDs returns the metadata of the blocks of the given block IDs, in the order of the IDs.
+func (r *RequestHandler) BlockMetadataFromBlockIDs(blockIDs []iotago.BlockID) []*BatchResult[*api.BlockMetadataResponse] {
+	return batchLookup(blockIDs, r.BlockMetadataFromBlockID)
+}
+
+// OutputsFromOutputIDs returns the outputs of the given output IDs, in the order of the IDs.
+func (r *RequestHandler) OutputsFromOutputIDs(outputIDs []iotago.OutputID) []*BatchResult[*api.OutputResponse] {
+	return batchLookup(outputIDs, r.OutputFromOutputID)
+}
+
+// OutputMetadataFromOutputIDs returns the metadata of the outputs of the given output IDs, in the order of the IDs.
+func (r *RequestHandler) OutputMetadataFromOutputIDs(outputIDs []iotago.OutputID) []*BatchResult[*api.OutputMetadata] {
+	return batchLookup(outputIDs, r.OutputMetadataFromOutputID)
+}
+
+// TransactionMetadataFromTransactionIDs returns the metadata of the transactions of the given transaction IDs, in the order of the IDs.
+func (r *RequestHandler) TransactionMetadataFromTransactionIDs(txIDs []iotago.TransactionID) []*BatchResult[*api.TransactionMetadataResponse] {
+	return batchLookup(txIDs, r.TransactionMetadataFromTransactionID)
+}
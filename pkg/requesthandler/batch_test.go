@@ -0,0 +1,64 @@
+package requesthandler_test
+
+import (
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/iota-core/pkg/testsuite"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func Test_BatchQueries(t *testing.T) {
+	ts := testsuite.NewTestSuite(t,
+		testsuite.WithProtocolParametersOptions(
+			iotago.WithTimeProviderOptions(
+				0,
+				testsuite.GenesisTimeWithOffsetBySlots(100, testsuite.DefaultSlotDurationInSeconds),
+				testsuite.DefaultSlotDurationInSeconds,
+				4,
+			),
+			iotago.WithLivenessOptions(
+				10,
+				10,
+				2,
+				4,
+				5,
+			),
+		),
+	)
+	defer ts.Shutdown()
+
+	node := ts.AddValidatorNode("node1")
+	ts.Run(true)
+
+	issuedBlocks, _ := ts.IssueBlocksAtSlots("wave:", []iotago.SlotIndex{1, 2}, 1, "Genesis", ts.Nodes(), true, false)
+	require.Len(t, issuedBlocks, 2)
+
+	block1 := issuedBlocks[0]
+	block2 := issuedBlocks[1]
+	unknownBlockID := iotago.BlockIDRepresentingData(2, []byte("unknown"))
+
+	// the results are in the order of the IDs and a missing block doesn't fail the other lookups.
+	blockIDs := []iotago.BlockID{block2.ID(), unknownBlockID, block1.ID()}
+
+	blockResults := node.RequestHandler.BlocksFromBlockIDs(blockIDs)
+	require.Len(t, blockResults, len(blockIDs))
+	require.NoError(t, blockResults[0].Err)
+	require.Equal(t, block2.ID(), lo.PanicOnErr(blockResults[0].Result.ID()))
+	require.ErrorIs(t, blockResults[1].Err, echo.ErrNotFound)
+	require.Nil(t, blockResults[1].Result)
+	require.NoError(t, blockResults[2].Err)
+	require.Equal(t, block1.ID(), lo.PanicOnErr(blockResults[2].Result.ID()))
+
+	metadataResults := node.RequestHandler.BlockMetadataFromBlockIDs(blockIDs)
+	require.Len(t, metadataResults, len(blockIDs))
+	require.NoError(t, metadataResults[0].Err)
+	require.Equal(t, block2.ID(), metadataResults[0].Result.BlockID)
+	require.ErrorIs(t, metadataResults[1].Err, echo.ErrNotFound)
+	require.Nil(t, metadataResults[1].Result)
+	require.NoError(t, metadataResults[2].Err)
+	require.Equal(t, block1.ID(), metadataResults[2].Result.BlockID)
+}
@@ -0,0 +1,62 @@
+package requesthandler
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/utxoledger"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// UnspentOutputsByAddressAtSlot returns up to pageSize outputs that were owned by the given address and unspent after the given slot was committed,
+// starting at the given cursor. The returned cursor is the ID of the next output or nil if there are no more outputs.
+func (r *RequestHandler) UnspentOutputsByAddressAtSlot(address iotago.Address, slot iotago.SlotIndex, cursor iotago.OutputID, pageSize uint32) (outputs utxoledger.Outputs, nextCursor *iotago.OutputID, err error) {
+	if err := r.protocol.Engines.Main.Get().Ledger.ForEachUnspentOutputAtSlot(slot, func(output *utxoledger.Output) bool {
+		if uint32(len(outputs)) == pageSize {
+			outputID := output.OutputID()
+			nextCursor = &outputID
+
+			return false
+		}
+
+		outputs = append(outputs, output)
+
+		return true
+	}, utxoledger.FilterAddress(address), utxoledger.StartOutputID(cursor)); err != nil {
+		return nil, nil, historicLedgerError(err, slot)
+	}
+
+	return outputs, nextCursor, nil
+}
+
+// BalanceByAddressAtSlot returns the sum of the base tokens and stored mana of the outputs that were owned by the given address
+// and unspent after the given slot was committed.
+func (r *RequestHandler) BalanceByAddressAtSlot(address iotago.Address, slot iotago.SlotIndex) (iotago.BaseToken, iotago.Mana, error) {
+	balance, storedMana, _, err := r.protocol.Engines.Main.Get().Ledger.ComputeLedgerBalanceAtSlot(slot, utxoledger.FilterAddress(address))
+	if err != nil {
+		return 0, 0, historicLedgerError(err, slot)
+	}
+
+	return balance, storedMana, nil
+}
+
+// IsOutputUnspentAtSlot returns whether the given output existed and was unspent after the given slot was committed.
+func (r *RequestHandler) IsOutputUnspentAtSlot(outputID iotago.OutputID, slot iotago.SlotIndex) (bool, error) {
+	unspent, err := r.protocol.Engines.Main.Get().Ledger.IsUnspentAtSlot(outputID, slot)
+	if err != nil {
+		return false, historicLedgerError(err, slot)
+	}
+
+	return unspent, nil
+}
+
+func historicLedgerError(err error, slot iotago.SlotIndex) error {
+	switch {
+	case ierrors.Is(err, utxoledger.ErrSlotNotCommitted):
+		return ierrors.WithMessagef(echo.ErrNotFound, "ledger state of slot %d is not available yet: %w", slot, err)
+	case ierrors.Is(err, utxoledger.ErrSlotPruned):
+		return ierrors.WithMessagef(echo.ErrNotFound, "ledger state of slot %d is not available anymore: %w", slot, err)
+	default:
+		return ierrors.WithMessagef(echo.ErrInternalServerError, "failed to reconstruct ledger state of slot %d: %w", slot, err)
+	}
+}
@@ -5,9 +5,11 @@ import (
 
 	"github.com/iotaledger/hive.go/ierrors"
 	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/iota-core/pkg/model"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/utxoledger"
 	iotago "github.com/iotaledger/iota.go/v4"
 	"github.com/iotaledger/iota.go/v4/api"
+	"github.com/iotaledger/iota.go/v4/merklehasher"
 )
 
 func (r *RequestHandler) OutputFromOutputID(outputID iotago.OutputID) (*api.OutputResponse, error) {
@@ -131,3 +133,86 @@ func (r *RequestHandler) newSpentMetadataResponse(spent *utxoledger.Spent) (*api
 
 	return newOutputMetadataResponse, nil
 }
+
+// OutputProof contains the proofs that anchor an unspent output to a commitment.
+type OutputProof struct {
+	// Output is the proven output, which contains the proof of its output ID.
+	Output *utxoledger.Output
+	// StateTreeProof proves the inclusion of the output ID in the state root.
+	StateTreeProof *utxoledger.StateTreeProof
+	// StateRootProof proves the inclusion of the state root in the roots of the commitment.
+	StateRootProof *merklehasher.Proof[iotago.Identifier]
+	// Commitment is the commitment the proofs are anchored to.
+	Commitment *model.Commitment
+}
+
+// errStateRootMismatch is returned if the state tree proof doesn't match the state root of the commitment.
+var errStateRootMismatch = ierrors.New("state root of commitment does not match the state tree")
+
+// outputProofAttempts is the number of times an output proof is created before giving up
+// because the ledger kept advancing while the proofs were collected.
+const outputProofAttempts = 3
+
+// OutputProof returns the proofs that anchor the given unspent output to the latest commitment.
+func (r *RequestHandler) OutputProof(outputID iotago.OutputID) (*OutputProof, error) {
+	// the state tree proof and the commitment are read separately, so the ledger might advance in between.
+	for attempt := 1; ; attempt++ {
+		outputProof, err := r.outputProof(outputID)
+		if !ierrors.Is(err, errStateRootMismatch) {
+			return outputProof, err
+		}
+
+		if attempt == outputProofAttempts {
+			return nil, ierrors.WithMessagef(echo.ErrServiceUnavailable, "failed to create consistent proofs for output %s: %s", outputID.ToHex(), err)
+		}
+	}
+}
+
+func (r *RequestHandler) outputProof(outputID iotago.OutputID) (*OutputProof, error) {
+	engineInstance := r.protocol.Engines.Main.Get()
+
+	stateTreeProof, err := engineInstance.Ledger.StateTreeProof(outputID)
+	if err != nil {
+		if ierrors.Is(err, utxoledger.ErrOutputNotInStateTree) {
+			return nil, ierrors.WithMessagef(echo.ErrNotFound, "output %s is not unspent in the Ledger", outputID.ToHex())
+		}
+
+		return nil, ierrors.WithMessagef(echo.ErrInternalServerError, "failed to create state tree proof for output %s: %w", outputID.ToHex(), err)
+	}
+
+	output, err := engineInstance.Ledger.Output(outputID)
+	if err != nil {
+		return nil, ierrors.WithMessagef(echo.ErrInternalServerError, "failed to get output %s from the Ledger: %w", outputID.ToHex(), err)
+	}
+
+	// the ledger is updated before the commitment of the slot is stored.
+	commitment, err := engineInstance.Storage.Commitments().Load(stateTreeProof.Slot)
+	if err != nil {
+		if ierrors.Is(err, kvstore.ErrKeyNotFound) {
+			return nil, ierrors.WithMessagef(echo.ErrServiceUnavailable, "commitment of slot %d is not stored yet", stateTreeProof.Slot)
+		}
+
+		return nil, ierrors.WithMessagef(echo.ErrInternalServerError, "failed to load commitment, slot: %d, error: %w", stateTreeProof.Slot, err)
+	}
+
+	commitmentAPI, err := engineInstance.CommitmentAPI(commitment.ID())
+	if err != nil {
+		return nil, ierrors.WithMessagef(echo.ErrServiceUnavailable, "failed to access commitment %s: %w", commitment.ID(), err)
+	}
+
+	_, stateRoot, stateRootProof, err := commitmentAPI.StateRootProof()
+	if err != nil {
+		return nil, ierrors.WithMessagef(echo.ErrInternalServerError, "failed to create state root proof for commitment %s: %w", commitment.ID(), err)
+	}
+
+	if stateRoot != stateTreeProof.StateRoot {
+		return nil, ierrors.Wrapf(errStateRootMismatch, "commitment %s (%s != %s)", commitment.ID(), stateRoot, stateTreeProof.StateRoot)
+	}
+
+	return &OutputProof{
+		Output:         output,
+		StateTreeProof: stateTreeProof,
+		StateRootProof: stateRootProof,
+		Commitment:     commitment,
+	}, nil
+}
@@ -0,0 +1,60 @@
+package restapi
+
+import (
+	"regexp"
+
+	"github.com/iotaledger/hive.go/ierrors"
+)
+
+// ScopeRule defines the scopes of which at least one is needed to call the routes matching the rule with a scoped JWT.
+type ScopeRule struct {
+	// Method is the HTTP method of the routes, all methods are matched if it is empty.
+	Method string
+	// Route is the route, wildcards using * are allowed.
+	Route string
+	// Scopes are the scopes that grant access to the routes, all JWTs are allowed if it is empty.
+	Scopes []string
+}
+
+type compiledScopeRule struct {
+	*ScopeRule
+
+	routeRegEx *regexp.Regexp
+}
+
+// ScopeRules determines the scopes that are needed to call a route, the first matching rule wins.
+type ScopeRules struct {
+	rules []*compiledScopeRule
+}
+
+// NewScopeRules creates new ScopeRules from the given rules.
+func NewScopeRules(rules ...*ScopeRule) (*ScopeRules, error) {
+	compiledRules := make([]*compiledScopeRule, len(rules))
+	for i, rule := range rules {
+		routeRegEx := CompileRouteAsRegex(rule.Route)
+		if routeRegEx == nil {
+			return nil, ierrors.Errorf("invalid route in scope rule: %s", rule.Route)
+		}
+
+		compiledRules[i] = &compiledScopeRule{
+			ScopeRule:  rule,
+			routeRegEx: routeRegEx,
+		}
+	}
+
+	return &ScopeRules{
+		rules: compiledRules,
+	}, nil
+}
+
+// Scopes returns the scopes of the first rule that matches the method and the route.
+// It returns false if no rule matches, in which case the route can't be called with a scoped JWT.
+func (s *ScopeRules) Scopes(method string, route string) ([]string, bool) {
+	for _, rule := range s.rules {
+		if (rule.Method == "" || rule.Method == method) && rule.routeRegEx.MatchString(route) {
+			return rule.Scopes, true
+		}
+	}
+
+	return nil, false
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/iotaledger/hive.go/runtime/event"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
 	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/api"
 )
 
 // BlockRetainerEvents is a collection of Retainer related BlockRetainerEvents.
@@ -34,6 +35,8 @@ var NewBlockRetainerEvents = event.CreateGroupConstructor(func() (newEvents *Blo
 type TransactionRetainerEvents struct {
 	// TransactionRetained is triggered when a transaction is stored in the retainer.
 	TransactionRetained *event.Event1[iotago.TransactionID]
+	// TransactionStateChanged is triggered when a retained transaction was accepted, committed, finalized or failed.
+	TransactionStateChanged *event.Event1[*api.TransactionMetadataResponse]
 
 	event.Group[TransactionRetainerEvents, *TransactionRetainerEvents]
 }
@@ -41,6 +44,7 @@ type TransactionRetainerEvents struct {
 // NewTransactionRetainerEvents contains the constructor of the Events object (it is generated by a generic factory).
 var NewTransactionRetainerEvents = event.CreateGroupConstructor(func() (newEvents *TransactionRetainerEvents) {
 	return &TransactionRetainerEvents{
-		TransactionRetained: event.New1[iotago.TransactionID](),
+		TransactionRetained:     event.New1[iotago.TransactionID](),
+		TransactionStateChanged: event.New1[*api.TransactionMetadataResponse](),
 	}
 })
@@ -99,6 +99,9 @@ type TransactionRetainer struct {
 
 	txRetainerCache *transactionRetainerCache
 
+	// lastFinalizedSlot is the last slot for which the finalization of the accepted transactions was announced.
+	lastFinalizedSlot iotago.SlotIndex
+
 	storeDebugErrorMessages bool
 
 	module.Module
@@ -147,6 +150,9 @@ func NewProvider(opts ...options.Option[TransactionRetainer]) module.Provider[*e
 		asyncOpt := event.WithWorkerPool(r.workerPool)
 
 		e.ConstructedEvent().OnTrigger(func() {
+			// the transactions of slots that were finalized before the engine was started were already announced.
+			r.lastFinalizedSlot = r.finalizedSlotFunc()
+
 			// attaching the transaction failed for some reason => store the error
 			// HINT: we treat the transaction as unsigned here, because we don't know if it was signed or not.
 			// This should not be a problem, because the error reason will still be stored and visible to the user,
@@ -240,8 +246,20 @@ func NewProvider(opts ...options.Option[TransactionRetainer]) module.Provider[*e
 				}
 			}, asyncOpt)
 
+			// this event is fired when a slot was finalized
+			e.Events.SlotGadget.SlotFinalized.Hook(func(slot iotago.SlotIndex) {
+				if err := r.FinalizeSlot(slot); err != nil {
+					r.errorHandler(err)
+				}
+			}, asyncOpt)
+
 			// this event is fired when the storage successfully pruned an epoch
 			e.Storage.Pruned.Hook(func(epoch iotago.EpochIndex) {
+				// an archive node keeps the transaction metadata of pruned epochs.
+				if e.Storage.IsArchive() {
+					return
+				}
+
 				epochEndSlot := e.CommittedAPI().TimeProvider().EpochEnd(epoch)
 
 				// pruning should be done until and including the last slot of the pruned epoch
@@ -251,6 +269,7 @@ func NewProvider(opts ...options.Option[TransactionRetainer]) module.Provider[*e
 			}, asyncOpt)
 
 			e.Events.TransactionRetainer.TransactionRetained.LinkTo(r.events.TransactionRetained)
+			e.Events.TransactionRetainer.TransactionStateChanged.LinkTo(r.events.TransactionStateChanged)
 
 			r.InitializedEvent().Trigger()
 		})
@@ -259,6 +278,11 @@ func NewProvider(opts ...options.Option[TransactionRetainer]) module.Provider[*e
 	})
 }
 
+// Events returns the events of the TransactionRetainer.
+func (r *TransactionRetainer) Events() *retainer.TransactionRetainerEvents {
+	return r.events
+}
+
 // Reset resets the component to a clean state as if it was created at the last commitment.
 func (r *TransactionRetainer) Reset(targetSlot iotago.SlotIndex) {
 	// In the TransactionRetainer, we rely on the fact that "Reset" is always called
@@ -300,6 +324,55 @@ func (r *TransactionRetainer) CommitSlot(slot iotago.SlotIndex) error {
 		return ierrors.Wrapf(err, "failed to commit slot: %d", slot)
 	}
 
+	// the accepted transactions of the slot are committed now.
+	// if the slot was already finalized before it was committed, they are announced as finalized right away,
+	// because the finalization of the slot didn't find them in the database.
+	committedState := api.TransactionStateCommitted
+	if slot <= r.lastFinalizedSlot {
+		committedState = api.TransactionStateFinalized
+	}
+
+	for txID, txMeta := range uncommittedChanges {
+		if txMeta.State != byte(api.TransactionStateAccepted) {
+			continue
+		}
+
+		response := newTransactionMetadataResponse(txID, txMeta)
+		response.TransactionState = committedState
+
+		r.events.TransactionStateChanged.Trigger(response)
+	}
+
+	return nil
+}
+
+// FinalizeSlot announces that all accepted transactions up to the given slot were finalized.
+func (r *TransactionRetainer) FinalizeSlot(slot iotago.SlotIndex) error {
+	if slot <= r.lastFinalizedSlot {
+		return nil
+	}
+
+	acceptedTxMetas, err := r.txRetainerDatabase.AcceptedTransactionMetadataBySlotRange(r.lastFinalizedSlot, slot)
+	if err != nil {
+		return ierrors.Wrapf(err, "failed to finalize slot: %d", slot)
+	}
+	r.lastFinalizedSlot = slot
+
+	// there can be several entries for the same transaction ID in the database, but it is only announced once.
+	finalizedTxIDs := make(map[iotago.TransactionID]struct{}, len(acceptedTxMetas))
+	for _, txMeta := range acceptedTxMetas {
+		txID := iotago.TransactionID(txMeta.TransactionID)
+		if _, exists := finalizedTxIDs[txID]; exists {
+			continue
+		}
+		finalizedTxIDs[txID] = struct{}{}
+
+		response := newTransactionMetadataResponse(txID, txMeta)
+		response.TransactionState = api.TransactionStateFinalized
+
+		r.events.TransactionStateChanged.Trigger(response)
+	}
+
 	return nil
 }
 
@@ -326,9 +399,37 @@ func (r *TransactionRetainer) UpdateTransactionMetadata(txID iotago.TransactionI
 		ErrorMsg:               txErrorMsg,
 	}
 
+	// the state before the update is only needed to detect if an accepted or failed transaction needs to be announced.
+	var previousState *api.TransactionState
+	if state == api.TransactionStateAccepted || state == api.TransactionStateFailed {
+		if previousResponse, err := r.TransactionMetadata(txID); err == nil {
+			previousState = &previousResponse.TransactionState
+		}
+	}
+
 	if err := r.txRetainerCache.UpdateTxMetadata(txMeta); err != nil {
 		r.errorHandler(err)
+
+		return
+	}
+
+	if state != api.TransactionStateAccepted && state != api.TransactionStateFailed {
+		return
+	}
+
+	response, err := r.TransactionMetadata(txID)
+	if err != nil {
+		r.errorHandler(err)
+
+		return
+	}
+
+	// the update might not have changed the state, e.g. if an accepted transaction has another failed attachment.
+	if response.TransactionState == api.TransactionStatePending || (previousState != nil && *previousState == response.TransactionState) {
+		return
 	}
+
+	r.events.TransactionStateChanged.Trigger(response)
 }
 
 // TransactionMetadata returns the metadata of a transaction.
@@ -358,18 +459,7 @@ func (r *TransactionRetainer) TransactionMetadata(txID iotago.TransactionID) (*a
 		return nil, ErrEntryNotFound
 	}
 
-	txFailureDetails := ""
-	if txMeta.ErrorMsg != nil {
-		txFailureDetails = *txMeta.ErrorMsg
-	}
-
-	response := &api.TransactionMetadataResponse{
-		TransactionID:             txID,
-		TransactionState:          api.TransactionState(txMeta.State),
-		EarliestAttachmentSlot:    txMeta.EarliestAttachmentSlot,
-		TransactionFailureReason:  api.TransactionFailureReason(txMeta.FailureReason),
-		TransactionFailureDetails: txFailureDetails,
-	}
+	response := newTransactionMetadataResponse(txID, txMeta)
 
 	// for TransactionStateConfirmed and TransactionStateFinalized we need to check if
 	// the slot of the earliest attachment is already confirmed or finalized
@@ -385,6 +475,22 @@ func (r *TransactionRetainer) TransactionMetadata(txID iotago.TransactionID) (*a
 	return response, nil
 }
 
+// newTransactionMetadataResponse creates the API response of the stored metadata of a transaction.
+func newTransactionMetadataResponse(txID iotago.TransactionID, txMeta *TransactionMetadata) *api.TransactionMetadataResponse {
+	txFailureDetails := ""
+	if txMeta.ErrorMsg != nil {
+		txFailureDetails = *txMeta.ErrorMsg
+	}
+
+	return &api.TransactionMetadataResponse{
+		TransactionID:             txID,
+		TransactionState:          api.TransactionState(txMeta.State),
+		EarliestAttachmentSlot:    txMeta.EarliestAttachmentSlot,
+		TransactionFailureReason:  api.TransactionFailureReason(txMeta.FailureReason),
+		TransactionFailureDetails: txFailureDetails,
+	}
+}
+
 // Shutdown shuts down the TransactionRetainer.
 func (r *TransactionRetainer) shutdown() {
 	r.workerPool.Shutdown()
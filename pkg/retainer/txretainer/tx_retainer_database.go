@@ -129,3 +129,19 @@ func (r *transactionRetainerDatabase) TransactionMetadataByID(transactionID iota
 
 	return txMeta, nil
 }
+
+// AcceptedTransactionMetadataBySlotRange returns the metadata of all accepted transactions where the block slot of the
+// earliest attachment is greater than startSlot and smaller or equal endSlot.
+func (r *transactionRetainerDatabase) AcceptedTransactionMetadataBySlotRange(startSlot iotago.SlotIndex, endSlot iotago.SlotIndex) ([]*TransactionMetadata, error) {
+	var txMetas []*TransactionMetadata
+
+	if err := r.dbExecFunc(func(dbTx *gorm.DB) error {
+		return dbTx.Where("state = ? AND earliest_attachment_slot > ? AND earliest_attachment_slot <= ?", byte(api.TransactionStateAccepted), startSlot, endSlot).
+			Order("earliest_attachment_slot ASC").
+			Find(&txMetas).Error
+	}); err != nil {
+		return nil, ierrors.Wrapf(err, "failed to query accepted transaction metadata for slots %d to %d", startSlot+1, endSlot)
+	}
+
+	return txMetas, nil
+}
@@ -444,3 +444,75 @@ func TestTransactionRetainer_ResetAndPruning(t *testing.T) {
 		test.Run(t, tr)
 	}
 }
+
+func TestTransactionRetainer_StateChanged(t *testing.T) {
+	ts := newTestSuite(t)
+	defer ts.Close()
+
+	tr := ts.TxRetainer
+
+	var stateChanges []*api.TransactionMetadataResponse
+	tr.Events().TransactionStateChanged.Hook(func(response *api.TransactionMetadataResponse) {
+		stateChanges = append(stateChanges, response)
+	})
+
+	requireStateChanges := func(expectedStates ...api.TransactionState) {
+		t.Helper()
+
+		states := make([]api.TransactionState, 0, len(stateChanges))
+		for _, stateChange := range stateChanges {
+			states = append(states, stateChange.TransactionState)
+		}
+		require.ElementsMatch(t, expectedStates, states)
+
+		stateChanges = nil
+	}
+
+	acceptedTxID := tpkg.RandTransactionID()
+	failedTxID := tpkg.RandTransactionID()
+
+	// pending transactions are not announced
+	tr.UpdateTransactionMetadata(acceptedTxID, false, 10, api.TransactionStatePending, nil)
+	tr.UpdateTransactionMetadata(failedTxID, false, 11, api.TransactionStatePending, nil)
+	requireStateChanges()
+
+	tr.UpdateTransactionMetadata(acceptedTxID, true, 10, api.TransactionStateAccepted, nil)
+	tr.UpdateTransactionMetadata(failedTxID, true, 11, api.TransactionStateFailed, iotago.ErrInputAlreadySpent)
+	requireStateChanges(api.TransactionStateAccepted, api.TransactionStateFailed)
+
+	// updates that don't change the state are not announced
+	tr.UpdateTransactionMetadata(acceptedTxID, true, 10, api.TransactionStateAccepted, nil)
+	tr.UpdateTransactionMetadata(failedTxID, true, 11, api.TransactionStateFailed, iotago.ErrInputAlreadySpent)
+	requireStateChanges()
+
+	// only accepted transactions are announced as committed
+	ts.SetLatestCommittedSlot(11)
+	require.Len(t, stateChanges, 1)
+	require.Equal(t, acceptedTxID, stateChanges[0].TransactionID)
+	requireStateChanges(api.TransactionStateCommitted)
+
+	ts.SetFinalizedSlot(9)
+	require.NoError(t, tr.FinalizeSlot(9))
+	requireStateChanges()
+
+	ts.SetFinalizedSlot(11)
+	require.NoError(t, tr.FinalizeSlot(11))
+	require.Len(t, stateChanges, 1)
+	require.Equal(t, acceptedTxID, stateChanges[0].TransactionID)
+	requireStateChanges(api.TransactionStateFinalized)
+
+	// finalizing the same slot again doesn't announce the transactions twice
+	require.NoError(t, tr.FinalizeSlot(11))
+	requireStateChanges()
+
+	// transactions of slots that were finalized before they were committed are announced as finalized right away
+	lateTxID := tpkg.RandTransactionID()
+	tr.UpdateTransactionMetadata(lateTxID, true, 12, api.TransactionStateAccepted, nil)
+	requireStateChanges(api.TransactionStateAccepted)
+
+	require.NoError(t, tr.FinalizeSlot(12))
+	requireStateChanges()
+
+	ts.SetLatestCommittedSlot(12)
+	requireStateChanges(api.TransactionStateFinalized)
+}
@@ -9,6 +9,7 @@ type Config struct {
 	Directory    string
 	Version      byte
 	PrefixHealth []byte
+	ReadOnly     bool
 }
 
 func (c Config) WithDirectory(directory string) Config {
@@ -72,3 +72,33 @@ func StoreWithDefaultSettings(path string, createDatabaseIfNotExists bool, dbEng
 		return nil, ierrors.Errorf("unknown database engine: %s, supported engines: pebble/rocksdb/mapdb", dbEngine)
 	}
 }
+
+// StoreReadOnly returns a read-only kvstore of an existing database.
+// It also checks if the database engine is correct.
+func StoreReadOnly(path string, dbEngine hivedb.Engine, allowedEngines ...hivedb.Engine) (kvstore.KVStore, error) {
+	tmpAllowedEngines := AllowedEnginesDefault
+	if len(allowedEngines) > 0 {
+		tmpAllowedEngines = allowedEngines
+	}
+
+	targetEngine, err := CheckEngine(path, false, dbEngine, tmpAllowedEngines...)
+	if err != nil {
+		return nil, err
+	}
+
+	switch targetEngine {
+	case hivedb.EngineRocksDB:
+		db, err := NewRocksDBReadOnly(path)
+		if err != nil {
+			return nil, err
+		}
+
+		return rocksdb.New(db), nil
+
+	case hivedb.EngineMapDB:
+		return nil, ierrors.New("in-memory database engine mapdb can't be opened in read-only mode")
+
+	default:
+		return nil, ierrors.Errorf("unknown database engine: %s, supported engines: rocksdb", dbEngine)
+	}
+}
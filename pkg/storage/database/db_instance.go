@@ -17,10 +17,16 @@ type DBInstance struct {
 	isShutdown    atomic.Bool
 }
 
+// NewDBInstance opens the database with the given config and panics if it can't be opened.
 func NewDBInstance(dbConfig Config, openedCallback func(d *DBInstance)) *DBInstance {
-	db, err := StoreWithDefaultSettings(dbConfig.Directory, true, dbConfig.Engine)
+	return lo.PanicOnErr(OpenDBInstance(dbConfig, openedCallback))
+}
+
+// OpenDBInstance opens the database with the given config and returns an error if it can't be opened (e.g. because it is corrupted).
+func OpenDBInstance(dbConfig Config, openedCallback func(d *DBInstance)) (*DBInstance, error) {
+	db, err := openStore(dbConfig, true)
 	if err != nil {
-		panic(err)
+		return nil, ierrors.Wrapf(err, "failed to open database in %s", dbConfig.Directory)
 	}
 
 	dbInstance := &DBInstance{
@@ -61,15 +67,32 @@ func NewDBInstance(dbConfig Config, openedCallback func(d *DBInstance)) *DBInsta
 	//  that's why it needs to use openableKVStore (which does not lock) instead of lockableKVStore to avoid a deadlock.
 	storeHealthTracker, err := kvstore.NewStoreHealthTracker(lockableKVStore.openableKVStore, dbConfig.PrefixHealth, dbConfig.Version, nil)
 	if err != nil {
-		panic(ierrors.Wrapf(err, "database in %s is corrupted, delete database and resync node", dbConfig.Directory))
+		_ = db.Close()
+
+		return nil, ierrors.Wrapf(err, "database in %s is corrupted, delete database and resync node", dbConfig.Directory)
 	}
-	if err = storeHealthTracker.MarkCorrupted(); err != nil {
-		panic(err)
+
+	// a read-only database is never modified, so its health status stays untouched.
+	if !dbConfig.ReadOnly {
+		if err = storeHealthTracker.MarkCorrupted(); err != nil {
+			_ = db.Close()
+
+			return nil, ierrors.Wrapf(err, "failed to mark database in %s as corrupted", dbConfig.Directory)
+		}
 	}
 
 	dbInstance.healthTracker = storeHealthTracker
 
-	return dbInstance
+	return dbInstance, nil
+}
+
+// openStore opens the underlying store of the given database config, either read-only or writable.
+func openStore(dbConfig Config, createDatabaseIfNotExists bool) (kvstore.KVStore, error) {
+	if dbConfig.ReadOnly {
+		return StoreReadOnly(dbConfig.Directory, dbConfig.Engine)
+	}
+
+	return StoreWithDefaultSettings(dbConfig.Directory, createDatabaseIfNotExists, dbConfig.Engine)
 }
 
 func (d *DBInstance) Shutdown() {
@@ -98,12 +121,14 @@ func (d *DBInstance) Close() {
 
 func (d *DBInstance) CloseWithoutLocking() {
 	if !d.isClosed.Load() {
-		if err := d.healthTracker.MarkHealthy(); err != nil {
-			panic(err)
-		}
+		if !d.dbConfig.ReadOnly {
+			if err := d.healthTracker.MarkHealthy(); err != nil {
+				panic(err)
+			}
 
-		if err := d.store.topParent().storeInstance.Flush(); err != nil {
-			panic(err)
+			if err := d.store.topParent().storeInstance.Flush(); err != nil {
+				panic(err)
+			}
 		}
 
 		if err := d.store.topParent().storeInstance.Close(); err != nil {
@@ -125,13 +150,15 @@ func (d *DBInstance) Open() error {
 		return ErrDatabaseShutdown
 	}
 
-	d.store.Replace(lo.PanicOnErr(StoreWithDefaultSettings(d.dbConfig.Directory, false, d.dbConfig.Engine)))
+	d.store.Replace(lo.PanicOnErr(openStore(d.dbConfig, false)))
 
 	d.isClosed.Store(false)
 
-	if err := d.healthTracker.MarkCorrupted(); err != nil {
-		// panic immediately as in this case the database state is corrupted
-		panic(err)
+	if !d.dbConfig.ReadOnly {
+		if err := d.healthTracker.MarkCorrupted(); err != nil {
+			// panic immediately as in this case the database state is corrupted
+			panic(err)
+		}
 	}
 
 	return nil
@@ -8,4 +8,5 @@ var (
 	ErrDatabaseFull      = ierrors.New("database full")
 	ErrDatabaseShutdown  = ierrors.New("cannot open DBInstance that is shutdown")
 	ErrDatabaseNotClosed = ierrors.New("cannot open DBInstance that is not closed")
+	ErrReadOnly          = ierrors.New("store is read-only")
 )
@@ -0,0 +1,85 @@
+package database
+
+import (
+	"github.com/iotaledger/hive.go/kvstore"
+)
+
+// readOnlyKVStore wraps a kvstore and rejects all operations that would modify it.
+type readOnlyKVStore struct {
+	store kvstore.KVStore
+}
+
+// NewReadOnlyKVStore returns a view of the given store that returns ErrReadOnly for all write operations.
+func NewReadOnlyKVStore(store kvstore.KVStore) kvstore.KVStore {
+	return &readOnlyKVStore{
+		store: store,
+	}
+}
+
+func (s *readOnlyKVStore) WithRealm(realm kvstore.Realm) (kvstore.KVStore, error) {
+	store, err := s.store.WithRealm(realm)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewReadOnlyKVStore(store), nil
+}
+
+func (s *readOnlyKVStore) WithExtendedRealm(realm kvstore.Realm) (kvstore.KVStore, error) {
+	store, err := s.store.WithExtendedRealm(realm)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewReadOnlyKVStore(store), nil
+}
+
+func (s *readOnlyKVStore) Realm() kvstore.Realm {
+	return s.store.Realm()
+}
+
+func (s *readOnlyKVStore) Iterate(prefix kvstore.KeyPrefix, kvConsumerFunc kvstore.IteratorKeyValueConsumerFunc, direction ...kvstore.IterDirection) error {
+	return s.store.Iterate(prefix, kvConsumerFunc, direction...)
+}
+
+func (s *readOnlyKVStore) IterateKeys(prefix kvstore.KeyPrefix, consumerFunc kvstore.IteratorKeyConsumerFunc, direction ...kvstore.IterDirection) error {
+	return s.store.IterateKeys(prefix, consumerFunc, direction...)
+}
+
+func (s *readOnlyKVStore) Clear() error {
+	return ErrReadOnly
+}
+
+func (s *readOnlyKVStore) Get(key kvstore.Key) (kvstore.Value, error) {
+	return s.store.Get(key)
+}
+
+func (s *readOnlyKVStore) Set(_ kvstore.Key, _ kvstore.Value) error {
+	return ErrReadOnly
+}
+
+func (s *readOnlyKVStore) Has(key kvstore.Key) (bool, error) {
+	return s.store.Has(key)
+}
+
+func (s *readOnlyKVStore) Delete(_ kvstore.Key) error {
+	return ErrReadOnly
+}
+
+func (s *readOnlyKVStore) DeletePrefix(_ kvstore.KeyPrefix) error {
+	return ErrReadOnly
+}
+
+// Flush does nothing, because there are no write operations to persist.
+func (s *readOnlyKVStore) Flush() error {
+	return nil
+}
+
+// Close returns ErrReadOnly, because the underlying store is owned by someone else.
+func (s *readOnlyKVStore) Close() error {
+	return ErrReadOnly
+}
+
+func (s *readOnlyKVStore) Batched() (kvstore.BatchedMutations, error) {
+	return nil, ErrReadOnly
+}
@@ -6,9 +6,8 @@ import (
 	"github.com/iotaledger/hive.go/kvstore/rocksdb"
 )
 
-// NewRocksDB creates a new RocksDB instance.
-func NewRocksDB(path string) (*rocksdb.RocksDB, error) {
-	opts := []rocksdb.Option{
+func rocksDBOptions() []rocksdb.Option {
+	return []rocksdb.Option{
 		rocksdb.IncreaseParallelism(runtime.NumCPU() - 1),
 		rocksdb.Custom([]string{
 			"periodic_compaction_seconds=43200",
@@ -17,6 +16,14 @@ func NewRocksDB(path string) (*rocksdb.RocksDB, error) {
 			"max_log_file_size=50000000", // 50MB per log file
 		}),
 	}
+}
+
+// NewRocksDB creates a new RocksDB instance.
+func NewRocksDB(path string) (*rocksdb.RocksDB, error) {
+	return rocksdb.CreateDB(path, rocksDBOptions()...)
+}
 
-	return rocksdb.CreateDB(path, opts...)
+// NewRocksDBReadOnly opens an existing RocksDB instance in read-only mode.
+func NewRocksDBReadOnly(path string) (*rocksdb.RocksDB, error) {
+	return rocksdb.OpenDBReadOnly(path, rocksDBOptions()...)
 }
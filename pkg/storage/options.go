@@ -16,6 +16,20 @@ func WithDBEngine(optsDBEngine db.Engine) options.Option[Storage] {
 	}
 }
 
+// WithReadOnly opens the key-value stores of the storage in read-only mode (e.g. to inspect the database of a stopped node).
+func WithReadOnly(readOnly bool) options.Option[Storage] {
+	return func(s *Storage) {
+		s.optsReadOnly = readOnly
+	}
+}
+
+// WithIndexer creates the SQL database of the indexer, which is only needed if the indexer is enabled.
+func WithIndexer(indexer bool) options.Option[Storage] {
+	return func(s *Storage) {
+		s.optsIndexer = indexer
+	}
+}
+
 func WithAllowedDBEngines(optsAllowedDBEngines []db.Engine) options.Option[Storage] {
 	return func(s *Storage) {
 		s.optsAllowedDBEngines = optsAllowedDBEngines
@@ -58,6 +72,13 @@ func WithPruningSizeCooldownTime(cooldown time.Duration) options.Option[Storage]
 	}
 }
 
+// WithArchive moves the data of pruned epochs to the given archive instead of deleting it.
+func WithArchive(archive *prunable.Archive) options.Option[Storage] {
+	return func(s *Storage) {
+		s.optsArchive = archive
+	}
+}
+
 func WithPermanentOptions(opts ...options.Option[permanent.Permanent]) options.Option[Storage] {
 	return func(s *Storage) {
 		s.optsPermanent = append(s.optsPermanent, opts...)
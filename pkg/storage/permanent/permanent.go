@@ -36,17 +36,38 @@ type Permanent struct {
 
 // New returns a new permanent storage instance.
 func New(dbConfig database.Config, errorHandler func(error), opts ...options.Option[Permanent]) *Permanent {
-	return options.Apply(&Permanent{
+	return lo.PanicOnErr(Open(dbConfig, errorHandler, opts...))
+}
+
+// Open returns a new permanent storage instance or an error if the database can't be opened.
+func Open(dbConfig database.Config, errorHandler func(error), opts ...options.Option[Permanent]) (*Permanent, error) {
+	// openedCallback is nil because we don't need to do anything upon reopening
+	store, err := database.OpenDBInstance(dbConfig, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	p := options.Apply(&Permanent{
 		errorHandler: errorHandler,
 		dbConfig:     dbConfig,
+		store:        store,
 	}, opts, func(p *Permanent) {
-		// openedCallback is nil because we don't need to do anything upon reopening
-		p.store = database.NewDBInstance(p.dbConfig, nil)
 		p.settings = NewSettings(lo.PanicOnErr(p.store.KVStore().WithExtendedRealm(kvstore.Realm{settingsPrefix})), p.optsEpochBasedProvider...)
 		p.commitments = NewCommitments(lo.PanicOnErr(p.store.KVStore().WithExtendedRealm(kvstore.Realm{commitmentsPrefix})), p.settings.APIProvider())
 		p.utxoLedger = utxoledger.New(lo.PanicOnErr(p.store.KVStore().WithExtendedRealm(kvstore.Realm{ledgerPrefix})), p.settings.APIProvider())
 		p.accounts = lo.PanicOnErr(p.store.KVStore().WithExtendedRealm(kvstore.Realm{accountsPrefix}))
 	})
+
+	// databases that were created before the address index existed need to be indexed once.
+	if !dbConfig.ReadOnly {
+		if err := p.utxoLedger.BuildAddressIndexIfMissing(); err != nil {
+			p.store.Close()
+
+			return nil, ierrors.Wrap(err, "failed to build address index of the ledger")
+		}
+	}
+
+	return p, nil
 }
 
 func Clone(source *Permanent, dbConfig database.Config, errorHandler func(error), opts ...options.Option[Permanent]) (*Permanent, error) {
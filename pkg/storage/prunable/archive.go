@@ -0,0 +1,135 @@
+package prunable
+
+import (
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/runtime/ioutils"
+	"github.com/iotaledger/hive.go/runtime/syncutils"
+	"github.com/iotaledger/hive.go/serializer/v2/byteutils"
+	"github.com/iotaledger/iota-core/pkg/storage/database"
+	"github.com/iotaledger/iota-core/pkg/storage/prunable/slotstore"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+const (
+	// archivePrefixSlotData is the realm that holds the archived data of all slots.
+	archivePrefixSlotData byte = iota
+	// archivePrefixEpochs is the realm that holds the markers of the completely archived epochs.
+	archivePrefixEpochs
+
+	// archiveBatchSize is the number of entries that are written to the archive in a single batch.
+	archiveBatchSize = 10000
+)
+
+// Archive is the cold store of an archive node that keeps the data of pruned epochs instead of deleting it.
+// All epoch buckets are rolled into a single database, so that the underlying compaction can pack the data that is
+// never modified again. Only finalized epochs are archived, which is why a single archive is shared by all engine instances.
+type Archive struct {
+	dbConfig database.Config
+	db       *database.DBInstance
+
+	// mutex makes sure that the same epoch is not archived concurrently by different engine instances.
+	mutex syncutils.Mutex
+}
+
+// NewArchive opens the archive database with the given config or creates it if it does not exist yet.
+func NewArchive(dbConfig database.Config) *Archive {
+	return &Archive{
+		dbConfig: dbConfig,
+		// openedCallback is nil because we don't need to do anything when reopening the store.
+		db: database.NewDBInstance(dbConfig, nil),
+	}
+}
+
+// IsArchived returns true if the data of the given epoch was archived.
+func (a *Archive) IsArchived(epoch iotago.EpochIndex) (bool, error) {
+	return a.epochs().Has(epoch.MustBytes())
+}
+
+// Blocks returns the read-only store of the archived blocks of the given slot.
+func (a *Archive) Blocks(slot iotago.SlotIndex, apiForSlot iotago.API) (*slotstore.Blocks, error) {
+	kv, err := a.slotKVStore(slot, apiForSlot, kvstore.Realm{slotPrefixBlocks})
+	if err != nil {
+		return nil, ierrors.Wrapf(err, "could not get archived blocks with slot %d", slot)
+	}
+
+	return slotstore.NewBlocks(slot, kv, apiForSlot), nil
+}
+
+// BlockMetadata returns the read-only store of the archived block metadata of the given slot.
+func (a *Archive) BlockMetadata(slot iotago.SlotIndex, apiForSlot iotago.API) (*slotstore.BlockMetadataStore, error) {
+	kv, err := a.slotKVStore(slot, apiForSlot, kvstore.Realm{slotPrefixBlockMetadata})
+	if err != nil {
+		return nil, ierrors.Wrapf(err, "could not get archived block metadata with slot %d", slot)
+	}
+
+	return slotstore.NewBlockMetadataStore(slot, kv), nil
+}
+
+// Size returns the size of the archive database on disk.
+func (a *Archive) Size() int64 {
+	size, err := ioutils.FolderSize(a.dbConfig.Directory)
+	if err != nil {
+		return 0
+	}
+
+	return size
+}
+
+// Shutdown flushes and closes the archive database.
+func (a *Archive) Shutdown() {
+	a.db.Shutdown()
+}
+
+// archiveEpoch copies the data of all slots of an epoch from the given bucket to the archive.
+// Epochs that were already archived are skipped, the marker of the epoch is only written after all of its slots were copied.
+func (a *Archive) archiveEpoch(epoch iotago.EpochIndex, bucket kvstore.KVStore, startSlot iotago.SlotIndex, endSlot iotago.SlotIndex) error {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if archived, err := a.IsArchived(epoch); err != nil {
+		return ierrors.Wrapf(err, "failed to check whether epoch %d is archived", epoch)
+	} else if archived {
+		return nil
+	}
+
+	for slot := startSlot; slot <= endSlot; slot++ {
+		source, err := bucket.WithExtendedRealm(slot.MustBytes())
+		if err != nil {
+			return ierrors.Wrapf(err, "failed to get bucket realm of slot %d", slot)
+		}
+
+		if err = kvstore.CopyBatched(source, a.slotData(slot), archiveBatchSize); err != nil {
+			return ierrors.Wrapf(err, "failed to archive slot %d", slot)
+		}
+	}
+
+	if err := a.epochs().Set(epoch.MustBytes(), []byte{}); err != nil {
+		return ierrors.Wrapf(err, "failed to mark epoch %d as archived", epoch)
+	}
+
+	return a.db.KVStore().Flush()
+}
+
+// slotKVStore returns the archived data of the given slot within the given realm if its epoch was archived.
+// The returned store is read-only, because archived epochs are never modified again.
+func (a *Archive) slotKVStore(slot iotago.SlotIndex, apiForSlot iotago.API, realm kvstore.Realm) (kvstore.KVStore, error) {
+	epoch := apiForSlot.TimeProvider().EpochFromSlot(slot)
+
+	if archived, err := a.IsArchived(epoch); err != nil {
+		return nil, ierrors.Wrapf(err, "failed to check whether epoch %d is archived", epoch)
+	} else if !archived {
+		return nil, ierrors.WithMessagef(database.ErrEpochPruned, "epoch %d is not archived", epoch)
+	}
+
+	return database.NewReadOnlyKVStore(lo.PanicOnErr(a.slotData(slot).WithExtendedRealm(realm))), nil
+}
+
+func (a *Archive) slotData(slot iotago.SlotIndex) kvstore.KVStore {
+	return lo.PanicOnErr(a.db.KVStore().WithExtendedRealm(byteutils.ConcatBytes(kvstore.Realm{archivePrefixSlotData}, slot.MustBytes())))
+}
+
+func (a *Archive) epochs() kvstore.KVStore {
+	return lo.PanicOnErr(a.db.KVStore().WithExtendedRealm(kvstore.Realm{archivePrefixEpochs}))
+}
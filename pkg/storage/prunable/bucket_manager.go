@@ -67,11 +67,31 @@ func (b *BucketManager) Get(epoch iotago.EpochIndex, realm kvstore.Realm) (kvsto
 		return nil, ierrors.WithMessagef(database.ErrEpochPruned, "epoch %d", epoch)
 	}
 
+	// a read-only bucket manager can't create buckets that don't exist on disk yet.
+	if b.dbConfig.ReadOnly {
+		if exists, _, err := ioutils.PathExists(dbPathFromIndex(b.dbConfig.Directory, epoch)); err != nil || !exists {
+			return nil, ierrors.Errorf("bucket for epoch %d does not exist", epoch)
+		}
+	}
+
 	kv := newBucketedKVStore(b, b.getDBInstance(epoch).KVStore())
 
 	return lo.PanicOnErr(kv.WithExtendedRealm(realm)), nil
 }
 
+// existingBucket returns the store of the given epoch if its bucket exists on disk and was not pruned yet.
+func (b *BucketManager) existingBucket(epoch iotago.EpochIndex) (kvstore.KVStore, bool) {
+	if b.IsTooOld(epoch) {
+		return nil, false
+	}
+
+	if exists, _, err := ioutils.PathExists(dbPathFromIndex(b.dbConfig.Directory, epoch)); err != nil || !exists {
+		return nil, false
+	}
+
+	return b.getDBInstance(epoch).KVStore(), true
+}
+
 func (b *BucketManager) Lock() {
 	// Lock b.mutex so that a new DBInstance is not created
 	b.mutex.Lock()
@@ -5,6 +5,7 @@ import (
 
 	"github.com/iotaledger/hive.go/ierrors"
 	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/lo"
 	"github.com/iotaledger/hive.go/runtime/ioutils"
 	"github.com/iotaledger/hive.go/runtime/options"
 	"github.com/iotaledger/iota-core/pkg/core/account"
@@ -15,6 +16,11 @@ import (
 	iotago "github.com/iotaledger/iota.go/v4"
 )
 
+const (
+	// SemiPermanentDirName is the name of the directory that holds the semi-permanent database.
+	SemiPermanentDirName = "semipermanent"
+)
+
 type Prunable struct {
 	apiProvider       iotago.APIProvider
 	prunableSlotStore *BucketManager
@@ -30,10 +36,23 @@ type Prunable struct {
 }
 
 func New(dbConfig database.Config, apiProvider iotago.APIProvider, errorHandler func(error), opts ...options.Option[BucketManager]) *Prunable {
-	dir := utils.NewDirectory(dbConfig.Directory, true)
-	semiPermanentDBConfig := dbConfig.WithDirectory(dir.PathWithCreate("semipermanent"))
+	return lo.PanicOnErr(Open(dbConfig, apiProvider, errorHandler, opts...))
+}
+
+// Open returns a new prunable storage instance or an error if the semi-permanent database can't be opened.
+// A read-only prunable storage doesn't create any directories.
+func Open(dbConfig database.Config, apiProvider iotago.APIProvider, errorHandler func(error), opts ...options.Option[BucketManager]) (*Prunable, error) {
+	dir := utils.NewDirectory(dbConfig.Directory, !dbConfig.ReadOnly)
+	semiPermanentDir := dir.Path(SemiPermanentDirName)
+	if !dbConfig.ReadOnly {
+		semiPermanentDir = dir.PathWithCreate(SemiPermanentDirName)
+	}
+	semiPermanentDBConfig := dbConfig.WithDirectory(semiPermanentDir)
 	// openedCallback is nil because we don't need to do anything when reopening the store.
-	semiPermanentDB := database.NewDBInstance(semiPermanentDBConfig, nil)
+	semiPermanentDB, err := database.OpenDBInstance(semiPermanentDBConfig, nil)
+	if err != nil {
+		return nil, err
+	}
 
 	rewardPruningDelayFunc := func(epochToPrune iotago.EpochIndex) iotago.EpochIndex {
 		return iotago.EpochIndex(apiProvider.APIForEpoch(epochToPrune).ProtocolParameters().RewardsParameters().RetentionPeriod)
@@ -75,7 +94,7 @@ func New(dbConfig database.Config, apiProvider iotago.APIProvider, errorHandler
 			),
 			5,
 		),
-	}
+	}, nil
 }
 
 func Clone(source *Prunable, dbConfig database.Config, apiProvider iotago.APIProvider, errorHandler func(error), opts ...options.Option[BucketManager]) (*Prunable, error) {
@@ -143,6 +162,18 @@ func (p *Prunable) Prune(epoch iotago.EpochIndex, defaultPruningDelay iotago.Epo
 	return nil
 }
 
+// Archive copies the data of all slots of the given epoch to the archive, it needs to be called before the epoch is pruned.
+func (p *Prunable) Archive(epoch iotago.EpochIndex, archive *Archive) error {
+	bucket, exists := p.prunableSlotStore.existingBucket(epoch)
+	if !exists {
+		return nil
+	}
+
+	timeProvider := p.apiProvider.APIForEpoch(epoch).TimeProvider()
+
+	return archive.archiveEpoch(epoch, bucket, timeProvider.EpochStart(epoch), timeProvider.EpochEnd(epoch))
+}
+
 func (p *Prunable) BucketSize(epoch iotago.EpochIndex) (int64, error) {
 	return p.prunableSlotStore.BucketSize(epoch)
 }
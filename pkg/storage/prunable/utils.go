@@ -48,6 +48,39 @@ func getSortedDBInstancesFromDisk(baseDir string) (dbInfos []*dbInstanceFileInfo
 	return dbInfos
 }
 
+// BucketEpochsFromDisk returns an ASC sorted list of the base epochs of all buckets stored in the given base directory.
+func BucketEpochsFromDisk(baseDir string) ([]iotago.EpochIndex, error) {
+	files, err := os.ReadDir(baseDir)
+	if err != nil {
+		return nil, err
+	}
+
+	epochs := make([]iotago.EpochIndex, 0, len(files))
+	for _, file := range files {
+		if !file.IsDir() {
+			continue
+		}
+
+		atoi, convErr := strconv.Atoi(file.Name())
+		if convErr != nil {
+			continue
+		}
+
+		epochs = append(epochs, iotago.EpochIndex(atoi))
+	}
+
+	sort.Slice(epochs, func(i int, j int) bool {
+		return epochs[i] < epochs[j]
+	})
+
+	return epochs, nil
+}
+
+// BucketDirectory returns the directory of the bucket for the given epoch in the given base directory.
+func BucketDirectory(baseDir string, epoch iotago.EpochIndex) string {
+	return dbPathFromIndex(baseDir, epoch)
+}
+
 func dbPrunableDirectorySize(base string, epoch iotago.EpochIndex) (int64, error) {
 	return ioutils.FolderSize(dbPathFromIndex(base, epoch))
 }
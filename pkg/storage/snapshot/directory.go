@@ -0,0 +1,197 @@
+package snapshot
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/runtime/syncutils"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+const (
+	// directoryFileBaseName is the base name of the snapshot files in a Directory.
+	directoryFileBaseName = "snapshot"
+	// directoryFilePrefix is the prefix of the snapshot files in a Directory.
+	directoryFilePrefix = directoryFileBaseName + "_"
+	// directoryFileExtension is the extension of the snapshot files in a Directory.
+	directoryFileExtension = ".bin"
+	// directoryLatestName is the name of the symlink that points to the latest snapshot in a Directory.
+	directoryLatestName = directoryFilePrefix + "latest" + directoryFileExtension
+
+	// TempFileSuffix is appended to the path of a snapshot file while it is written, it is renamed once it is complete.
+	TempFileSuffix = ".tmp"
+)
+
+// FileInfo contains information about a snapshot file in a Directory.
+type FileInfo struct {
+	// Slot is the target slot of the snapshot.
+	Slot iotago.SlotIndex
+	// Path is the path of the snapshot file.
+	Path string
+	// Size is the size of the snapshot file in bytes.
+	Size int64
+	// ModTime is the time the snapshot file was last modified.
+	ModTime time.Time
+}
+
+// Directory manages a directory of snapshot files that are named by their target slot ("snapshot_<slot>.bin").
+// It keeps a "snapshot_latest.bin" symlink that points to the snapshot with the highest slot.
+type Directory struct {
+	path  string
+	mutex syncutils.Mutex
+}
+
+// NewDirectory creates a new Directory for the given path.
+func NewDirectory(path string) *Directory {
+	return &Directory{
+		path: path,
+	}
+}
+
+// Path returns the path of the directory.
+func (d *Directory) Path() string {
+	return d.path
+}
+
+// BaseFilePath returns the file path that is passed to Engine.ExportSnapshot, which appends the slot to the file name.
+func (d *Directory) BaseFilePath() string {
+	return filepath.Join(d.path, directoryFileBaseName+directoryFileExtension)
+}
+
+// LatestPath returns the path of the symlink that points to the latest snapshot.
+func (d *Directory) LatestPath() string {
+	return filepath.Join(d.path, directoryLatestName)
+}
+
+// List returns the snapshot files in the directory sorted by slot in ascending order.
+func (d *Directory) List() ([]*FileInfo, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	return d.list()
+}
+
+// Latest returns the snapshot file with the highest slot.
+func (d *Directory) Latest() (*FileInfo, bool, error) {
+	files, err := d.List()
+	if err != nil || len(files) == 0 {
+		return nil, false, err
+	}
+
+	return files[len(files)-1], true, nil
+}
+
+// Update points the latest symlink to the newest snapshot and removes all but the latest keep snapshots.
+// A keep value of 0 retains all snapshots. It returns the paths of the removed snapshot files.
+func (d *Directory) Update(keep int) ([]string, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	files, err := d.list()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(files) == 0 {
+		return nil, nil
+	}
+
+	if err = d.updateLatestSymlink(files[len(files)-1]); err != nil {
+		return nil, err
+	}
+
+	if keep <= 0 || len(files) <= keep {
+		return nil, nil
+	}
+
+	removed := make([]string, 0, len(files)-keep)
+	for _, file := range files[:len(files)-keep] {
+		if err = os.Remove(file.Path); err != nil {
+			return removed, ierrors.Wrapf(err, "failed to remove snapshot file %s", file.Path)
+		}
+
+		removed = append(removed, file.Path)
+	}
+
+	return removed, nil
+}
+
+func (d *Directory) list() ([]*FileInfo, error) {
+	entries, err := os.ReadDir(d.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+
+		return nil, ierrors.Wrapf(err, "failed to read snapshot directory %s", d.path)
+	}
+
+	files := make([]*FileInfo, 0, len(entries))
+	for _, entry := range entries {
+		// snapshots that are still being written are not part of the directory yet.
+		if strings.HasSuffix(entry.Name(), TempFileSuffix) {
+			continue
+		}
+
+		slot, ok := slotFromFileName(entry.Name())
+		if !ok || !entry.Type().IsRegular() {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return nil, ierrors.Wrapf(err, "failed to stat snapshot file %s", entry.Name())
+		}
+
+		files = append(files, &FileInfo{
+			Slot:    slot,
+			Path:    filepath.Join(d.path, entry.Name()),
+			Size:    info.Size(),
+			ModTime: info.ModTime(),
+		})
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Slot < files[j].Slot
+	})
+
+	return files, nil
+}
+
+// updateLatestSymlink atomically replaces the latest symlink with one that points to the given file.
+func (d *Directory) updateLatestSymlink(latest *FileInfo) error {
+	tempPath := d.LatestPath() + TempFileSuffix
+	if err := os.Remove(tempPath); err != nil && !os.IsNotExist(err) {
+		return ierrors.Wrap(err, "failed to remove temporary symlink")
+	}
+
+	// the symlink is relative, so the directory can be moved or mounted elsewhere.
+	if err := os.Symlink(filepath.Base(latest.Path), tempPath); err != nil {
+		return ierrors.Wrap(err, "failed to create latest snapshot symlink")
+	}
+
+	if err := os.Rename(tempPath, d.LatestPath()); err != nil {
+		return ierrors.Wrap(err, "failed to replace latest snapshot symlink")
+	}
+
+	return nil
+}
+
+// slotFromFileName parses the slot from a file name of the form "snapshot_<slot>.bin".
+func slotFromFileName(fileName string) (iotago.SlotIndex, bool) {
+	if !strings.HasPrefix(fileName, directoryFilePrefix) || !strings.HasSuffix(fileName, directoryFileExtension) {
+		return 0, false
+	}
+
+	slot, err := strconv.ParseUint(strings.TrimSuffix(strings.TrimPrefix(fileName, directoryFilePrefix), directoryFileExtension), 10, 32)
+	if err != nil {
+		return 0, false
+	}
+
+	return iotago.SlotIndex(slot), true
+}
@@ -0,0 +1,63 @@
+package snapshot_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/iota-core/pkg/storage/snapshot"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func TestDirectory_Update(t *testing.T) {
+	directory := snapshot.NewDirectory(t.TempDir())
+	require.Equal(t, filepath.Join(directory.Path(), "snapshot.bin"), directory.BaseFilePath())
+
+	files, err := directory.List()
+	require.NoError(t, err)
+	require.Empty(t, files)
+
+	for _, fileName := range []string{"snapshot_30.bin", "snapshot_10.bin", "snapshot_20.bin", "snapshot_40.bin", "other.bin", "snapshot_x.bin", "snapshot_50.bin.tmp"} {
+		require.NoError(t, os.WriteFile(filepath.Join(directory.Path(), fileName), []byte(fileName), 0600))
+	}
+
+	files, err = directory.List()
+	require.NoError(t, err)
+	// other files and snapshots that are still being written are not listed.
+	require.Len(t, files, 4)
+	require.Equal(t, []iotago.SlotIndex{10, 20, 30, 40}, []iotago.SlotIndex{files[0].Slot, files[1].Slot, files[2].Slot, files[3].Slot})
+
+	removed, err := directory.Update(2)
+	require.NoError(t, err)
+	require.Equal(t, []string{filepath.Join(directory.Path(), "snapshot_10.bin"), filepath.Join(directory.Path(), "snapshot_20.bin")}, removed)
+
+	latest, exists, err := directory.Latest()
+	require.NoError(t, err)
+	require.True(t, exists)
+	require.EqualValues(t, 40, latest.Slot)
+
+	// the latest symlink is not listed as a snapshot, but resolves to the newest file.
+	target, err := os.Readlink(directory.LatestPath())
+	require.NoError(t, err)
+	require.Equal(t, "snapshot_40.bin", target)
+
+	content, err := os.ReadFile(directory.LatestPath())
+	require.NoError(t, err)
+	require.Equal(t, "snapshot_40.bin", string(content))
+
+	files, err = directory.List()
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+
+	// keeping 0 snapshots retains all of them.
+	require.NoError(t, os.WriteFile(filepath.Join(directory.Path(), "snapshot_50.bin"), nil, 0600))
+	removed, err = directory.Update(0)
+	require.NoError(t, err)
+	require.Empty(t, removed)
+
+	target, err = os.Readlink(directory.LatestPath())
+	require.NoError(t, err)
+	require.Equal(t, "snapshot_50.bin", target)
+}
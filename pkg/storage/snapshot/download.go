@@ -0,0 +1,258 @@
+package snapshot
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/log"
+	"github.com/iotaledger/hive.go/runtime/options"
+)
+
+var (
+	// ErrHashMismatch is returned if the SHA-256 hash of a downloaded snapshot does not match the expected hash.
+	ErrHashMismatch = ierrors.New("snapshot hash mismatch")
+	// ErrNoVerification is returned if a snapshot should be downloaded without any verification configured.
+	ErrNoVerification = ierrors.New("no snapshot verification configured")
+)
+
+// Downloader downloads snapshot files from a list of HTTP(S) sources. Interrupted downloads are resumed and a source
+// is only accepted if the downloaded file passes the configured verifications, otherwise the next source is tried.
+type Downloader struct {
+	logger log.Logger
+	client *http.Client
+
+	optsExpectedSHA256 []byte
+	optsVerifyFunc     func(filePath string) error
+	optsMaxAttempts    int
+	optsRetryDelay     time.Duration
+}
+
+// NewDownloader creates a new Downloader.
+func NewDownloader(logger log.Logger, opts ...options.Option[Downloader]) *Downloader {
+	return options.Apply(&Downloader{
+		logger: logger,
+		client: &http.Client{
+			Transport: &http.Transport{
+				Proxy:                 http.ProxyFromEnvironment,
+				ResponseHeaderTimeout: 30 * time.Second,
+			},
+		},
+
+		optsMaxAttempts: 3,
+		optsRetryDelay:  2 * time.Second,
+	}, opts)
+}
+
+// Download downloads the snapshot to the target path by trying the given sources in order.
+// The data is written to a temporary file next to the target path, which is only renamed after it was verified.
+// An expected hash or a verify function is required, unverified snapshots are never downloaded.
+func (d *Downloader) Download(ctx context.Context, targetPath string, urls ...string) error {
+	if len(urls) == 0 {
+		return ierrors.New("no snapshot download sources given")
+	}
+
+	if !d.HasVerification() {
+		return ErrNoVerification
+	}
+
+	partPath := targetPath + ".part"
+
+	var errs []error
+	for _, url := range urls {
+		err := d.downloadFromSource(ctx, url, partPath)
+		if err == nil {
+			if err = os.Rename(partPath, targetPath); err != nil {
+				return ierrors.Wrap(err, "failed to move downloaded snapshot to the target path")
+			}
+
+			return nil
+		}
+
+		// keep the partial file if we got interrupted, so the download can be resumed on the next start.
+		if ctx.Err() != nil {
+			return ierrors.Wrap(ctx.Err(), "snapshot download canceled")
+		}
+
+		d.logger.LogWarn("failed to download snapshot", "url", url, "err", err)
+		errs = append(errs, ierrors.Wrapf(err, "source %s", url))
+
+		// the partial data of one source must not be resumed from a different source.
+		if removeErr := os.Remove(partPath); removeErr != nil && !os.IsNotExist(removeErr) {
+			return ierrors.Wrap(removeErr, "failed to remove partially downloaded snapshot")
+		}
+	}
+
+	return ierrors.Wrap(ierrors.Join(errs...), "failed to download snapshot from all sources")
+}
+
+// HasVerification returns true if an expected hash or a verify function is configured.
+func (d *Downloader) HasVerification() bool {
+	return len(d.optsExpectedSHA256) != 0 || d.optsVerifyFunc != nil
+}
+
+// Verify checks the snapshot file at the given path against the expected hash and the verify function.
+func (d *Downloader) Verify(filePath string) error {
+	if len(d.optsExpectedSHA256) != 0 {
+		hash, err := fileSHA256(filePath)
+		if err != nil {
+			return err
+		}
+
+		if !bytes.Equal(hash, d.optsExpectedSHA256) {
+			return ierrors.Wrapf(ErrHashMismatch, "expected %x, got %x", d.optsExpectedSHA256, hash)
+		}
+	}
+
+	if d.optsVerifyFunc != nil {
+		if err := d.optsVerifyFunc(filePath); err != nil {
+			return ierrors.Wrap(err, "snapshot verification failed")
+		}
+	}
+
+	return nil
+}
+
+func (d *Downloader) downloadFromSource(ctx context.Context, url string, partPath string) error {
+	for attempt := 1; ; attempt++ {
+		err := d.fetch(ctx, url, partPath)
+		if err == nil {
+			break
+		}
+
+		if attempt >= d.optsMaxAttempts || ctx.Err() != nil {
+			return err
+		}
+
+		d.logger.LogWarn("snapshot download interrupted, retrying", "url", url, "attempt", attempt, "err", err)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(d.optsRetryDelay):
+		}
+	}
+
+	return d.Verify(partPath)
+}
+
+// fetch downloads the remaining bytes of the snapshot from the given source and appends them to the partial file.
+func (d *Downloader) fetch(ctx context.Context, url string, partPath string) error {
+	file, err := os.OpenFile(partPath, os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return ierrors.Wrap(err, "failed to open partial snapshot file")
+	}
+	defer file.Close()
+
+	offset, err := file.Seek(0, io.SeekEnd)
+	if err != nil {
+		return ierrors.Wrap(err, "failed to seek to the end of the partial snapshot file")
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return ierrors.Wrap(err, "failed to create request")
+	}
+
+	if offset > 0 {
+		request.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+
+	response, err := d.client.Do(request)
+	if err != nil {
+		return ierrors.Wrap(err, "failed to send request")
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusPartialContent:
+		if !strings.HasPrefix(response.Header.Get("Content-Range"), fmt.Sprintf("bytes %d-", offset)) {
+			return ierrors.Errorf("unexpected content range '%s' for offset %d", response.Header.Get("Content-Range"), offset)
+		}
+
+		d.logger.LogInfo("resuming snapshot download", "url", url, "offset", offset)
+	case http.StatusOK:
+		if offset > 0 {
+			d.logger.LogInfo("source does not support resuming, restarting snapshot download", "url", url)
+
+			if err = file.Truncate(0); err != nil {
+				return ierrors.Wrap(err, "failed to truncate partial snapshot file")
+			}
+
+			if _, err = file.Seek(0, io.SeekStart); err != nil {
+				return ierrors.Wrap(err, "failed to seek to the start of the partial snapshot file")
+			}
+		} else {
+			d.logger.LogInfo("downloading snapshot", "url", url, "size", response.ContentLength)
+		}
+	case http.StatusRequestedRangeNotSatisfiable:
+		// the partial file already contains the complete snapshot.
+		if offset > 0 {
+			return nil
+		}
+
+		return ierrors.Errorf("unexpected status code: %s", response.Status)
+	default:
+		return ierrors.Errorf("unexpected status code: %s", response.Status)
+	}
+
+	if _, err = io.Copy(file, response.Body); err != nil {
+		return ierrors.Wrap(err, "failed to download snapshot")
+	}
+
+	if err = file.Close(); err != nil {
+		return ierrors.Wrap(err, "failed to close partial snapshot file")
+	}
+
+	return nil
+}
+
+func fileSHA256(filePath string) ([]byte, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, ierrors.Wrap(err, "failed to open snapshot file")
+	}
+	defer file.Close()
+
+	hasher := sha256.New()
+	if _, err = io.Copy(hasher, file); err != nil {
+		return nil, ierrors.Wrap(err, "failed to hash snapshot file")
+	}
+
+	return hasher.Sum(nil), nil
+}
+
+// WithExpectedSHA256 sets the SHA-256 hash the downloaded snapshot file must have.
+func WithExpectedSHA256(hash []byte) options.Option[Downloader] {
+	return func(d *Downloader) {
+		d.optsExpectedSHA256 = hash
+	}
+}
+
+// WithVerifyFunc sets an additional verification that the downloaded snapshot file must pass.
+func WithVerifyFunc(verifyFunc func(filePath string) error) options.Option[Downloader] {
+	return func(d *Downloader) {
+		d.optsVerifyFunc = verifyFunc
+	}
+}
+
+// WithMaxAttempts sets how often a download from the same source is attempted before the next source is tried.
+func WithMaxAttempts(maxAttempts int) options.Option[Downloader] {
+	return func(d *Downloader) {
+		d.optsMaxAttempts = maxAttempts
+	}
+}
+
+// WithRetryDelay sets the delay between two download attempts from the same source.
+func WithRetryDelay(retryDelay time.Duration) options.Option[Downloader] {
+	return func(d *Downloader) {
+		d.optsRetryDelay = retryDelay
+	}
+}
@@ -0,0 +1,101 @@
+package snapshot_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/log"
+	"github.com/iotaledger/iota-core/pkg/storage/snapshot"
+)
+
+func newSnapshotServer(t *testing.T, content []byte, rangeRequests *atomic.Int32) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Range") != "" && rangeRequests != nil {
+			rangeRequests.Add(1)
+		}
+
+		http.ServeContent(w, r, "snapshot.bin", time.Time{}, bytes.NewReader(content))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func newFailingServer(t *testing.T) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestDownloader_Fallback(t *testing.T) {
+	content := bytes.Repeat([]byte("snapshot"), 1024)
+	hash := sha256.Sum256(content)
+
+	targetPath := filepath.Join(t.TempDir(), "snapshot.bin")
+
+	downloader := snapshot.NewDownloader(log.NewLogger(), snapshot.WithExpectedSHA256(hash[:]), snapshot.WithMaxAttempts(2), snapshot.WithRetryDelay(0))
+	require.NoError(t, downloader.Download(context.Background(), targetPath, newFailingServer(t).URL, newSnapshotServer(t, content, nil).URL))
+
+	downloaded, err := os.ReadFile(targetPath)
+	require.NoError(t, err)
+	require.Equal(t, content, downloaded)
+
+	_, err = os.Stat(targetPath + ".part")
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestDownloader_Resume(t *testing.T) {
+	content := bytes.Repeat([]byte("snapshot"), 1024)
+	hash := sha256.Sum256(content)
+
+	targetPath := filepath.Join(t.TempDir(), "snapshot.bin")
+	require.NoError(t, os.WriteFile(targetPath+".part", content[:1000], 0600))
+
+	var rangeRequests atomic.Int32
+	downloader := snapshot.NewDownloader(log.NewLogger(), snapshot.WithExpectedSHA256(hash[:]))
+	require.NoError(t, downloader.Download(context.Background(), targetPath, newSnapshotServer(t, content, &rangeRequests).URL))
+	require.EqualValues(t, 1, rangeRequests.Load())
+
+	downloaded, err := os.ReadFile(targetPath)
+	require.NoError(t, err)
+	require.Equal(t, content, downloaded)
+}
+
+func TestDownloader_VerificationFailure(t *testing.T) {
+	content := bytes.Repeat([]byte("snapshot"), 1024)
+
+	targetPath := filepath.Join(t.TempDir(), "snapshot.bin")
+
+	downloader := snapshot.NewDownloader(log.NewLogger(), snapshot.WithExpectedSHA256(make([]byte, sha256.Size)))
+	err := downloader.Download(context.Background(), targetPath, newSnapshotServer(t, content, nil).URL)
+	require.ErrorIs(t, err, snapshot.ErrHashMismatch)
+
+	_, err = os.Stat(targetPath)
+	require.True(t, os.IsNotExist(err))
+	_, err = os.Stat(targetPath + ".part")
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestDownloader_NoVerification(t *testing.T) {
+	targetPath := filepath.Join(t.TempDir(), "snapshot.bin")
+
+	downloader := snapshot.NewDownloader(log.NewLogger())
+	err := downloader.Download(context.Background(), targetPath, newSnapshotServer(t, []byte("snapshot"), nil).URL)
+	require.ErrorIs(t, err, snapshot.ErrNoVerification)
+
+	_, err = os.Stat(targetPath + ".part")
+	require.True(t, os.IsNotExist(err))
+}
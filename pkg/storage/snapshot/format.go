@@ -0,0 +1,108 @@
+package snapshot
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"io"
+
+	"github.com/iotaledger/hive.go/ierrors"
+)
+
+// The streaming snapshot container has the following layout:
+//
+//	header:  magic (8 bytes) | format version (1 byte) | compression (1 byte)
+//	body:    (compressed according to the header)
+//	         section*
+//	         end marker (name length 0)
+//	section: name length (1 byte) | name | data length (8 bytes, little endian) | data | SHA-256 of data (32 bytes)
+//
+// The data of every section is exactly what the corresponding component writes in the legacy snapshot format,
+// so the container does not change the way the components serialize their state.
+
+const (
+	// FormatVersion is the version of the streaming snapshot container.
+	FormatVersion byte = 1
+
+	// checksumLength is the length of the checksum that is appended to every section.
+	checksumLength = sha256.Size
+
+	// maxSectionNameLength is the maximum length of a section name.
+	maxSectionNameLength = 255
+)
+
+var (
+	// magic is the prefix that identifies the streaming snapshot container.
+	magic = []byte("IOTASNAP")
+
+	// ErrInvalidHeader is returned if the header of a snapshot is not a valid streaming snapshot header.
+	ErrInvalidHeader = ierrors.New("invalid snapshot header")
+	// ErrUnsupportedVersion is returned if the container was written with an unknown format version.
+	ErrUnsupportedVersion = ierrors.New("unsupported snapshot format version")
+	// ErrChecksumMismatch is returned if the checksum of a section does not match its data.
+	ErrChecksumMismatch = ierrors.New("snapshot section checksum mismatch")
+	// ErrUnexpectedSection is returned if a section is read that was not expected at this position.
+	ErrUnexpectedSection = ierrors.New("unexpected snapshot section")
+	// ErrSeekNotSupported is returned if a section reader is asked to seek further back than it can rewind.
+	ErrSeekNotSupported = ierrors.New("seeking that far back is not supported by the streaming snapshot reader")
+)
+
+// Compression is the compression algorithm that is used for the body of the container.
+type Compression byte
+
+const (
+	// CompressionNone stores the body uncompressed.
+	CompressionNone Compression = iota
+	// CompressionZstd compresses the body with zstd.
+	CompressionZstd
+)
+
+// String returns a human-readable representation of the Compression.
+func (c Compression) String() string {
+	switch c {
+	case CompressionNone:
+		return "none"
+	case CompressionZstd:
+		return "zstd"
+	default:
+		return "unknown"
+	}
+}
+
+// CompressionFromString returns the Compression for the given name.
+func CompressionFromString(name string) (Compression, error) {
+	switch name {
+	case CompressionNone.String():
+		return CompressionNone, nil
+	case CompressionZstd.String():
+		return CompressionZstd, nil
+	default:
+		return 0, ierrors.Errorf("unknown snapshot compression: %s", name)
+	}
+}
+
+// IsStreamFormat checks if the given reader starts with the header of the streaming snapshot container.
+// The reader is rewound to its initial position afterward, so it can be used to read either format.
+func IsStreamFormat(reader io.ReadSeeker) (bool, error) {
+	start, err := reader.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return false, ierrors.Wrap(err, "failed to determine reader position")
+	}
+
+	prefix := make([]byte, len(magic))
+	n, readErr := io.ReadFull(reader, prefix)
+
+	if _, err = reader.Seek(start, io.SeekStart); err != nil {
+		return false, ierrors.Wrap(err, "failed to rewind reader")
+	}
+
+	if readErr != nil {
+		// files that are shorter than the magic can't be streaming snapshots
+		if ierrors.Is(readErr, io.EOF) || ierrors.Is(readErr, io.ErrUnexpectedEOF) {
+			return false, nil
+		}
+
+		return false, ierrors.Wrap(readErr, "failed to read snapshot header")
+	}
+
+	return bytes.Equal(prefix[:n], magic), nil
+}
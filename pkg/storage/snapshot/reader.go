@@ -0,0 +1,244 @@
+package snapshot
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"hash"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/serializer/v2"
+	"github.com/iotaledger/hive.go/serializer/v2/stream"
+)
+
+// rewindWindow is the number of recently read bytes a section reader keeps around to support seeking backwards.
+// The components only ever peek at collection counts, so a small window is sufficient.
+const rewindWindow = 1024
+
+// Reader reads snapshots in the streaming container format from a non-seekable io.Reader.
+type Reader struct {
+	body     *bufio.Reader
+	decoder  *zstd.Decoder
+	finished bool
+}
+
+// NewReader creates a new Reader and validates the container header of the given input.
+func NewReader(input io.Reader) (*Reader, error) {
+	prefix, err := stream.ReadBytes(input, len(magic))
+	if err != nil {
+		return nil, ierrors.Wrap(err, "failed to read snapshot magic")
+	} else if !bytes.Equal(prefix, magic) {
+		return nil, ErrInvalidHeader
+	}
+
+	version, err := stream.Read[byte](input)
+	if err != nil {
+		return nil, ierrors.Wrap(err, "failed to read snapshot format version")
+	} else if version != FormatVersion {
+		return nil, ierrors.Wrapf(ErrUnsupportedVersion, "version %d", version)
+	}
+
+	compression, err := stream.Read[byte](input)
+	if err != nil {
+		return nil, ierrors.Wrap(err, "failed to read snapshot compression")
+	}
+
+	r := &Reader{}
+
+	switch Compression(compression) {
+	case CompressionNone:
+		r.body = bufio.NewReader(input)
+	case CompressionZstd:
+		if r.decoder, err = zstd.NewReader(input); err != nil {
+			return nil, ierrors.Wrap(err, "failed to create zstd decoder")
+		}
+
+		r.body = bufio.NewReader(r.decoder)
+	default:
+		return nil, ierrors.Errorf("unknown snapshot compression: %d", compression)
+	}
+
+	return r, nil
+}
+
+// ReadSection reads the next section, which must have the given name, and verifies its checksum after the readFunc
+// consumed it. The readFunc has to consume the complete section.
+func (r *Reader) ReadSection(expectedName string, readFunc func(reader io.ReadSeeker) error) error {
+	name, length, err := r.nextSection()
+	if err != nil {
+		return err
+	} else if r.finished {
+		return ierrors.Wrapf(ErrUnexpectedSection, "expected section %s, but reached the end of the snapshot", expectedName)
+	} else if name != expectedName {
+		return ierrors.Wrapf(ErrUnexpectedSection, "expected section %s, but found %s", expectedName, name)
+	}
+
+	section := newSectionReader(r.body, length)
+	if err = readFunc(section); err != nil {
+		return ierrors.Wrapf(err, "failed to read section %s", name)
+	}
+
+	if unread := section.unread(); unread != 0 {
+		return ierrors.Errorf("section %s was not fully consumed (%d bytes left)", name, unread)
+	}
+
+	checksum, err := stream.ReadBytes(r.body, checksumLength)
+	if err != nil {
+		return ierrors.Wrapf(err, "failed to read checksum of section %s", name)
+	} else if !bytes.Equal(checksum, section.hasher.Sum(nil)) {
+		return ierrors.Wrapf(ErrChecksumMismatch, "section %s", name)
+	}
+
+	return nil
+}
+
+// Finish verifies that all sections of the snapshot were read.
+func (r *Reader) Finish() error {
+	if r.finished {
+		return nil
+	}
+
+	name, _, err := r.nextSection()
+	if err != nil {
+		return err
+	} else if !r.finished {
+		return ierrors.Wrapf(ErrUnexpectedSection, "expected the end of the snapshot, but found section %s", name)
+	}
+
+	return nil
+}
+
+// Close releases the resources of the Reader. It does not close the underlying input.
+func (r *Reader) Close() {
+	if r.decoder != nil {
+		r.decoder.Close()
+	}
+}
+
+func (r *Reader) nextSection() (name string, length int64, err error) {
+	nameBytes, err := stream.ReadBytesWithSize(r.body, serializer.SeriLengthPrefixTypeAsByte)
+	if err != nil {
+		return "", 0, ierrors.Wrap(err, "failed to read section name")
+	}
+
+	if len(nameBytes) == 0 {
+		r.finished = true
+
+		return "", 0, nil
+	}
+
+	sectionLength, err := stream.Read[uint64](r.body)
+	if err != nil {
+		return "", 0, ierrors.Wrapf(err, "failed to read length of section %s", nameBytes)
+	}
+
+	return string(nameBytes), int64(sectionLength), nil
+}
+
+// sectionReader is an io.ReadSeeker over a single section of a non-seekable stream. It hashes the data while it is
+// read and supports seeking backwards within the rewind window as well as skipping forward.
+type sectionReader struct {
+	source    io.Reader
+	remaining int64
+	position  int64
+	hasher    hash.Hash
+	history   []byte
+	replay    []byte
+}
+
+func newSectionReader(source io.Reader, length int64) *sectionReader {
+	return &sectionReader{
+		source:    source,
+		remaining: length,
+		hasher:    sha256.New(),
+	}
+}
+
+// Read reads up to len(p) bytes of the section. Contrary to most io.Readers it only returns fewer bytes than
+// requested at the end of the section, because the stream helpers expect a single Read to fill the buffer.
+func (s *sectionReader) Read(p []byte) (n int, err error) {
+	if s.unread() == 0 {
+		return 0, io.EOF
+	}
+
+	if len(s.replay) > 0 {
+		n = copy(p, s.replay)
+		s.replay = s.replay[n:]
+		s.advance(p[:n])
+	}
+
+	if n == len(p) || s.remaining == 0 {
+		return n, nil
+	}
+
+	buffer := p[n:]
+	if int64(len(buffer)) > s.remaining {
+		buffer = buffer[:s.remaining]
+	}
+
+	read, err := io.ReadFull(s.source, buffer)
+	_, _ = s.hasher.Write(buffer[:read])
+	s.remaining -= int64(read)
+	s.advance(buffer[:read])
+
+	if ierrors.Is(err, io.EOF) {
+		err = io.ErrUnexpectedEOF
+	}
+
+	return n + read, err
+}
+
+// Seek sets the position for the next Read. Seeking backwards is limited to the rewind window.
+func (s *sectionReader) Seek(offset int64, whence int) (int64, error) {
+	var target int64
+	switch whence {
+	case io.SeekStart:
+		target = offset
+	case io.SeekCurrent:
+		target = s.position + offset
+	case io.SeekEnd:
+		target = s.position + s.unread() + offset
+	default:
+		return 0, ierrors.Errorf("invalid whence: %d", whence)
+	}
+
+	switch {
+	case target < s.position:
+		rewind := s.position - target
+		if rewind > int64(len(s.history)) {
+			return 0, ierrors.Wrapf(ErrSeekNotSupported, "position %d, target %d", s.position, target)
+		}
+
+		rewound := s.history[int64(len(s.history))-rewind:]
+		s.replay = append(append(make([]byte, 0, len(rewound)+len(s.replay)), rewound...), s.replay...)
+		s.history = s.history[:int64(len(s.history))-rewind]
+		s.position = target
+	case target > s.position:
+		if target > s.position+s.unread() {
+			return 0, ierrors.Errorf("cannot seek beyond the end of the section (target %d)", target)
+		}
+
+		if _, err := io.CopyN(io.Discard, s, target-s.position); err != nil {
+			return 0, ierrors.Wrap(err, "failed to skip section data")
+		}
+	}
+
+	return s.position, nil
+}
+
+// unread returns the number of bytes of the section that were not read yet.
+func (s *sectionReader) unread() int64 {
+	return s.remaining + int64(len(s.replay))
+}
+
+func (s *sectionReader) advance(data []byte) {
+	s.position += int64(len(data))
+
+	s.history = append(s.history, data...)
+	if len(s.history) > 2*rewindWindow {
+		s.history = append(s.history[:0], s.history[len(s.history)-rewindWindow:]...)
+	}
+}
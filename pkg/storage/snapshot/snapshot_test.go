@@ -0,0 +1,150 @@
+package snapshot_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/hive.go/serializer/v2"
+	"github.com/iotaledger/hive.go/serializer/v2/stream"
+	"github.com/iotaledger/iota-core/pkg/storage/snapshot"
+)
+
+func writeCollection(t *testing.T, writer io.WriteSeeker, elements []uint64) {
+	require.NoError(t, stream.WriteCollection(writer, serializer.SeriLengthPrefixTypeAsUint32, func() (int, error) {
+		for _, element := range elements {
+			if err := stream.Write(writer, element); err != nil {
+				return 0, err
+			}
+		}
+
+		return len(elements), nil
+	}))
+}
+
+func readCollection(t *testing.T, reader io.ReadSeeker) []uint64 {
+	// PeekSize seeks back after reading the count, which the streaming reader has to support.
+	count, err := stream.PeekSize(reader, serializer.SeriLengthPrefixTypeAsUint32)
+	require.NoError(t, err)
+
+	elements := make([]uint64, 0, count)
+	require.NoError(t, stream.ReadCollection(reader, serializer.SeriLengthPrefixTypeAsUint32, func(int) error {
+		element, err := stream.Read[uint64](reader)
+		if err != nil {
+			return err
+		}
+		elements = append(elements, element)
+
+		return nil
+	}))
+
+	return elements
+}
+
+func writeSnapshot(t *testing.T, opts ...options.Option[snapshot.Writer]) []byte {
+	var buffer bytes.Buffer
+
+	writer, err := snapshot.NewWriter(&buffer, opts...)
+	require.NoError(t, err)
+
+	require.NoError(t, writer.WriteSection("first", func(sectionWriter io.WriteSeeker) error {
+		writeCollection(t, sectionWriter, []uint64{1, 2, 3})
+
+		return nil
+	}))
+
+	require.NoError(t, writer.WriteSection("second", func(sectionWriter io.WriteSeeker) error {
+		elements := make([]uint64, 10000)
+		for i := range elements {
+			elements[i] = uint64(i)
+		}
+		writeCollection(t, sectionWriter, elements)
+
+		return nil
+	}))
+
+	require.NoError(t, writer.Close())
+
+	return buffer.Bytes()
+}
+
+func TestSnapshot_RoundTrip(t *testing.T) {
+	for _, compression := range []snapshot.Compression{snapshot.CompressionNone, snapshot.CompressionZstd} {
+		t.Run(compression.String(), func(t *testing.T) {
+			// a small spool threshold forces the second section to be buffered in a temporary file.
+			data := writeSnapshot(t, snapshot.WithCompression(compression), snapshot.WithSpoolThreshold(1024), snapshot.WithTemporaryDirectory(t.TempDir()))
+
+			isStreamFormat, err := snapshot.IsStreamFormat(bytes.NewReader(data))
+			require.NoError(t, err)
+			require.True(t, isStreamFormat)
+
+			// hide the Seek method of the bytes.Reader to make sure the reader works on plain streams.
+			reader, err := snapshot.NewReader(struct{ io.Reader }{bytes.NewReader(data)})
+			require.NoError(t, err)
+			defer reader.Close()
+
+			require.NoError(t, reader.ReadSection("first", func(sectionReader io.ReadSeeker) error {
+				require.Equal(t, []uint64{1, 2, 3}, readCollection(t, sectionReader))
+
+				return nil
+			}))
+
+			require.NoError(t, reader.ReadSection("second", func(sectionReader io.ReadSeeker) error {
+				elements := readCollection(t, sectionReader)
+				require.Len(t, elements, 10000)
+				require.EqualValues(t, 9999, elements[9999])
+
+				return nil
+			}))
+
+			require.NoError(t, reader.Finish())
+		})
+	}
+}
+
+func TestSnapshot_ChecksumMismatch(t *testing.T) {
+	data := writeSnapshot(t, snapshot.WithCompression(snapshot.CompressionNone))
+
+	// flip a bit in the last element of the first section (header + name + length + count + 2 elements).
+	data[10+1+len("first")+8+4+2*8] ^= 0x01
+
+	reader, err := snapshot.NewReader(bytes.NewReader(data))
+	require.NoError(t, err)
+
+	err = reader.ReadSection("first", func(sectionReader io.ReadSeeker) error {
+		readCollection(t, sectionReader)
+
+		return nil
+	})
+	require.ErrorIs(t, err, snapshot.ErrChecksumMismatch)
+}
+
+func TestSnapshot_UnexpectedSection(t *testing.T) {
+	reader, err := snapshot.NewReader(bytes.NewReader(writeSnapshot(t)))
+	require.NoError(t, err)
+
+	require.ErrorIs(t, reader.ReadSection("second", func(io.ReadSeeker) error { return nil }), snapshot.ErrUnexpectedSection)
+}
+
+func TestSnapshot_PartiallyConsumedSection(t *testing.T) {
+	reader, err := snapshot.NewReader(bytes.NewReader(writeSnapshot(t)))
+	require.NoError(t, err)
+
+	require.Error(t, reader.ReadSection("first", func(sectionReader io.ReadSeeker) error {
+		_, err := stream.Read[uint32](sectionReader)
+
+		return err
+	}))
+}
+
+func TestSnapshot_LegacyFormat(t *testing.T) {
+	isStreamFormat, err := snapshot.IsStreamFormat(bytes.NewReader([]byte{0x01, 0x02, 0x03}))
+	require.NoError(t, err)
+	require.False(t, isStreamFormat)
+
+	_, err = snapshot.NewReader(bytes.NewReader(make([]byte, 32)))
+	require.ErrorIs(t, err, snapshot.ErrInvalidHeader)
+}
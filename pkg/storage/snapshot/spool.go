@@ -0,0 +1,142 @@
+package snapshot
+
+import (
+	"io"
+	"os"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/serializer/v2/stream"
+)
+
+// spool is an io.WriteSeeker that buffers a section in memory and moves it to a temporary file once it exceeds
+// the configured threshold. The components patch collection counts by seeking back, so a section has to be
+// fully materialized before it can be streamed to the non-seekable output.
+type spool struct {
+	memory        *stream.ByteBuffer
+	file          *os.File
+	threshold     int64
+	tempDirectory string
+}
+
+func newSpool(threshold int64, tempDirectory string) *spool {
+	return &spool{
+		memory:        stream.NewByteBuffer(),
+		threshold:     threshold,
+		tempDirectory: tempDirectory,
+	}
+}
+
+// Write writes the given bytes at the current position.
+func (s *spool) Write(p []byte) (n int, err error) {
+	if s.file == nil {
+		position, err := s.memory.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return 0, err
+		}
+
+		if position+int64(len(p)) <= s.threshold {
+			return s.memory.Write(p)
+		}
+
+		if err = s.moveToFile(position); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.file.Write(p)
+}
+
+// Seek sets the position for the next Write.
+func (s *spool) Seek(offset int64, whence int) (int64, error) {
+	if s.file == nil {
+		return s.memory.Seek(offset, whence)
+	}
+
+	return s.file.Seek(offset, whence)
+}
+
+// WriteTo writes the spooled data to the given writer and returns the number of written bytes.
+func (s *spool) WriteTo(writer io.Writer) (int64, error) {
+	if s.file == nil {
+		data, err := s.memory.Bytes()
+		if err != nil {
+			return 0, err
+		}
+
+		n, err := writer.Write(data)
+
+		return int64(n), err
+	}
+
+	if _, err := s.file.Seek(0, io.SeekStart); err != nil {
+		return 0, ierrors.Wrap(err, "failed to rewind spool file")
+	}
+
+	return io.Copy(writer, s.file)
+}
+
+// Size returns the number of spooled bytes.
+func (s *spool) Size() (int64, error) {
+	if s.file == nil {
+		data, err := s.memory.Bytes()
+
+		return int64(len(data)), err
+	}
+
+	info, err := s.file.Stat()
+	if err != nil {
+		return 0, ierrors.Wrap(err, "failed to stat spool file")
+	}
+
+	return info.Size(), nil
+}
+
+// Close releases the resources of the spool.
+func (s *spool) Close() error {
+	if s.file == nil {
+		return nil
+	}
+
+	fileName := s.file.Name()
+	if err := s.file.Close(); err != nil {
+		return ierrors.Wrap(err, "failed to close spool file")
+	}
+
+	if err := os.Remove(fileName); err != nil {
+		return ierrors.Wrap(err, "failed to remove spool file")
+	}
+
+	return nil
+}
+
+func (s *spool) moveToFile(position int64) error {
+	file, err := os.CreateTemp(s.tempDirectory, "snapshot-section-*.tmp")
+	if err != nil {
+		return ierrors.Wrap(err, "failed to create spool file")
+	}
+
+	cleanup := func(err error) error {
+		_ = file.Close()
+		_ = os.Remove(file.Name())
+
+		return err
+	}
+
+	data, err := s.memory.Bytes()
+	if err != nil {
+		return cleanup(err)
+	}
+
+	if _, err = file.Write(data); err != nil {
+		return cleanup(ierrors.Wrap(err, "failed to write spool file"))
+	}
+
+	if _, err = file.Seek(position, io.SeekStart); err != nil {
+		return cleanup(ierrors.Wrap(err, "failed to seek in spool file"))
+	}
+
+	s.file = file
+	s.memory = nil
+
+	return nil
+}
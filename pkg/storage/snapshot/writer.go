@@ -0,0 +1,146 @@
+package snapshot
+
+import (
+	"crypto/sha256"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/hive.go/serializer/v2"
+	"github.com/iotaledger/hive.go/serializer/v2/stream"
+)
+
+// Writer writes snapshots in the streaming container format to a non-seekable io.Writer.
+type Writer struct {
+	output  io.Writer
+	body    io.Writer
+	encoder *zstd.Encoder
+	closed  bool
+
+	optsCompression    Compression
+	optsSpoolThreshold int64
+	optsTempDirectory  string
+}
+
+// NewWriter creates a new Writer that writes the container header to the given output.
+func NewWriter(output io.Writer, opts ...options.Option[Writer]) (*Writer, error) {
+	w := options.Apply(&Writer{
+		output: output,
+
+		optsCompression:    CompressionZstd,
+		optsSpoolThreshold: 64 << 20,
+	}, opts)
+
+	if err := stream.WriteBytes(output, magic); err != nil {
+		return nil, ierrors.Wrap(err, "failed to write snapshot magic")
+	} else if err = stream.Write(output, FormatVersion); err != nil {
+		return nil, ierrors.Wrap(err, "failed to write snapshot format version")
+	} else if err = stream.Write(output, byte(w.optsCompression)); err != nil {
+		return nil, ierrors.Wrap(err, "failed to write snapshot compression")
+	}
+
+	switch w.optsCompression {
+	case CompressionNone:
+		w.body = output
+	case CompressionZstd:
+		encoder, err := zstd.NewWriter(output)
+		if err != nil {
+			return nil, ierrors.Wrap(err, "failed to create zstd encoder")
+		}
+
+		w.encoder = encoder
+		w.body = encoder
+	default:
+		return nil, ierrors.Errorf("unknown snapshot compression: %d", w.optsCompression)
+	}
+
+	return w, nil
+}
+
+// WriteSection writes a section with the given name. The writeFunc receives a seekable writer that only covers the
+// section, so the components can keep using the stream helpers that patch counts after the fact.
+func (w *Writer) WriteSection(name string, writeFunc func(writer io.WriteSeeker) error) (err error) {
+	if w.closed {
+		return ierrors.New("snapshot writer is already closed")
+	}
+
+	if len(name) == 0 || len(name) > maxSectionNameLength {
+		return ierrors.Errorf("invalid snapshot section name '%s'", name)
+	}
+
+	sectionSpool := newSpool(w.optsSpoolThreshold, w.optsTempDirectory)
+	defer func() {
+		if closeErr := sectionSpool.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}()
+
+	if err = writeFunc(sectionSpool); err != nil {
+		return ierrors.Wrapf(err, "failed to write section %s", name)
+	}
+
+	size, err := sectionSpool.Size()
+	if err != nil {
+		return ierrors.Wrapf(err, "failed to determine size of section %s", name)
+	}
+
+	if err = stream.WriteBytesWithSize(w.body, []byte(name), serializer.SeriLengthPrefixTypeAsByte); err != nil {
+		return ierrors.Wrapf(err, "failed to write name of section %s", name)
+	} else if err = stream.Write(w.body, uint64(size)); err != nil {
+		return ierrors.Wrapf(err, "failed to write length of section %s", name)
+	}
+
+	hasher := sha256.New()
+	if _, err = sectionSpool.WriteTo(io.MultiWriter(w.body, hasher)); err != nil {
+		return ierrors.Wrapf(err, "failed to write data of section %s", name)
+	}
+
+	if err = stream.WriteBytes(w.body, hasher.Sum(nil)); err != nil {
+		return ierrors.Wrapf(err, "failed to write checksum of section %s", name)
+	}
+
+	return nil
+}
+
+// Close writes the end marker and flushes the compressed body. It does not close the underlying output.
+func (w *Writer) Close() error {
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+
+	if err := stream.Write(w.body, uint8(0)); err != nil {
+		return ierrors.Wrap(err, "failed to write snapshot end marker")
+	}
+
+	if w.encoder != nil {
+		if err := w.encoder.Close(); err != nil {
+			return ierrors.Wrap(err, "failed to close zstd encoder")
+		}
+	}
+
+	return nil
+}
+
+// WithCompression sets the compression that is used for the body of the container.
+func WithCompression(compression Compression) options.Option[Writer] {
+	return func(w *Writer) {
+		w.optsCompression = compression
+	}
+}
+
+// WithSpoolThreshold sets the size in bytes up to which a section is buffered in memory before it is moved to a temporary file.
+func WithSpoolThreshold(threshold int64) options.Option[Writer] {
+	return func(w *Writer) {
+		w.optsSpoolThreshold = threshold
+	}
+}
+
+// WithTemporaryDirectory sets the directory that is used for the temporary files of large sections.
+func WithTemporaryDirectory(directory string) options.Option[Writer] {
+	return func(w *Writer) {
+		w.optsTempDirectory = directory
+	}
+}
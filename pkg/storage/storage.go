@@ -7,8 +7,10 @@ import (
 	"github.com/iotaledger/hive.go/db"
 	"github.com/iotaledger/hive.go/ds/reactive"
 	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/lo"
 	"github.com/iotaledger/hive.go/log"
 	"github.com/iotaledger/hive.go/runtime/event"
+	"github.com/iotaledger/hive.go/runtime/ioutils"
 	"github.com/iotaledger/hive.go/runtime/options"
 	"github.com/iotaledger/iota-core/pkg/model"
 	"github.com/iotaledger/iota-core/pkg/storage/clonablesql"
@@ -24,6 +26,8 @@ const (
 	prunableDirName    = "prunable"
 	sqlDirName         = "sql"
 	txRetainerFileName = "tx_retainer.db"
+	indexerDirName     = "indexer"
+	indexerFileName    = "indexer.db"
 
 	storePrefixHealth byte = 255
 )
@@ -43,6 +47,9 @@ type Storage struct {
 	// txRetainerSQL is the SQL database for the transaction retainer (holds the transaction metadata).
 	txRetainerSQL *clonablesql.ClonableSQLiteDatabase
 
+	// indexerSQL is the SQL database for the indexer (holds the unspent outputs indexed by their properties).
+	indexerSQL *clonablesql.ClonableSQLiteDatabase
+
 	shutdownOnce sync.Once
 	errorHandler func(error)
 
@@ -54,6 +61,8 @@ type Storage struct {
 	lastAccessedBlocks reactive.Variable[iotago.SlotIndex]
 
 	optsDBEngine                       db.Engine
+	optsReadOnly                       bool
+	optsIndexer                        bool
 	optsAllowedDBEngines               []db.Engine
 	optsPruningDelay                   iotago.EpochIndex
 	optPruningSizeEnabled              bool
@@ -62,13 +71,14 @@ type Storage struct {
 	optsBucketManagerOptions           []options.Option[prunable.BucketManager]
 	optsPruningSizeCooldownTime        time.Duration
 	optsPermanent                      []options.Option[permanent.Permanent]
+	optsArchive                        *prunable.Archive
 }
 
 // newStorage creates a new storage instance with the named database version in the given directory.
+// The directory is not created for a read-only storage.
 func newStorage(directory string, errorHandler func(error), opts ...options.Option[Storage]) *Storage {
 	return options.Apply(&Storage{
 		Pruned:                             event.New1[iotago.EpochIndex](),
-		dir:                                utils.NewDirectory(directory, true),
 		errorHandler:                       errorHandler,
 		lastPrunedEpoch:                    model.NewEvictionIndex[iotago.EpochIndex](),
 		lastAccessedBlocks:                 reactive.NewVariable[iotago.SlotIndex](),
@@ -78,26 +88,64 @@ func newStorage(directory string, errorHandler func(error), opts ...options.Opti
 		optsPruningSizeMaxTargetSizeBytes:  30 * 1024 * 1024 * 1024, // 30GB
 		optsPruningSizeReductionPercentage: 0.1,
 		optsPruningSizeCooldownTime:        5 * time.Minute,
-	}, opts)
+	}, opts, func(s *Storage) {
+		s.dir = utils.NewDirectory(directory, !s.optsReadOnly)
+	})
 }
 
 // Create creates a new storage instance with the named database version in the given directory and initializes its permanent
 // and prunable counterparts.
 func Create(parentLogger log.Logger, directory string, dbVersion byte, errorHandler func(error), opts ...options.Option[Storage]) *Storage {
+	return lo.PanicOnErr(Open(parentLogger, directory, dbVersion, errorHandler, opts...))
+}
+
+// Open works like Create, but returns an error instead of panicking if one of the databases can't be opened (e.g. because it is corrupted).
+// A read-only storage neither creates any directories nor opens the SQL databases.
+func Open(parentLogger log.Logger, directory string, dbVersion byte, errorHandler func(error), opts ...options.Option[Storage]) (*Storage, error) {
 	s := newStorage(directory, errorHandler, opts...)
 
 	dbConfig := database.Config{
 		Engine:       s.optsDBEngine,
-		Directory:    s.dir.PathWithCreate(permanentDirName),
+		Directory:    s.path(permanentDirName),
 		Version:      dbVersion,
 		PrefixHealth: []byte{storePrefixHealth},
+		ReadOnly:     s.optsReadOnly,
+	}
+
+	permanentStorage, err := permanent.Open(dbConfig, errorHandler, s.optsPermanent...)
+	if err != nil {
+		return nil, ierrors.Wrap(err, "failed to open permanent storage")
+	}
+	s.permanent = permanentStorage
+
+	prunableStorage, err := prunable.Open(dbConfig.WithDirectory(s.path(prunableDirName)), s.Settings().APIProvider(), s.errorHandler, s.optsBucketManagerOptions...)
+	if err != nil {
+		s.permanent.Shutdown()
+
+		return nil, ierrors.Wrap(err, "failed to open prunable storage")
+	}
+	s.prunable = prunableStorage
+
+	if s.optsReadOnly {
+		return s, nil
 	}
 
-	s.permanent = permanent.New(dbConfig, errorHandler, s.optsPermanent...)
-	s.prunable = prunable.New(dbConfig.WithDirectory(s.dir.PathWithCreate(prunableDirName)), s.Settings().APIProvider(), s.errorHandler, s.optsBucketManagerOptions...)
 	s.txRetainerSQL = clonablesql.NewClonableSQLiteDatabase(parentLogger.NewChildLogger("tx-retainer-db"), s.dir.PathWithCreate(sqlDirName), txRetainerFileName, s.errorHandler)
 
-	return s
+	if s.optsIndexer {
+		s.indexerSQL = clonablesql.NewClonableSQLiteDatabase(parentLogger.NewChildLogger("indexer-db"), s.dir.PathWithCreate(indexerDirName), indexerFileName, s.errorHandler)
+	}
+
+	return s, nil
+}
+
+// path returns the path of the given subdirectory of the storage, which is only created if the storage is writable.
+func (s *Storage) path(relativePathElements ...string) string {
+	if s.optsReadOnly {
+		return s.dir.Path(relativePathElements...)
+	}
+
+	return s.dir.PathWithCreate(relativePathElements...)
 }
 
 // Clone creates a new storage instance with the named database version in the given directory and cloning the permannent
@@ -131,9 +179,69 @@ func Clone(parentLogger log.Logger, source *Storage, directory string, dbVersion
 	s.prunable = prunableClone
 	s.txRetainerSQL = txRetainerSQLClone
 
+	if s.optsIndexer {
+		if source.indexerSQL == nil {
+			s.indexerSQL = clonablesql.NewClonableSQLiteDatabase(parentLogger.NewChildLogger("indexer-db"), s.dir.PathWithCreate(indexerDirName), indexerFileName, s.errorHandler)
+		} else if s.indexerSQL, err = clonablesql.Clone(parentLogger.NewChildLogger("indexer-db"), source.indexerSQL, s.dir.PathWithCreate(indexerDirName), indexerFileName, s.errorHandler); err != nil {
+			return nil, ierrors.Wrap(err, "error while cloning indexer SQL storage")
+		}
+	}
+
 	return s, nil
 }
 
+// NewArchive opens the archive database of an archive node in the given directory.
+// The archive is shared by all engine instances and has to be passed to their storages with WithArchive.
+func NewArchive(dbEngine db.Engine, directory string, dbVersion byte) *prunable.Archive {
+	return prunable.NewArchive(database.Config{
+		Engine:       dbEngine,
+		Directory:    utils.NewDirectory(directory, true).Path(),
+		Version:      dbVersion,
+		PrefixHealth: []byte{storePrefixHealth},
+	})
+}
+
+// KVStoreDirectories returns the directories of all key-value stores of the storage located in the given directory.
+// This includes the permanent store, the semi-permanent store and all prunable epoch buckets.
+func KVStoreDirectories(directory string) ([]string, error) {
+	dir := utils.NewDirectory(directory)
+
+	directories := []string{dir.Path(permanentDirName)}
+
+	exists, isDir, err := ioutils.PathExists(dir.Path(prunableDirName))
+	if err != nil {
+		return nil, ierrors.Wrapf(err, "unable to check prunable directory (%s)", dir.Path(prunableDirName))
+	}
+	if !exists || !isDir {
+		return directories, nil
+	}
+
+	if exists, isDir, err = ioutils.PathExists(dir.Path(prunableDirName, prunable.SemiPermanentDirName)); err == nil && exists && isDir {
+		directories = append(directories, dir.Path(prunableDirName, prunable.SemiPermanentDirName))
+	}
+
+	epochs, err := prunable.BucketEpochsFromDisk(dir.Path(prunableDirName))
+	if err != nil {
+		return nil, ierrors.Wrapf(err, "unable to list prunable buckets (%s)", dir.Path(prunableDirName))
+	}
+
+	for _, epoch := range epochs {
+		directories = append(directories, prunable.BucketDirectory(dir.Path(prunableDirName), epoch))
+	}
+
+	return directories, nil
+}
+
+// SQLDirectory returns the directory of the SQL databases of the storage located in the given directory.
+func SQLDirectory(directory string) string {
+	return utils.NewDirectory(directory).Path(sqlDirName)
+}
+
+// IndexerDirectory returns the directory of the SQL database of the indexer of the storage located in the given directory.
+func IndexerDirectory(directory string) string {
+	return utils.NewDirectory(directory).Path(indexerDirName)
+}
+
 func (s *Storage) Directory() string {
 	return s.dir.Path()
 }
@@ -150,11 +258,39 @@ func (s *Storage) PrunableDatabaseSize() int64 {
 
 // TransactionRetainerDatabaseSize returns the size of the underlying SQL database of the transaction retainer.
 func (s *Storage) TransactionRetainerDatabaseSize() int64 {
+	if s.txRetainerSQL == nil {
+		return 0
+	}
+
 	return s.txRetainerSQL.Size()
 }
 
+// IndexerDatabaseSize returns the size of the underlying SQL database of the indexer.
+func (s *Storage) IndexerDatabaseSize() int64 {
+	if s.indexerSQL == nil {
+		return 0
+	}
+
+	return s.indexerSQL.Size()
+}
+
+// ArchiveDatabaseSize returns the size of the underlying archive database (0 if the node is not an archive node).
+func (s *Storage) ArchiveDatabaseSize() int64 {
+	if s.optsArchive == nil {
+		return 0
+	}
+
+	return s.optsArchive.Size()
+}
+
+// IsArchive returns true if the data of pruned epochs is moved to an archive instead of being deleted.
+func (s *Storage) IsArchive() bool {
+	return s.optsArchive != nil
+}
+
+// Size returns the size of the storage without the archive, which is not subject to pruning.
 func (s *Storage) Size() int64 {
-	return s.PermanentDatabaseSize() + s.PrunableDatabaseSize() + s.TransactionRetainerDatabaseSize()
+	return s.PermanentDatabaseSize() + s.PrunableDatabaseSize() + s.TransactionRetainerDatabaseSize() + s.IndexerDatabaseSize()
 }
 
 func (s *Storage) RestoreFromDisk() {
@@ -179,7 +315,12 @@ func (s *Storage) Shutdown() {
 	s.shutdownOnce.Do(func() {
 		s.permanent.Shutdown()
 		s.prunable.Shutdown()
-		s.txRetainerSQL.Shutdown()
+		if s.txRetainerSQL != nil {
+			s.txRetainerSQL.Shutdown()
+		}
+		if s.indexerSQL != nil {
+			s.indexerSQL.Shutdown()
+		}
 	})
 }
 
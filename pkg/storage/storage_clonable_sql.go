@@ -11,3 +11,8 @@ type (
 func (s *Storage) TransactionRetainerDatabaseExecFunc() SQLDatabaseExecFunc {
 	return s.txRetainerSQL.ExecDBFunc()
 }
+
+// IndexerDatabaseExecFunc returns the function to access the SQL database of the indexer, which only exists if the storage was created WithIndexer.
+func (s *Storage) IndexerDatabaseExecFunc() SQLDatabaseExecFunc {
+	return s.indexerSQL.ExecDBFunc()
+}
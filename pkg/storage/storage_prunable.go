@@ -8,6 +8,7 @@ import (
 	"github.com/iotaledger/hive.go/serializer/v2/stream"
 	"github.com/iotaledger/iota-core/pkg/core/account"
 	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/storage/database"
 	"github.com/iotaledger/iota-core/pkg/storage/prunable/epochstore"
 	"github.com/iotaledger/iota-core/pkg/storage/prunable/slotstore"
 	iotago "github.com/iotaledger/iota.go/v4"
@@ -42,7 +43,13 @@ func (s *Storage) Blocks(slot iotago.SlotIndex) (*slotstore.Blocks, error) {
 		return max(lastAccessedBlocks, slot)
 	})
 
-	return s.prunable.Blocks(slot)
+	// blocks of pruned epochs are served read-only from the archive.
+	blocks, err := s.prunable.Blocks(slot)
+	if err != nil && s.optsArchive != nil && ierrors.Is(err, database.ErrEpochPruned) {
+		return s.optsArchive.Blocks(slot, s.Settings().APIProvider().APIForSlot(slot))
+	}
+
+	return blocks, err
 }
 
 // Reset resets the component to a clean state as if it was created at the last commitment.
@@ -249,7 +256,12 @@ func (s *Storage) BlockMetadata(slot iotago.SlotIndex) (*slotstore.BlockMetadata
 		return nil, ierrors.Wrap(err, "failed to advance latest stored slot when accessing block metadata")
 	}
 
-	return s.prunable.BlockMetadata(slot)
+	blockMetadata, err := s.prunable.BlockMetadata(slot)
+	if err != nil && s.optsArchive != nil && ierrors.Is(err, database.ErrEpochPruned) {
+		return s.optsArchive.BlockMetadata(slot, s.Settings().APIProvider().APIForSlot(slot))
+	}
+
+	return blockMetadata, err
 }
 
 func (s *Storage) pruningRange(targetSlot iotago.SlotIndex) (targetEpoch iotago.EpochIndex, startSlot iotago.SlotIndex, endSlot iotago.SlotIndex) {
@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"context"
 	"time"
 
 	"github.com/iotaledger/hive.go/ierrors"
@@ -55,8 +56,14 @@ func (s *Storage) TryPrune() error {
 // PruneByEpochIndex prunes the database until the given epoch. It returns an error if the epoch is too old or too new.
 // It is to be called by the user e.g. via the WebAPI.
 func (s *Storage) PruneByEpochIndex(epoch iotago.EpochIndex) error {
+	return s.PruneByEpochIndexWithContext(context.Background(), epoch, nil)
+}
+
+// PruneByEpochIndexWithContext works like PruneByEpochIndex, but stops before the next epoch if the context is canceled.
+// The optional progressFunc is called with every epoch that was pruned.
+func (s *Storage) PruneByEpochIndexWithContext(ctx context.Context, epoch iotago.EpochIndex, progressFunc func(prunedEpoch iotago.EpochIndex)) error {
 	// Make sure epoch is not too recent or not yet finalized.
-	latestPrunableEpoch := s.latestPrunableEpoch()
+	latestPrunableEpoch := s.LatestPrunableEpoch()
 	if epoch > latestPrunableEpoch {
 		return ierrors.Errorf("epoch %d is too new, latest prunable epoch is %d", epoch, latestPrunableEpoch)
 	}
@@ -73,7 +80,7 @@ func (s *Storage) PruneByEpochIndex(epoch iotago.EpochIndex) error {
 	s.setIsPruning(true)
 	defer s.setIsPruning(false)
 
-	if err := s.pruneUntilEpoch(start, epoch, 0); err != nil {
+	if err := s.pruneUntilEpoch(ctx, start, epoch, 0, progressFunc); err != nil {
 		return ierrors.Wrapf(err, "failed to prune from epoch %d to %d", start, epoch)
 	}
 
@@ -81,12 +88,18 @@ func (s *Storage) PruneByEpochIndex(epoch iotago.EpochIndex) error {
 }
 
 func (s *Storage) PruneByDepth(epochDepth iotago.EpochIndex) (firstPruned iotago.EpochIndex, lastPruned iotago.EpochIndex, err error) {
+	return s.PruneByDepthWithContext(context.Background(), epochDepth, nil)
+}
+
+// PruneByDepthWithContext works like PruneByDepth, but stops before the next epoch if the context is canceled.
+// The optional progressFunc is called with every epoch that was pruned.
+func (s *Storage) PruneByDepthWithContext(ctx context.Context, epochDepth iotago.EpochIndex, progressFunc func(prunedEpoch iotago.EpochIndex)) (firstPruned iotago.EpochIndex, lastPruned iotago.EpochIndex, err error) {
 	// Depth of 0 and 1 means we prune to the latestPrunableEpoch.
 	if epochDepth == 0 {
 		epochDepth = 1
 	}
 
-	latestPrunableEpoch := s.latestPrunableEpoch()
+	latestPrunableEpoch := s.LatestPrunableEpoch()
 	if epochDepth > latestPrunableEpoch {
 		return 0, 0, ierrors.WithMessagef(database.ErrNoPruningNeeded, "epochDepth %d is too big, latest prunable epoch is %d", epochDepth, latestPrunableEpoch)
 	}
@@ -106,7 +119,7 @@ func (s *Storage) PruneByDepth(epochDepth iotago.EpochIndex) (firstPruned iotago
 	s.setIsPruning(true)
 	defer s.setIsPruning(false)
 
-	if err := s.pruneUntilEpoch(start, end, epochDepth); err != nil {
+	if err := s.pruneUntilEpoch(ctx, start, end, epochDepth, progressFunc); err != nil {
 		return 0, 0, ierrors.Wrapf(err, "failed to prune from epoch %d to %d", start, end)
 	}
 
@@ -114,6 +127,12 @@ func (s *Storage) PruneByDepth(epochDepth iotago.EpochIndex) (firstPruned iotago
 }
 
 func (s *Storage) PruneBySize(targetSizeMaxBytes ...int64) error {
+	return s.PruneBySizeWithContext(context.Background(), nil, targetSizeMaxBytes...)
+}
+
+// PruneBySizeWithContext works like PruneBySize, but stops before the next epoch if the context is canceled.
+// The optional progressFunc is called with every epoch that was pruned.
+func (s *Storage) PruneBySizeWithContext(ctx context.Context, progressFunc func(prunedEpoch iotago.EpochIndex), targetSizeMaxBytes ...int64) error {
 	// pruning by size deactivated
 	if !s.optPruningSizeEnabled && len(targetSizeMaxBytes) == 0 {
 		return database.ErrNoPruningNeeded
@@ -142,7 +161,7 @@ func (s *Storage) PruneBySize(targetSizeMaxBytes ...int64) error {
 		return database.ErrNoPruningNeeded
 	}
 
-	latestPrunableEpoch := s.latestPrunableEpoch()
+	latestPrunableEpoch := s.LatestPrunableEpoch()
 
 	// Make sure epoch is not already pruned.
 	start, canPrune := s.getPruningStart(latestPrunableEpoch)
@@ -168,7 +187,7 @@ func (s *Storage) PruneBySize(targetSizeMaxBytes ...int64) error {
 		totalBytesToPrune -= int64(float64(bucketSize) * 1.2)
 
 		// Actually prune the epoch.
-		if err := s.pruneUntilEpoch(prunedEpoch, prunedEpoch, 0); err != nil {
+		if err := s.pruneUntilEpoch(ctx, prunedEpoch, prunedEpoch, 0, progressFunc); err != nil {
 			return ierrors.Wrapf(err, "failed to prune epoch %d", prunedEpoch)
 		}
 
@@ -196,7 +215,8 @@ func (s *Storage) getPruningStart(epoch iotago.EpochIndex) (iotago.EpochIndex, b
 	return s.lastPrunedEpoch.NextIndex(), true
 }
 
-func (s *Storage) latestPrunableEpoch() iotago.EpochIndex {
+// LatestPrunableEpoch returns the most recent epoch that can be pruned while keeping at least one full finalized epoch.
+func (s *Storage) LatestPrunableEpoch() iotago.EpochIndex {
 	latestFinalizedSlot := s.Settings().LatestFinalizedSlot()
 	currentFinalizedEpoch := s.Settings().APIProvider().APIForSlot(latestFinalizedSlot).TimeProvider().EpochFromSlot(latestFinalizedSlot)
 
@@ -208,22 +228,51 @@ func (s *Storage) latestPrunableEpoch() iotago.EpochIndex {
 	return currentFinalizedEpoch - 2
 }
 
-// PruneUntilEpoch prunes the database until the given epoch.
+// PruneUntilEpoch prunes the database epoch by epoch until the given epoch or until the context is canceled.
 // The caller needs to make sure that the start and target epoch take into account the specified pruning delay.
-func (s *Storage) pruneUntilEpoch(startEpoch iotago.EpochIndex, targetEpoch iotago.EpochIndex, pruningDelay iotago.EpochIndex) error {
+func (s *Storage) pruneUntilEpoch(ctx context.Context, startEpoch iotago.EpochIndex, targetEpoch iotago.EpochIndex, pruningDelay iotago.EpochIndex, progressFunc func(prunedEpoch iotago.EpochIndex)) error {
 	for currentEpoch := startEpoch; currentEpoch <= targetEpoch; currentEpoch++ {
-		if err := s.prunable.Prune(currentEpoch, pruningDelay); err != nil {
-			return ierrors.Wrapf(err, "failed to prune epoch %d in prunable", currentEpoch)
+		if err := ctx.Err(); err != nil {
+			return ierrors.Wrapf(err, "canceled before pruning epoch %d", currentEpoch)
+		}
+
+		if err := s.pruneEpoch(currentEpoch, pruningDelay); err != nil {
+			return err
+		}
+
+		// every epoch is marked as pruned on its own, so that the pruning can be stopped between two epochs.
+		s.lastPrunedEpoch.MarkEvicted(currentEpoch)
+
+		s.Pruned.Trigger(currentEpoch)
+
+		if progressFunc != nil {
+			progressFunc(currentEpoch)
 		}
+	}
+
+	return nil
+}
 
-		if err := s.permanent.PruneUTXOLedger(currentEpoch); err != nil {
-			return ierrors.Wrapf(err, "failed to prune epoch %d in permanent", currentEpoch)
+// pruneEpoch prunes the data of the given epoch.
+func (s *Storage) pruneEpoch(epoch iotago.EpochIndex, pruningDelay iotago.EpochIndex) error {
+	if s.optsArchive != nil {
+		if err := s.prunable.Archive(epoch, s.optsArchive); err != nil {
+			return ierrors.Wrapf(err, "failed to archive epoch %d", epoch)
 		}
 	}
 
-	s.lastPrunedEpoch.MarkEvicted(targetEpoch)
+	if err := s.prunable.Prune(epoch, pruningDelay); err != nil {
+		return ierrors.Wrapf(err, "failed to prune epoch %d in prunable", epoch)
+	}
 
-	s.Pruned.Trigger(targetEpoch)
+	// an archive node keeps the slot diffs and spent outputs of the UTXO ledger to serve the full history.
+	if s.optsArchive != nil {
+		return nil
+	}
+
+	if err := s.permanent.PruneUTXOLedger(epoch); err != nil {
+		return ierrors.Wrapf(err, "failed to prune epoch %d in permanent", epoch)
+	}
 
 	return nil
 }
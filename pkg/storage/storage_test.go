@@ -1,10 +1,12 @@
 package storage_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/iotaledger/hive.go/db"
 	"github.com/iotaledger/hive.go/ds/types"
 	"github.com/iotaledger/hive.go/kvstore"
 	"github.com/iotaledger/iota-core/pkg/storage"
@@ -52,6 +54,106 @@ func TestStorage_PruneByEpochIndex(t *testing.T) {
 	require.ErrorContains(t, err, "too old")
 }
 
+func TestStorage_PruneByEpochIndexWithContext(t *testing.T) {
+	tf := NewTestFramework(t, t.TempDir())
+	defer tf.Shutdown()
+
+	totalEpochs := 10
+	tf.GeneratePermanentData(10 * MB)
+	for i := 0; i <= totalEpochs; i++ {
+		tf.GeneratePrunableData(iotago.EpochIndex(i), 10*KB)
+		tf.GenerateSemiPermanentData(iotago.EpochIndex(i))
+	}
+
+	tf.SetLatestFinalizedEpoch(9)
+
+	// the pruning stops before the next epoch once the context is canceled.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var prunedEpochs []iotago.EpochIndex
+	err := tf.Instance.PruneByEpochIndexWithContext(ctx, 7, func(prunedEpoch iotago.EpochIndex) {
+		prunedEpochs = append(prunedEpochs, prunedEpoch)
+
+		if prunedEpoch == 3 {
+			cancel()
+		}
+	})
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, []iotago.EpochIndex{0, 1, 2, 3}, prunedEpochs)
+
+	lastPrunedEpoch, hasPruned := tf.Instance.LastPrunedEpoch()
+	require.True(t, hasPruned)
+	require.EqualValues(t, 3, lastPrunedEpoch)
+	require.False(t, tf.Instance.IsPruning())
+
+	// the next call continues with the next epoch.
+	prunedEpochs = nil
+	require.NoError(t, tf.Instance.PruneByEpochIndexWithContext(context.Background(), 7, func(prunedEpoch iotago.EpochIndex) {
+		prunedEpochs = append(prunedEpochs, prunedEpoch)
+	}))
+	require.Equal(t, []iotago.EpochIndex{4, 5, 6, 7}, prunedEpochs)
+
+	tf.AssertPrunedUntil(
+		types.NewTuple(7, true),
+		types.NewTuple(0, true),
+		types.NewTuple(0, false),
+		types.NewTuple(0, false),
+		types.NewTuple(0, false),
+	)
+}
+
+func TestStorage_Archive(t *testing.T) {
+	archive := storage.NewArchive(db.EngineRocksDB, t.TempDir(), 0)
+	defer archive.Shutdown()
+
+	tf := NewTestFramework(t, t.TempDir(), storage.WithArchive(archive))
+	defer tf.Shutdown()
+
+	totalEpochs := 10
+	for i := 0; i <= totalEpochs; i++ {
+		tf.GeneratePrunableData(iotago.EpochIndex(i), 10*KB)
+		tf.GenerateSemiPermanentData(iotago.EpochIndex(i))
+	}
+
+	archivedSlot := tf.Instance.Settings().APIProvider().APIForEpoch(3).TimeProvider().EpochStart(3)
+	archivedBlock := tf.StoreRandomBlock(archivedSlot)
+	tf.Instance.Flush()
+
+	tf.SetLatestFinalizedEpoch(9)
+
+	require.NoError(t, tf.Instance.PruneByEpochIndex(7))
+	tf.AssertPrunedUntil(
+		types.NewTuple(7, true),
+		types.NewTuple(0, true),
+		types.NewTuple(0, false),
+		types.NewTuple(0, false),
+		types.NewTuple(0, false),
+	)
+
+	for epoch := iotago.EpochIndex(0); epoch <= 7; epoch++ {
+		archived, err := archive.IsArchived(epoch)
+		require.NoError(t, err)
+		require.Truef(t, archived, "expected epoch %d to be archived", epoch)
+	}
+
+	archived, err := archive.IsArchived(8)
+	require.NoError(t, err)
+	require.False(t, archived)
+
+	// blocks of pruned epochs are served from the archive.
+	blocks, err := tf.Instance.Blocks(archivedSlot)
+	require.NoError(t, err)
+
+	loadedBlock, err := blocks.Load(archivedBlock.ID())
+	require.NoError(t, err)
+	require.NotNil(t, loadedBlock)
+	require.Equal(t, archivedBlock.Data(), loadedBlock.Data())
+
+	_, err = tf.Instance.BlockMetadata(archivedSlot)
+	require.NoError(t, err)
+}
+
 func TestStorage_PruneByDepth(t *testing.T) {
 	tf := NewTestFramework(t, t.TempDir())
 	defer tf.Shutdown()
@@ -21,6 +21,7 @@ import (
 	"github.com/iotaledger/iota-core/pkg/storage/database"
 	"github.com/iotaledger/iota-core/pkg/storage/prunable/epochstore"
 	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/api"
 	"github.com/iotaledger/iota.go/v4/tpkg"
 )
 
@@ -125,6 +126,28 @@ func (t *TestFramework) GeneratePrunableData(epoch iotago.EpochIndex, size int64
 	// fmt.Printf("> created %d MB of bucket prunable data\n\tPermanent: %dMB\n\tPrunable: %dMB\n", createdBytes/MB, t.Instance.PermanentDatabaseSize()/MB, t.Instance.PrunableDatabaseSize()/MB)
 }
 
+// StoreRandomBlock stores a random block in the given slot and returns it.
+func (t *TestFramework) StoreRandomBlock(slot iotago.SlotIndex) *model.Block {
+	block := tpkg.RandBlock(&iotago.BasicBlockBody{
+		StrongParents: tpkg.SortedRandBlockIDs(1),
+		Payload:       &iotago.TaggedData{Data: tpkg.RandBytes(64)},
+		MaxBurnedMana: 1000,
+	}, t.apiProvider.APIForSlot(slot), 0)
+	block.Header.IssuingTime = t.apiProvider.APIForSlot(slot).TimeProvider().SlotStartTime(slot)
+	// the block needs to commit to a slot within the committable age to pass the syntactic validation when it is loaded.
+	block.Header.SlotCommitmentID = iotago.NewCommitmentID(slot-t.apiProvider.APIForSlot(slot).ProtocolParameters().MinCommittableAge(), tpkg.Rand32ByteArray())
+
+	modelBlock, err := model.BlockFromBlock(block)
+	require.NoError(t.t, err)
+	require.Equal(t.t, slot, modelBlock.ID().Slot())
+
+	blockStorageForSlot, err := t.Instance.Blocks(slot)
+	require.NoError(t.t, err)
+	require.NoError(t.t, blockStorageForSlot.Store(modelBlock))
+
+	return modelBlock
+}
+
 func (t *TestFramework) GenerateSemiPermanentData(epoch iotago.EpochIndex) {
 	rewardsKV, err := t.Instance.RewardsForEpoch(epoch)
 	require.NoError(t.t, err)
@@ -293,8 +316,14 @@ func (t *TestFramework) assertPrunableSlotStoragesPruned(epoch iotago.EpochIndex
 	// Check that all storages return the expected error when trying to access the data.
 	endSlot := t.apiProvider.APIForEpoch(epoch).TimeProvider().EpochEnd(epoch)
 
-	_, err := t.Instance.Blocks(endSlot)
-	require.ErrorIsf(t.t, err, database.ErrEpochPruned, "expected epoch %d to be pruned", epoch)
+	// archive nodes keep serving the blocks of pruned epochs, but they can't be modified anymore.
+	blocks, err := t.Instance.Blocks(endSlot)
+	if t.Instance.IsArchive() {
+		require.NoErrorf(t.t, err, "expected epoch %d to be archived", epoch)
+		require.ErrorIsf(t.t, blocks.Delete(iotago.EmptyBlockID), database.ErrReadOnly, "expected archived blocks of epoch %d to be read-only", epoch)
+	} else {
+		require.ErrorIsf(t.t, err, database.ErrEpochPruned, "expected epoch %d to be pruned", epoch)
+	}
 
 	_, err = t.Instance.RootBlocks(endSlot)
 	require.ErrorIsf(t.t, err, database.ErrEpochPruned, "expected epoch %d to be pruned", epoch)
@@ -314,8 +343,13 @@ func (t *TestFramework) assertPrunableSlotStoragesPruned(epoch iotago.EpochIndex
 	_, err = t.Instance.Roots(endSlot)
 	require.ErrorIsf(t.t, err, database.ErrEpochPruned, "expected epoch %d to be pruned", epoch)
 
-	_, err = t.Instance.BlockMetadata(endSlot)
-	require.ErrorIsf(t.t, err, database.ErrEpochPruned, "expected epoch %d to be pruned", epoch)
+	blockMetadata, err := t.Instance.BlockMetadata(endSlot)
+	if t.Instance.IsArchive() {
+		require.NoErrorf(t.t, err, "expected epoch %d to be archived", epoch)
+		require.ErrorIsf(t.t, blockMetadata.StoreBlockMetadata(iotago.EmptyBlockID, api.BlockStateAccepted), database.ErrReadOnly, "expected archived block metadata of epoch %d to be read-only", epoch)
+	} else {
+		require.ErrorIsf(t.t, err, database.ErrEpochPruned, "expected epoch %d to be pruned", epoch)
+	}
 }
 
 func (t *TestFramework) assertPrunedState(expected *types.Tuple[int, bool], prunedStateFunc func() (iotago.EpochIndex, bool), name string) {
@@ -0,0 +1,125 @@
+package tests
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/iota-core/pkg/protocol"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/utxoledger"
+	"github.com/iotaledger/iota-core/pkg/storage/snapshot"
+	"github.com/iotaledger/iota-core/pkg/testsuite"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// Test_SnapshotStreamRoundTrip exports a snapshot in the streaming container format through a non-seekable pipe,
+// starts a new node from it and checks that the commitments, the ledger and the accounts of both nodes are equal.
+func Test_SnapshotStreamRoundTrip(t *testing.T) {
+	ts := testsuite.NewTestSuite(t,
+		testsuite.WithProtocolParametersOptions(
+			iotago.WithTimeProviderOptions(
+				0,
+				testsuite.GenesisTimeWithOffsetBySlots(100, testsuite.DefaultSlotDurationInSeconds),
+				testsuite.DefaultSlotDurationInSeconds,
+				3,
+			),
+			iotago.WithLivenessOptions(
+				10,
+				10,
+				2,
+				4,
+				5,
+			),
+		),
+	)
+	defer ts.Shutdown()
+
+	node0 := ts.AddValidatorNode("node0")
+	ts.AddDefaultWallet(node0)
+	ts.AddValidatorNode("node1")
+	ts.Run(true, nil)
+
+	// Issue up to slot 10, committing slot 8.
+	ts.IssueBlocksAtSlots("", []iotago.SlotIndex{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, 3, "Genesis", ts.Nodes(), true, false)
+	ts.AssertNodeState(ts.Nodes(),
+		testsuite.WithLatestFinalizedSlot(7),
+		testsuite.WithLatestCommitmentSlotIndex(8),
+		testsuite.WithEqualStoredCommitmentAtIndex(8),
+	)
+
+	sourceEngine := node0.Protocol.Engines.Main.Get()
+	targetSlot := sourceEngine.Storage.Settings().LatestCommitment().Slot()
+
+	snapshotReader, snapshotWriter := io.Pipe()
+	go func() {
+		snapshotWriter.CloseWithError(sourceEngine.ExportStream(snapshotWriter, targetSlot, snapshot.WithCompression(snapshot.CompressionZstd)))
+	}()
+
+	node2 := ts.AddNode("node2")
+	node2.Initialize(true,
+		protocol.WithEngineOptions(engine.WithSnapshotReader(snapshotReader)),
+		protocol.WithBaseDirectory(ts.Directory.PathWithCreate(node2.Name)),
+	)
+	ts.Wait()
+
+	targetEngine := node2.Protocol.Engines.Main.Get()
+	require.Equal(t, targetSlot, targetEngine.Storage.Settings().LatestCommitment().Slot())
+
+	// commitments
+	for slot := ts.API.ProtocolParameters().GenesisSlot(); slot <= targetSlot; slot++ {
+		sourceCommitment, err := sourceEngine.Storage.Commitments().Load(slot)
+		require.NoError(t, err)
+
+		targetCommitment, err := targetEngine.Storage.Commitments().Load(slot)
+		require.NoError(t, err)
+
+		require.Equal(t, sourceCommitment.ID(), targetCommitment.ID(), "commitment of slot %d", slot)
+	}
+
+	// ledger
+	unspentOutputs := func(e *engine.Engine) map[iotago.OutputID][]byte {
+		outputs := make(map[iotago.OutputID][]byte)
+		require.NoError(t, e.Ledger.ForEachUnspentOutput(func(output *utxoledger.Output) bool {
+			outputs[output.OutputID()] = output.SnapshotBytes()
+
+			return true
+		}))
+
+		return outputs
+	}
+
+	sourceOutputs := unspentOutputs(sourceEngine)
+	require.NotEmpty(t, sourceOutputs)
+	require.Equal(t, sourceOutputs, unspentOutputs(targetEngine))
+	require.Equal(t, sourceEngine.Storage.Ledger().StateTreeRoot(), targetEngine.Storage.Ledger().StateTreeRoot())
+
+	// accounts
+	require.Equal(t, sourceEngine.Ledger.AccountRoot(), targetEngine.Ledger.AccountRoot())
+
+	require.NoError(t, sourceEngine.Ledger.ForEachUnspentOutput(func(output *utxoledger.Output) bool {
+		accountOutput, isAccountOutput := output.Output().(*iotago.AccountOutput)
+		if !isAccountOutput {
+			return true
+		}
+
+		accountID := accountOutput.AccountID
+		if accountID.Empty() {
+			accountID = iotago.AccountIDFromOutputID(output.OutputID())
+		}
+
+		sourceAccount, exists, err := sourceEngine.Ledger.Account(accountID, targetSlot)
+		require.NoError(t, err)
+		require.True(t, exists)
+
+		targetAccount, exists, err := targetEngine.Ledger.Account(accountID, targetSlot)
+		require.NoError(t, err)
+		require.True(t, exists, "account %s", accountID)
+
+		require.Equal(t, lo.PanicOnErr(sourceAccount.Bytes()), lo.PanicOnErr(targetAccount.Bytes()), "account %s", accountID)
+
+		return true
+	}))
+}
@@ -0,0 +1,408 @@
+package toolset
+
+import (
+	"fmt"
+	"os"
+
+	flag "github.com/spf13/pflag"
+
+	"github.com/iotaledger/hive.go/ads"
+	"github.com/iotaledger/hive.go/app/configuration"
+	hivedb "github.com/iotaledger/hive.go/db"
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/log"
+	"github.com/iotaledger/hive.go/runtime/module"
+	"github.com/iotaledger/iota-core/pkg/protocol"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/accounts"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/accounts/accountsledger"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/eviction"
+	"github.com/iotaledger/iota-core/pkg/storage"
+	"github.com/iotaledger/iota-core/pkg/storage/database"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+const (
+	dbCheckCommitmentLedgerState = "commitment-ledger-state"
+	dbCheckLedgerBalance         = "ledger-balance"
+	dbCheckCommitmentChain       = "commitment-chain"
+	dbCheckRootBlocks            = "root-blocks"
+	dbCheckAccountsTree          = "accounts-tree"
+)
+
+// dbCheckMismatch is a single inconsistency found by a database check.
+type dbCheckMismatch struct {
+	Slot  *iotago.SlotIndex `json:"slot,omitempty"`
+	Error string            `json:"error"`
+}
+
+// dbCheckResult is the result of a single database check.
+type dbCheckResult struct {
+	Name       string             `json:"name"`
+	Passed     bool               `json:"passed"`
+	Mismatches []*dbCheckMismatch `json:"mismatches,omitempty"`
+}
+
+func (r *dbCheckResult) addMismatch(err error, slot ...iotago.SlotIndex) {
+	mismatch := &dbCheckMismatch{Error: err.Error()}
+	if len(slot) > 0 {
+		mismatch.Slot = &slot[0]
+	}
+
+	r.Passed = false
+	r.Mismatches = append(r.Mismatches, mismatch)
+}
+
+// dbCheckReport is the result of all database checks.
+type dbCheckReport struct {
+	EngineDirectory     string           `json:"engineDirectory"`
+	LatestCommitmentID  string           `json:"latestCommitmentId"`
+	LatestCommittedSlot iotago.SlotIndex `json:"latestCommittedSlot"`
+	LatestFinalizedSlot iotago.SlotIndex `json:"latestFinalizedSlot"`
+	FirstUnprunedSlot   iotago.SlotIndex `json:"firstUnprunedSlot"`
+	Healthy             bool             `json:"healthy"`
+	// Error is set if the database could not be opened, in which case no checks were run.
+	Error  string           `json:"error,omitempty"`
+	Checks []*dbCheckResult `json:"checks"`
+}
+
+func databaseCheck(args []string) error {
+	fs := configuration.NewUnsortedFlagSet("", flag.ContinueOnError)
+	databasePathFlag := fs.String(FlagToolDatabasePath, DefaultValueDatabasePath, "the path to the database")
+	databaseEngineFlag := fs.String(FlagToolDatabaseEngine, string(hivedb.EngineRocksDB), "the engine of the database (values: rocksdb)")
+	outputJSONFlag := fs.Bool(FlagToolOutputJSON, false, FlagToolDescriptionOutputJSON)
+
+	fs.Usage = func() {
+		_, _ = fmt.Fprintf(os.Stderr, "Usage of %s:\n", ToolDatabaseCheck)
+		fs.PrintDefaults()
+		println(fmt.Sprintf("\nexample: %s --%s %s",
+			ToolDatabaseCheck,
+			FlagToolDatabasePath,
+			DefaultValueDatabasePath))
+	}
+
+	if err := parseFlagSet(fs, args); err != nil {
+		return err
+	}
+
+	if len(*databasePathFlag) == 0 {
+		return ierrors.Errorf("'%s' not specified", FlagToolDatabasePath)
+	}
+
+	dbEngine, err := hivedb.EngineFromStringAllowed(*databaseEngineFlag, database.AllowedEnginesStorage)
+	if err != nil {
+		return err
+	}
+
+	report, err := checkDatabase(*databasePathFlag, dbEngine)
+	if err != nil {
+		return err
+	}
+
+	if *outputJSONFlag {
+		if err := printJSON(report); err != nil {
+			return err
+		}
+	} else {
+		printDatabaseCheckReport(report)
+	}
+
+	if !report.Healthy {
+		return ierrors.New("database check failed")
+	}
+
+	return nil
+}
+
+// checkDatabase opens the main engine storage of the database and runs all database checks on it.
+// A database that can't be opened is reported as unhealthy, other storage errors are returned.
+func checkDatabase(databasePath string, dbEngine hivedb.Engine) (*dbCheckReport, error) {
+	engineDirectory, err := protocol.MainEngineDirectory(databasePath)
+	if err != nil {
+		return nil, err
+	}
+
+	var storageErr error
+	errorHandler := func(err error) {
+		storageErr = err
+	}
+
+	logger := log.NewLogger(log.WithName(ToolDatabaseCheck), log.WithLevel(log.LevelWarning))
+
+	// the storage is opened read-only, so that the check neither creates nor modifies any files.
+	var report *dbCheckReport
+	if s, err := storage.Open(logger, engineDirectory, protocol.DatabaseVersion, errorHandler,
+		storage.WithDBEngine(dbEngine),
+		storage.WithReadOnly(true),
+	); err != nil {
+		report = &dbCheckReport{Error: err.Error()}
+	} else {
+		defer s.Shutdown()
+
+		s.RestoreFromDisk()
+		report = checkStorage(logger, s)
+	}
+	report.EngineDirectory = engineDirectory
+
+	if storageErr != nil {
+		return nil, ierrors.Wrap(storageErr, "storage error during database check")
+	}
+
+	return report, nil
+}
+
+// checkStorage runs all database checks on the given storage.
+func checkStorage(logger log.Logger, s *storage.Storage) *dbCheckReport {
+	latestCommitment := s.Settings().LatestCommitment()
+
+	firstUnprunedSlot := s.Settings().APIProvider().CommittedAPI().ProtocolParameters().GenesisSlot()
+	if lastPrunedEpoch, hasPruned := s.LastPrunedEpoch(); hasPruned {
+		firstUnprunedSlot = s.Settings().APIProvider().APIForEpoch(lastPrunedEpoch + 1).TimeProvider().EpochStart(lastPrunedEpoch + 1)
+	}
+
+	report := &dbCheckReport{
+		LatestCommitmentID:  latestCommitment.ID().ToHex(),
+		LatestCommittedSlot: latestCommitment.Slot(),
+		LatestFinalizedSlot: s.Settings().LatestFinalizedSlot(),
+		FirstUnprunedSlot:   firstUnprunedSlot,
+		Checks: []*dbCheckResult{
+			runDatabaseCheck(dbCheckCommitmentLedgerState, func() *dbCheckResult { return checkCommitmentLedgerState(s) }),
+			runDatabaseCheck(dbCheckLedgerBalance, func() *dbCheckResult { return checkLedgerBalance(s) }),
+			runDatabaseCheck(dbCheckCommitmentChain, func() *dbCheckResult { return checkCommitmentChain(s, firstUnprunedSlot) }),
+			runDatabaseCheck(dbCheckRootBlocks, func() *dbCheckResult { return checkRootBlocks(s) }),
+			runDatabaseCheck(dbCheckAccountsTree, func() *dbCheckResult { return checkAccountsTree(logger, s) }),
+		},
+	}
+
+	report.Healthy = true
+	for _, check := range report.Checks {
+		report.Healthy = report.Healthy && check.Passed
+	}
+
+	return report
+}
+
+// runDatabaseCheck runs a single database check and reports a panic (e.g. caused by a corrupted prunable bucket) as a failed check.
+func runDatabaseCheck(name string, check func() *dbCheckResult) (result *dbCheckResult) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = &dbCheckResult{Name: name, Passed: true}
+			result.addMismatch(ierrors.Errorf("check aborted: %v", r))
+		}
+	}()
+
+	return check()
+}
+
+// checkCommitmentLedgerState checks the latest commitment and the ledger state against the stored roots.
+func checkCommitmentLedgerState(s *storage.Storage) *dbCheckResult {
+	result := &dbCheckResult{Name: dbCheckCommitmentLedgerState, Passed: true}
+
+	if err := s.CheckCorrectnessCommitmentLedgerState(); err != nil {
+		result.addMismatch(err, s.Settings().LatestCommitment().Slot())
+	}
+
+	return result
+}
+
+// checkLedgerBalance checks that the sum of all unspent outputs matches the token supply.
+func checkLedgerBalance(s *storage.Storage) *dbCheckResult {
+	result := &dbCheckResult{Name: dbCheckLedgerBalance, Passed: true}
+
+	if err := s.Ledger().CheckLedgerState(s.Settings().APIProvider().CommittedAPI().ProtocolParameters().TokenSupply()); err != nil {
+		result.addMismatch(err)
+	}
+
+	return result
+}
+
+// checkCommitmentChain checks that every stored commitment links to the stored commitment of the previous slot,
+// that the chain ends in the latest commitment and (if the slot was not pruned yet) that it matches the stored roots.
+func checkCommitmentChain(s *storage.Storage, firstUnprunedSlot iotago.SlotIndex) *dbCheckResult {
+	result := &dbCheckResult{Name: dbCheckCommitmentChain, Passed: true}
+
+	genesisSlot := s.Settings().APIProvider().CommittedAPI().ProtocolParameters().GenesisSlot()
+	latestCommittedSlot := s.Settings().LatestCommitment().Slot()
+
+	var previousCommitmentID iotago.CommitmentID
+	for slot := genesisSlot; slot <= latestCommittedSlot; slot++ {
+		commitment, err := s.Commitments().Load(slot)
+		if err != nil {
+			result.addMismatch(ierrors.Wrap(err, "failed to load commitment"), slot)
+			previousCommitmentID = iotago.EmptyCommitmentID
+
+			continue
+		}
+
+		if commitment.Slot() != slot {
+			result.addMismatch(ierrors.Errorf("commitment %s is stored for the wrong slot", commitment.ID()), slot)
+		}
+
+		// every commitment must link to the stored commitment of the previous slot.
+		if slot > genesisSlot && previousCommitmentID != iotago.EmptyCommitmentID && commitment.PreviousCommitmentID() != previousCommitmentID {
+			result.addMismatch(ierrors.Errorf("previous commitment ID %s does not match stored commitment %s of the previous slot", commitment.PreviousCommitmentID(), previousCommitmentID), slot)
+		}
+		previousCommitmentID = commitment.ID()
+
+		if slot == latestCommittedSlot && commitment.ID() != s.Settings().LatestCommitment().ID() {
+			result.addMismatch(ierrors.Errorf("stored commitment %s does not match latest commitment %s of the settings", commitment.ID(), s.Settings().LatestCommitment().ID()), slot)
+		}
+
+		// the roots are only kept for slots that were not pruned yet, and the genesis slot doesn't provide roots.
+		if slot < firstUnprunedSlot || slot == genesisSlot {
+			continue
+		}
+
+		rootsStorage, err := s.Roots(slot)
+		if err != nil {
+			if !ierrors.Is(err, database.ErrEpochPruned) {
+				result.addMismatch(ierrors.Wrap(err, "failed to load roots storage"), slot)
+			}
+
+			continue
+		}
+
+		roots, exists, err := rootsStorage.Load(commitment.ID())
+		if err != nil {
+			result.addMismatch(ierrors.Wrap(err, "failed to load roots"), slot)
+		} else if !exists {
+			result.addMismatch(ierrors.Errorf("roots for commitment %s not found", commitment.ID()), slot)
+		} else if roots.ID() != commitment.RootsID() {
+			result.addMismatch(ierrors.Errorf("stored roots ID %s does not match roots ID %s of the commitment", roots.ID(), commitment.RootsID()), slot)
+		}
+	}
+
+	return result
+}
+
+// checkRootBlocks checks that all active root blocks reference a commitment that is part of the stored commitment chain.
+func checkRootBlocks(s *storage.Storage) *dbCheckResult {
+	result := &dbCheckResult{Name: dbCheckRootBlocks, Passed: true}
+
+	latestCommittedSlot := s.Settings().LatestCommitment().Slot()
+
+	evictionState := eviction.NewState(s.Settings(), s.RootBlocks)
+	evictionState.Initialize(latestCommittedSlot)
+
+	activeRootBlocks := evictionState.AllActiveRootBlocks()
+	if len(activeRootBlocks) == 0 {
+		result.addMismatch(ierrors.New("no active root blocks found"), latestCommittedSlot)
+
+		return result
+	}
+
+	for blockID, commitmentID := range activeRootBlocks {
+		if commitmentID.Slot() > latestCommittedSlot {
+			result.addMismatch(ierrors.Errorf("root block %s references commitment %s above the latest committed slot", blockID, commitmentID), blockID.Slot())
+
+			continue
+		}
+
+		commitment, err := s.Commitments().Load(commitmentID.Slot())
+		if err != nil {
+			result.addMismatch(ierrors.Wrapf(err, "failed to load commitment referenced by root block %s", blockID), blockID.Slot())
+
+			continue
+		}
+
+		if commitment.ID() != commitmentID {
+			result.addMismatch(ierrors.Errorf("root block %s references commitment %s, but the stored commitment is %s", blockID, commitmentID, commitment.ID()), blockID.Slot())
+		}
+	}
+
+	return result
+}
+
+// checkAccountsTree rebuilds the accounts tree from its leaves and compares its root to the roots of the latest commitment.
+func checkAccountsTree(logger log.Logger, s *storage.Storage) *dbCheckResult {
+	result := &dbCheckResult{Name: dbCheckAccountsTree, Passed: true}
+
+	latestCommitment := s.Settings().LatestCommitment()
+
+	// the genesis slot doesn't provide roots.
+	if latestCommitment.Slot() <= s.Settings().APIProvider().CommittedAPI().ProtocolParameters().GenesisSlot() {
+		return result
+	}
+
+	rootsStorage, err := s.Roots(latestCommitment.Slot())
+	if err != nil {
+		result.addMismatch(ierrors.Wrap(err, "failed to load roots storage"), latestCommitment.Slot())
+
+		return result
+	}
+
+	roots, exists, err := rootsStorage.Load(latestCommitment.ID())
+	if err != nil {
+		result.addMismatch(ierrors.Wrap(err, "failed to load roots"), latestCommitment.Slot())
+
+		return result
+	} else if !exists {
+		result.addMismatch(ierrors.Errorf("roots for commitment %s not found", latestCommitment.ID()), latestCommitment.Slot())
+
+		return result
+	}
+
+	accountsManager := accountsledger.New(module.New(logger), s.Settings().APIProvider(), nil, s.AccountDiffs, s.Accounts())
+	accountsManager.SetLatestCommittedSlot(latestCommitment.Slot())
+
+	if storedRoot := accountsManager.AccountsTreeRoot(); storedRoot != roots.AccountRoot {
+		result.addMismatch(ierrors.Errorf("stored accounts tree root %s does not match account root %s of the commitment", storedRoot, roots.AccountRoot), latestCommitment.Slot())
+	}
+
+	// the stored root is not trusted, the tree is rebuilt in memory from the account leaves instead.
+	rebuiltTree := ads.NewMap[iotago.Identifier](mapdb.NewMapDB(),
+		iotago.Identifier.Bytes,
+		iotago.IdentifierFromBytes,
+		iotago.AccountID.Bytes,
+		iotago.AccountIDFromBytes,
+		(*accounts.AccountData).Bytes,
+		accounts.AccountDataFromBytes,
+	)
+
+	if err := accountsManager.ForEachAccount(func(accountData *accounts.AccountData) error {
+		return rebuiltTree.Set(accountData.ID(), accountData)
+	}); err != nil {
+		result.addMismatch(ierrors.Wrap(err, "failed to rebuild accounts tree from the account leaves"), latestCommitment.Slot())
+
+		return result
+	}
+
+	if rebuiltRoot := rebuiltTree.Root(); rebuiltRoot != roots.AccountRoot {
+		result.addMismatch(ierrors.Errorf("accounts tree root %s rebuilt from the account leaves does not match account root %s of the commitment", rebuiltRoot, roots.AccountRoot), latestCommitment.Slot())
+	}
+
+	return result
+}
+
+func printDatabaseCheckReport(report *dbCheckReport) {
+	fmt.Println("Engine directory:       ", report.EngineDirectory)
+	if report.Error != "" {
+		fmt.Println("Error:                  ", report.Error)
+		fmt.Println()
+		fmt.Println("Healthy:                ", yesOrNo(report.Healthy))
+
+		return
+	}
+
+	fmt.Println("Latest commitment:      ", report.LatestCommitmentID)
+	fmt.Println("Latest committed slot:  ", report.LatestCommittedSlot)
+	fmt.Println("Latest finalized slot:  ", report.LatestFinalizedSlot)
+	fmt.Println("First unpruned slot:    ", report.FirstUnprunedSlot)
+	fmt.Println()
+
+	for _, check := range report.Checks {
+		fmt.Printf("%-25s %s\n", check.Name+":", lo.Cond(check.Passed, "OK", "FAILED"))
+
+		for _, mismatch := range check.Mismatches {
+			if mismatch.Slot != nil {
+				fmt.Printf("    slot %d: %s\n", *mismatch.Slot, mismatch.Error)
+			} else {
+				fmt.Printf("    %s\n", mismatch.Error)
+			}
+		}
+	}
+
+	fmt.Println()
+	fmt.Println("Healthy:                ", yesOrNo(report.Healthy))
+}
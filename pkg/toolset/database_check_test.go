@@ -0,0 +1,157 @@
+package toolset
+
+import (
+	"path/filepath"
+	"slices"
+	"testing"
+
+	copydir "github.com/otiai10/copy"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/ads"
+	hivedb "github.com/iotaledger/hive.go/db"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/log"
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/protocol"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/accounts"
+	"github.com/iotaledger/iota-core/pkg/storage"
+	"github.com/iotaledger/iota-core/pkg/testsuite"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// adsRootKey is the key of the stored root of an ads.Map in its store.
+var adsRootKey = []byte{2}
+
+// createTestDatabase runs a single validator node for a few slots and returns the path to its stopped database
+// and the path of the genesis snapshot it was started from.
+func createTestDatabase(t *testing.T) (databasePath string, snapshotPath string) {
+	ts := testsuite.NewTestSuite(t,
+		testsuite.WithProtocolParametersOptions(
+			iotago.WithTimeProviderOptions(
+				0,
+				testsuite.GenesisTimeWithOffsetBySlots(100, testsuite.DefaultSlotDurationInSeconds),
+				testsuite.DefaultSlotDurationInSeconds,
+				4,
+			),
+			iotago.WithLivenessOptions(
+				10,
+				10,
+				2,
+				4,
+				5,
+			),
+		),
+	)
+
+	ts.AddValidatorNode("node1")
+	ts.Run(true)
+
+	ts.IssueBlocksAtSlots("wave:", []iotago.SlotIndex{1, 2, 3, 4, 5, 6, 7, 8}, 2, "Genesis", ts.Nodes(), true, false)
+	ts.Shutdown()
+
+	return ts.Directory.Path("node1"), ts.Directory.Path("genesis_snapshot.bin")
+}
+
+// modifyTestDatabase opens the main engine storage of a copy of the database writable and applies the given modification.
+func modifyTestDatabase(t *testing.T, databasePath string, modify func(s *storage.Storage)) string {
+	modifiedDatabasePath := filepath.Join(t.TempDir(), "database")
+	require.NoError(t, copydir.Copy(databasePath, modifiedDatabasePath))
+
+	engineDirectory, err := protocol.MainEngineDirectory(modifiedDatabasePath)
+	require.NoError(t, err)
+
+	s, err := storage.Open(log.NewLogger(), engineDirectory, protocol.DatabaseVersion, func(err error) { require.NoError(t, err) })
+	require.NoError(t, err)
+	s.RestoreFromDisk()
+
+	modify(s)
+	s.Shutdown()
+
+	return modifiedDatabasePath
+}
+
+// requireCheckFailed checks that exactly the given database checks failed.
+func requireCheckFailed(t *testing.T, report *dbCheckReport, failedChecks ...string) {
+	require.False(t, report.Healthy)
+
+	for _, check := range report.Checks {
+		require.Equal(t, !slices.Contains(failedChecks, check.Name), check.Passed, "check %s: %v", check.Name, check.Mismatches)
+	}
+}
+
+func TestDatabaseCheck(t *testing.T) {
+	databasePath, _ := createTestDatabase(t)
+
+	report, err := checkDatabase(databasePath, hivedb.EngineRocksDB)
+	require.NoError(t, err)
+	require.True(t, report.Healthy, "%+v", report.Checks)
+	require.Greater(t, report.LatestCommittedSlot, iotago.SlotIndex(0))
+	require.NoError(t, databaseCheck([]string{"--" + FlagToolDatabasePath, databasePath}))
+
+	t.Run("broken commitment chain", func(t *testing.T) {
+		modifiedDatabasePath := modifyTestDatabase(t, databasePath, func(s *storage.Storage) {
+			slot := s.Settings().LatestCommitment().Slot() - 1
+
+			commitment, err := s.Commitments().Load(slot)
+			require.NoError(t, err)
+
+			modifiedCommitment := iotago.NewCommitment(
+				commitment.Commitment().ProtocolVersion,
+				commitment.Slot(),
+				commitment.PreviousCommitmentID(),
+				commitment.RootsID(),
+				commitment.CumulativeWeight()+1,
+				commitment.ReferenceManaCost(),
+			)
+
+			require.NoError(t, s.Commitments().Store(lo.PanicOnErr(model.CommitmentFromCommitment(modifiedCommitment, s.Settings().APIProvider().APIForSlot(slot)))))
+		})
+
+		report, err := checkDatabase(modifiedDatabasePath, hivedb.EngineRocksDB)
+		require.NoError(t, err)
+		requireCheckFailed(t, report, dbCheckCommitmentChain)
+
+		require.EqualError(t, databaseCheck([]string{"--" + FlagToolDatabasePath, modifiedDatabasePath}), "database check failed")
+	})
+
+	t.Run("corrupted account leaf with intact stored root", func(t *testing.T) {
+		modifiedDatabasePath := modifyTestDatabase(t, databasePath, func(s *storage.Storage) {
+			storedRoot, err := s.Accounts().Get(adsRootKey)
+			require.NoError(t, err)
+
+			accountsTree := ads.NewMap[iotago.Identifier](s.Accounts(),
+				iotago.Identifier.Bytes,
+				iotago.IdentifierFromBytes,
+				iotago.AccountID.Bytes,
+				iotago.AccountIDFromBytes,
+				(*accounts.AccountData).Bytes,
+				accounts.AccountDataFromBytes,
+			)
+
+			var accountData *accounts.AccountData
+			require.NoError(t, accountsTree.Stream(func(_ iotago.AccountID, data *accounts.AccountData) error {
+				accountData = data
+
+				return nil
+			}))
+			require.NotNil(t, accountData)
+
+			accountData.SetExpirySlot(accountData.ExpirySlot() + 1)
+			require.NoError(t, accountsTree.Set(accountData.ID(), accountData))
+			require.NoError(t, accountsTree.Commit())
+
+			// the stored root still matches the commitment, only the leaf is corrupted.
+			require.NoError(t, s.Accounts().Set(adsRootKey, storedRoot))
+		})
+
+		report, err := checkDatabase(modifiedDatabasePath, hivedb.EngineRocksDB)
+		require.NoError(t, err)
+		requireCheckFailed(t, report, dbCheckAccountsTree)
+
+		accountsTreeCheck := report.Checks[len(report.Checks)-1]
+		require.Equal(t, dbCheckAccountsTree, accountsTreeCheck.Name)
+		require.Len(t, accountsTreeCheck.Mismatches, 1)
+		require.Contains(t, accountsTreeCheck.Mismatches[0].Error, "account leaves")
+	})
+}
@@ -0,0 +1,444 @@
+package toolset
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	copydir "github.com/otiai10/copy"
+	flag "github.com/spf13/pflag"
+
+	"github.com/iotaledger/hive.go/app/configuration"
+	hivedb "github.com/iotaledger/hive.go/db"
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/runtime/ioutils"
+	"github.com/iotaledger/iota-core/pkg/storage"
+	"github.com/iotaledger/iota-core/pkg/storage/database"
+)
+
+const (
+	// migrationBatchSize is the amount of entries that are written to the target database in a single batch.
+	migrationBatchSize = 10000
+)
+
+// migrationStats holds the statistics of a running database migration.
+type migrationStats struct {
+	timeStart      time.Time
+	lastStatusTime time.Time
+	entries        uint64
+	bytes          uint64
+}
+
+func newMigrationStats() *migrationStats {
+	return &migrationStats{
+		timeStart:      time.Now(),
+		lastStatusTime: time.Now(),
+	}
+}
+
+// track adds an entry to the statistics and prints the status if the status interval elapsed.
+func (m *migrationStats) track(key kvstore.Key, value kvstore.Value, storeName string) {
+	m.entries++
+	m.bytes += uint64(len(key) + len(value))
+
+	if time.Since(m.lastStatusTime) < printStatusInterval {
+		return
+	}
+	m.lastStatusTime = time.Now()
+
+	bytesPerSecond := uint64(float64(m.bytes) / time.Since(m.timeStart).Seconds())
+	fmt.Printf("Migrating %s... (total %d entries, %s, %s/s)\n", storeName, m.entries, humanize.Bytes(m.bytes), humanize.Bytes(bytesPerSecond))
+}
+
+func databaseMigration(args []string) error {
+	fs := configuration.NewUnsortedFlagSet("", flag.ContinueOnError)
+	databasePathSourceFlag := fs.String(FlagToolDatabasePathSource, "", "the path to the source database")
+	databasePathTargetFlag := fs.String(FlagToolDatabasePathTarget, "", "the path to the target database")
+	databaseEngineTargetFlag := fs.String(FlagToolDatabaseEngineTarget, string(hivedb.EngineRocksDB), "the engine of the target database (values: rocksdb)")
+	archivePathSourceFlag := fs.String(FlagToolArchivePathSource, "", "the path to the source archive database (optional, only for archive nodes)")
+	archivePathTargetFlag := fs.String(FlagToolArchivePathTarget, "", "the path to the target archive database (only needed if the source archive database is specified)")
+
+	fs.Usage = func() {
+		_, _ = fmt.Fprintf(os.Stderr, "Usage of %s:\n", ToolDatabaseMigration)
+		fs.PrintDefaults()
+		println(fmt.Sprintf("\nexample: %s --%s %s --%s %s --%s %s",
+			ToolDatabaseMigration,
+			FlagToolDatabasePathSource,
+			DefaultValueDatabasePath,
+			FlagToolDatabasePathTarget,
+			"database_new",
+			FlagToolDatabaseEngineTarget,
+			hivedb.EngineRocksDB))
+		println("\nmapdb is an in-memory database without an on-disk format, so it can't be used as the target engine.")
+		println(fmt.Sprintf("the archive database of an archive node is migrated as well if --%s and --%s are specified.", FlagToolArchivePathSource, FlagToolArchivePathTarget))
+	}
+
+	if err := parseFlagSet(fs, args); err != nil {
+		return err
+	}
+
+	if len(*databasePathSourceFlag) == 0 {
+		return ierrors.Errorf("'%s' not specified", FlagToolDatabasePathSource)
+	}
+
+	targetEngine, err := hivedb.EngineFromStringAllowed(*databaseEngineTargetFlag, []hivedb.Engine{hivedb.EngineRocksDB})
+	if err != nil {
+		return err
+	}
+
+	sourcePath := *databasePathSourceFlag
+	if exists, isDir, err := ioutils.PathExists(sourcePath); err != nil {
+		return ierrors.Wrapf(err, "unable to check source database path (%s)", sourcePath)
+	} else if !exists || !isDir {
+		return ierrors.Errorf("source database path (%s) does not exist", sourcePath)
+	}
+
+	targetPath := *databasePathTargetFlag
+	if err := checkMigrationTargetPath(targetPath, FlagToolDatabasePathTarget); err != nil {
+		return err
+	}
+
+	sourceArchivePath := *archivePathSourceFlag
+	targetArchivePath := *archivePathTargetFlag
+	if len(sourceArchivePath) > 0 {
+		if exists, isDir, err := ioutils.PathExists(sourceArchivePath); err != nil {
+			return ierrors.Wrapf(err, "unable to check source archive database path (%s)", sourceArchivePath)
+		} else if !exists || !isDir {
+			return ierrors.Errorf("source archive database path (%s) does not exist", sourceArchivePath)
+		}
+
+		if err := checkMigrationTargetPath(targetArchivePath, FlagToolArchivePathTarget); err != nil {
+			return err
+		}
+	}
+
+	engineDirs, err := engineDirectories(sourcePath)
+	if err != nil {
+		return err
+	}
+	if len(engineDirs) == 0 {
+		return ierrors.Errorf("no engine storage found in source database path (%s)", sourcePath)
+	}
+
+	stats := newMigrationStats()
+	for _, engineDir := range engineDirs {
+		if err := migrateEngineStorage(filepath.Join(sourcePath, engineDir), filepath.Join(targetPath, engineDir), targetEngine, stats); err != nil {
+			return ierrors.Wrapf(err, "migrating engine storage (%s) failed", engineDir)
+		}
+	}
+
+	// copy the remaining files (e.g. the engine info file) of the database directory.
+	if err := copyRegularFiles(sourcePath, targetPath); err != nil {
+		return err
+	}
+
+	if len(sourceArchivePath) > 0 {
+		if err := migrateKVStore(sourceArchivePath, targetArchivePath, targetEngine, stats); err != nil {
+			return ierrors.Wrapf(err, "migrating archive database (%s) failed", sourceArchivePath)
+		}
+	}
+
+	fmt.Printf("Migration successful! (%d entries, %s, took %v)\n", stats.entries, humanize.Bytes(stats.bytes), time.Since(stats.timeStart).Truncate(time.Millisecond))
+
+	return nil
+}
+
+// checkMigrationTargetPath checks that the target path of a migration is specified and does not contain any data yet.
+func checkMigrationTargetPath(targetPath string, flagName string) error {
+	if len(targetPath) == 0 {
+		return ierrors.Errorf("'%s' not specified", flagName)
+	}
+
+	targetExists, err := ioutils.DirExistsAndIsNotEmpty(targetPath)
+	if err != nil {
+		return ierrors.Wrapf(err, "unable to check target path (%s)", targetPath)
+	}
+	if targetExists {
+		return ierrors.Errorf("target path (%s) already exists and is not empty", targetPath)
+	}
+
+	return nil
+}
+
+// engineDirectories returns the names of all engine storage directories in the given database directory.
+func engineDirectories(databasePath string) ([]string, error) {
+	entries, err := os.ReadDir(databasePath)
+	if err != nil {
+		return nil, ierrors.Wrapf(err, "unable to read database directory (%s)", databasePath)
+	}
+
+	var engineDirs []string
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		kvStoreDirs, err := storage.KVStoreDirectories(filepath.Join(databasePath, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		// the first directory is always the permanent store, an engine storage can't exist without it.
+		if exists, isDir, err := ioutils.PathExists(kvStoreDirs[0]); err == nil && exists && isDir {
+			engineDirs = append(engineDirs, entry.Name())
+		}
+	}
+
+	return engineDirs, nil
+}
+
+// migrateEngineStorage migrates all key-value stores and SQL databases of a single engine storage.
+func migrateEngineStorage(sourceDir string, targetDir string, targetEngine hivedb.Engine, stats *migrationStats) error {
+	kvStoreDirs, err := storage.KVStoreDirectories(sourceDir)
+	if err != nil {
+		return err
+	}
+
+	for _, kvStoreDir := range kvStoreDirs {
+		relativePath, err := filepath.Rel(sourceDir, kvStoreDir)
+		if err != nil {
+			return err
+		}
+
+		if err := migrateKVStore(kvStoreDir, filepath.Join(targetDir, relativePath), targetEngine, stats); err != nil {
+			return ierrors.Wrapf(err, "migrating key-value store (%s) failed", kvStoreDir)
+		}
+	}
+
+	// the SQL databases (transaction retainer and indexer) are independent of the key-value store engine and can be copied as they are.
+	for _, sqlDir := range []string{storage.SQLDirectory(sourceDir), storage.IndexerDirectory(sourceDir)} {
+		if err := copySQLDirectory(sqlDir, sourceDir, targetDir); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// copySQLDirectory copies the SQL database directory of the engine storage in the source directory to the target directory.
+func copySQLDirectory(sqlDir string, sourceDir string, targetDir string) error {
+	if exists, isDir, err := ioutils.PathExists(sqlDir); err != nil {
+		return ierrors.Wrapf(err, "unable to check SQL database directory (%s)", sqlDir)
+	} else if !exists || !isDir {
+		return nil
+	}
+
+	relativePath, err := filepath.Rel(sourceDir, sqlDir)
+	if err != nil {
+		return err
+	}
+	targetSQLDir := filepath.Join(targetDir, relativePath)
+
+	fmt.Printf("Copying SQL databases (%s)...\n", sqlDir)
+	if err := copydir.Copy(sqlDir, targetSQLDir); err != nil {
+		return ierrors.Wrapf(err, "copying SQL database directory (%s) failed", sqlDir)
+	}
+
+	return verifyFilesEqual(sqlDir, targetSQLDir)
+}
+
+// migrateKVStore copies all entries of the key-value store in the source directory to a new store
+// with the given engine in the target directory and verifies the result afterwards.
+// The source store is opened read-only, so that the migration doesn't modify it.
+func migrateKVStore(sourceDir string, targetDir string, targetEngine hivedb.Engine, stats *migrationStats) (err error) {
+	sourceStore, err := database.StoreReadOnly(sourceDir, hivedb.EngineAuto, database.AllowedEnginesStorageAuto...)
+	if err != nil {
+		return ierrors.Wrapf(err, "source database initialization failed (%s)", sourceDir)
+	}
+	defer func() {
+		if closeErr := sourceStore.Close(); closeErr != nil && err == nil {
+			err = ierrors.Wrapf(closeErr, "closing source database failed (%s)", sourceDir)
+		}
+	}()
+
+	targetStore, err := database.StoreWithDefaultSettings(targetDir, true, targetEngine)
+	if err != nil {
+		return ierrors.Wrapf(err, "target database initialization failed (%s)", targetDir)
+	}
+	defer func() {
+		if closeErr := targetStore.Close(); closeErr != nil && err == nil {
+			err = ierrors.Wrapf(closeErr, "closing target database failed (%s)", targetDir)
+		}
+	}()
+
+	if err := copyKVStore(sourceStore, targetStore, sourceDir, stats); err != nil {
+		return err
+	}
+
+	if err := targetStore.Flush(); err != nil {
+		return ierrors.Wrapf(err, "flushing target database failed (%s)", targetDir)
+	}
+
+	fmt.Printf("Verifying %s...\n", targetDir)
+
+	return verifyKVStore(sourceStore, targetStore)
+}
+
+// copyKVStore copies all entries from the source to the target store in batches.
+func copyKVStore(sourceStore kvstore.KVStore, targetStore kvstore.KVStore, storeName string, stats *migrationStats) error {
+	batch, err := targetStore.Batched()
+	if err != nil {
+		return err
+	}
+
+	var batchEntries int
+	var innerErr error
+	if err := sourceStore.Iterate(kvstore.EmptyPrefix, func(key kvstore.Key, value kvstore.Value) bool {
+		if innerErr = batch.Set(key, value); innerErr != nil {
+			return false
+		}
+		stats.track(key, value, storeName)
+
+		if batchEntries++; batchEntries < migrationBatchSize {
+			return true
+		}
+
+		if innerErr = batch.Commit(); innerErr != nil {
+			return false
+		}
+
+		batchEntries = 0
+
+		// the committed batch must not be canceled if no new batch can be created.
+		nextBatch, err := targetStore.Batched()
+		if err != nil {
+			innerErr = err
+			batch = nil
+
+			return false
+		}
+		batch = nextBatch
+
+		return true
+	}); err != nil {
+		if batch != nil {
+			batch.Cancel()
+		}
+
+		return ierrors.Wrap(err, "iterating source database failed")
+	}
+
+	if innerErr != nil {
+		if batch != nil {
+			batch.Cancel()
+		}
+
+		return ierrors.Wrap(innerErr, "writing target database failed")
+	}
+
+	return batch.Commit()
+}
+
+// verifyKVStore checks that the target store contains exactly the same entries as the source store.
+func verifyKVStore(sourceStore kvstore.KVStore, targetStore kvstore.KVStore) error {
+	var sourceEntries, targetEntries uint64
+	var innerErr error
+	if err := sourceStore.Iterate(kvstore.EmptyPrefix, func(key kvstore.Key, value kvstore.Value) bool {
+		sourceEntries++
+
+		targetValue, err := targetStore.Get(key)
+		if err != nil {
+			innerErr = ierrors.Wrapf(err, "key %X not found in target database", key)
+			return false
+		}
+
+		if !bytes.Equal(value, targetValue) {
+			innerErr = ierrors.Errorf("value of key %X does not match", key)
+			return false
+		}
+
+		return true
+	}); err != nil {
+		return ierrors.Wrap(err, "iterating source database failed")
+	}
+
+	if innerErr != nil {
+		return ierrors.Wrap(innerErr, "verification failed")
+	}
+
+	if err := targetStore.IterateKeys(kvstore.EmptyPrefix, func(_ kvstore.Key) bool {
+		targetEntries++
+
+		return true
+	}); err != nil {
+		return ierrors.Wrap(err, "iterating target database failed")
+	}
+
+	if sourceEntries != targetEntries {
+		return ierrors.Errorf("verification failed: entry count mismatch, source: %d, target: %d", sourceEntries, targetEntries)
+	}
+
+	return nil
+}
+
+// copyRegularFiles copies all regular files (non-recursive) from the source to the target directory.
+func copyRegularFiles(sourceDir string, targetDir string) error {
+	entries, err := os.ReadDir(sourceDir)
+	if err != nil {
+		return ierrors.Wrapf(err, "unable to read directory (%s)", sourceDir)
+	}
+
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() {
+			continue
+		}
+
+		if err := copydir.Copy(filepath.Join(sourceDir, entry.Name()), filepath.Join(targetDir, entry.Name())); err != nil {
+			return ierrors.Wrapf(err, "copying file (%s) failed", entry.Name())
+		}
+	}
+
+	return nil
+}
+
+// verifyFilesEqual checks that all regular files in the source directory have an identical copy in the target directory.
+func verifyFilesEqual(sourceDir string, targetDir string) error {
+	return filepath.WalkDir(sourceDir, func(path string, entry os.DirEntry, err error) error {
+		if err != nil || !entry.Type().IsRegular() {
+			return err
+		}
+
+		relativePath, err := filepath.Rel(sourceDir, path)
+		if err != nil {
+			return err
+		}
+
+		sourceHash, err := fileHash(path)
+		if err != nil {
+			return err
+		}
+
+		targetHash, err := fileHash(filepath.Join(targetDir, relativePath))
+		if err != nil {
+			return err
+		}
+
+		if !bytes.Equal(sourceHash, targetHash) {
+			return ierrors.Errorf("verification failed: file (%s) does not match", relativePath)
+		}
+
+		return nil
+	})
+}
+
+// fileHash returns the SHA-256 hash of the file at the given path.
+func fileHash(path string) ([]byte, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, ierrors.Wrapf(err, "unable to open file (%s)", path)
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return nil, ierrors.Wrapf(err, "unable to read file (%s)", path)
+	}
+
+	return hash.Sum(nil), nil
+}
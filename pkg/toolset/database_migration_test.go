@@ -0,0 +1,104 @@
+package toolset
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	copydir "github.com/otiai10/copy"
+	"github.com/stretchr/testify/require"
+
+	hivedb "github.com/iotaledger/hive.go/db"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/iota-core/pkg/storage"
+	"github.com/iotaledger/iota-core/pkg/storage/database"
+)
+
+// writeTestStore creates a RocksDB store in the given directory that contains the given number of entries.
+func writeTestStore(t *testing.T, directory string, entries int) {
+	t.Helper()
+
+	store, err := database.StoreWithDefaultSettings(directory, true, hivedb.EngineRocksDB)
+	require.NoError(t, err)
+
+	for i := 0; i < entries; i++ {
+		require.NoError(t, store.Set([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i))))
+	}
+
+	require.NoError(t, store.Flush())
+	require.NoError(t, store.Close())
+}
+
+// readTestStore returns all entries of the RocksDB store in the given directory.
+func readTestStore(t *testing.T, directory string) map[string]string {
+	t.Helper()
+
+	store, err := database.StoreWithDefaultSettings(directory, false, hivedb.EngineRocksDB)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, store.Close()) }()
+
+	entries := make(map[string]string)
+	require.NoError(t, store.Iterate(kvstore.EmptyPrefix, func(key kvstore.Key, value kvstore.Value) bool {
+		entries[string(key)] = string(value)
+
+		return true
+	}))
+
+	return entries
+}
+
+func TestDatabaseMigration(t *testing.T) {
+	sourcePath := filepath.Join(t.TempDir(), "database")
+	sourceArchivePath := filepath.Join(t.TempDir(), "archive")
+	engineDir := filepath.Join(sourcePath, "engine")
+
+	kvStoreDirs, err := storage.KVStoreDirectories(engineDir)
+	require.NoError(t, err)
+	writeTestStore(t, kvStoreDirs[0], 2*migrationBatchSize+1)
+	writeTestStore(t, sourceArchivePath, 10)
+
+	require.NoError(t, os.MkdirAll(storage.SQLDirectory(engineDir), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(storage.SQLDirectory(engineDir), "tx_retainer.db"), []byte("tx retainer"), 0o600))
+	require.NoError(t, os.MkdirAll(storage.IndexerDirectory(engineDir), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(storage.IndexerDirectory(engineDir), "indexer.db"), []byte("indexer"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(sourcePath, "info"), []byte("info"), 0o600))
+
+	// mapdb has no on-disk format, so it can't be the target of a migration.
+	require.Error(t, databaseMigration([]string{
+		"--" + FlagToolDatabasePathSource, sourcePath,
+		"--" + FlagToolDatabasePathTarget, filepath.Join(t.TempDir(), "database"),
+		"--" + FlagToolDatabaseEngineTarget, string(hivedb.EngineMapDB),
+	}))
+
+	// keep a copy of the source to check that the migration doesn't modify it.
+	sourceCopyPath := filepath.Join(t.TempDir(), "database")
+	require.NoError(t, copydir.Copy(sourcePath, sourceCopyPath))
+
+	targetPath := filepath.Join(t.TempDir(), "database")
+	targetArchivePath := filepath.Join(t.TempDir(), "archive")
+	require.NoError(t, databaseMigration([]string{
+		"--" + FlagToolDatabasePathSource, sourcePath,
+		"--" + FlagToolDatabasePathTarget, targetPath,
+		"--" + FlagToolDatabaseEngineTarget, string(hivedb.EngineRocksDB),
+		"--" + FlagToolArchivePathSource, sourceArchivePath,
+		"--" + FlagToolArchivePathTarget, targetArchivePath,
+	}))
+	require.NoError(t, verifyFilesEqual(sourceCopyPath, sourcePath))
+
+	targetEngineDir := filepath.Join(targetPath, "engine")
+	targetKVStoreDirs, err := storage.KVStoreDirectories(targetEngineDir)
+	require.NoError(t, err)
+	require.Equal(t, readTestStore(t, kvStoreDirs[0]), readTestStore(t, targetKVStoreDirs[0]))
+	require.Equal(t, readTestStore(t, sourceArchivePath), readTestStore(t, targetArchivePath))
+
+	require.NoError(t, verifyFilesEqual(storage.SQLDirectory(engineDir), storage.SQLDirectory(targetEngineDir)))
+	require.NoError(t, verifyFilesEqual(storage.IndexerDirectory(engineDir), storage.IndexerDirectory(targetEngineDir)))
+	require.FileExists(t, filepath.Join(targetPath, "info"))
+
+	// the target must not contain any data yet.
+	require.Error(t, databaseMigration([]string{
+		"--" + FlagToolDatabasePathSource, sourcePath,
+		"--" + FlagToolDatabasePathTarget, targetPath,
+	}))
+}
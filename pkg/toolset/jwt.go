@@ -3,6 +3,7 @@ package toolset
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/libp2p/go-libp2p/core/peer"
 	flag "github.com/spf13/pflag"
@@ -18,17 +19,24 @@ func generateJWTApiToken(args []string) error {
 	fs := configuration.NewUnsortedFlagSet("", flag.ContinueOnError)
 	privKeyFilePath := fs.String(FlagToolIdentityPrivateKeyFilePath, DefaultValueIdentityPrivateKeyFilePath, "the file path to the identity private key file")
 	apiJWTSaltFlag := fs.String(FlagToolSalt, DefaultValueAPIJWTTokenSalt, "salt used inside the JWT tokens for the REST API")
+	scopesFlag := fs.StringSlice(FlagToolScopes, nil, fmt.Sprintf("the scopes granted by the JWT token, the token grants access to all routes if no scopes are given (%s)", strings.Join(jwt.Scopes, ", ")))
+	expiryFlag := fs.Duration(FlagToolExpiry, 0, "the duration after which the JWT token expires, the token does not expire if no expiry is given")
 	outputJSONFlag := fs.Bool(FlagToolOutputJSON, false, FlagToolDescriptionOutputJSON)
 
 	fs.Usage = func() {
 		_, _ = fmt.Fprintf(os.Stderr, "Usage of %s:\n", ToolJWTApi)
 		fs.PrintDefaults()
-		println(fmt.Sprintf("\nexample: %s --%s %s --%s %s",
+		println(fmt.Sprintf("\nexample: %s --%s %s --%s %s --%s %s,%s --%s %s",
 			ToolJWTApi,
 			FlagToolIdentityPrivateKeyFilePath,
 			DefaultValueIdentityPrivateKeyFilePath,
 			FlagToolSalt,
-			DefaultValueAPIJWTTokenSalt))
+			DefaultValueAPIJWTTokenSalt,
+			FlagToolScopes,
+			jwt.ScopeCoreRead,
+			jwt.ScopeManagementRead,
+			FlagToolExpiry,
+			"720h"))
 	}
 
 	if err := parseFlagSet(fs, args); err != nil {
@@ -41,6 +49,12 @@ func generateJWTApiToken(args []string) error {
 	if len(*apiJWTSaltFlag) == 0 {
 		return ierrors.Errorf("'%s' not specified", FlagToolSalt)
 	}
+	if *expiryFlag < 0 {
+		return ierrors.Errorf("'%s' must not be negative", FlagToolExpiry)
+	}
+	if *expiryFlag > 0 && len(*scopesFlag) == 0 {
+		return ierrors.Errorf("'%s' can only be used together with '%s'", FlagToolExpiry, FlagToolScopes)
+	}
 
 	salt := *apiJWTSaltFlag
 
@@ -72,7 +86,7 @@ func generateJWTApiToken(args []string) error {
 		return ierrors.Wrap(err, "unable to get peer identity from public key")
 	}
 
-	// API tokens do not expire.
+	// unscoped API tokens do not expire.
 	jwtAuth, err := jwt.NewAuth(salt,
 		0,
 		peerID.String(),
@@ -82,16 +96,23 @@ func generateJWTApiToken(args []string) error {
 		return ierrors.Wrap(err, "JWT auth initialization failed")
 	}
 
-	jwtToken, err := jwtAuth.IssueJWT()
+	var jwtToken string
+	if len(*scopesFlag) > 0 {
+		jwtToken, err = jwtAuth.IssueScopedJWT(*scopesFlag, *expiryFlag)
+	} else {
+		jwtToken, err = jwtAuth.IssueJWT()
+	}
 	if err != nil {
 		return ierrors.Wrap(err, "issuing JWT token failed")
 	}
 
 	if *outputJSONFlag {
 		result := struct {
-			JWT string `json:"jwt"`
+			JWT    string   `json:"jwt"`
+			Scopes []string `json:"scopes,omitempty"`
 		}{
-			JWT: jwtToken,
+			JWT:    jwtToken,
+			Scopes: *scopesFlag,
 		}
 
 		return printJSON(result)
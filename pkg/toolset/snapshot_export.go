@@ -0,0 +1,295 @@
+package toolset
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	copydir "github.com/otiai10/copy"
+	flag "github.com/spf13/pflag"
+
+	"github.com/iotaledger/hive.go/app/configuration"
+	hivedb "github.com/iotaledger/hive.go/db"
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/log"
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/hive.go/runtime/workerpool"
+	"github.com/iotaledger/iota-core/pkg/protocol"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/attestation/slotattestation"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/blockdag/inmemoryblockdag"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/booker/inmemorybooker"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/clock/blocktime"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/congestioncontrol/scheduler/passthrough"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/consensus/blockgadget/thresholdblockgadget"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/consensus/slotgadget/totalweightslotgadget"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/filter/postsolidfilter/postsolidblockfilter"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/filter/presolidfilter/presolidblockfilter"
+	ledger1 "github.com/iotaledger/iota-core/pkg/protocol/engine/ledger/ledger"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/notarization/slotnotarization"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/syncmanager/trivialsyncmanager"
+	tipmanagerv1 "github.com/iotaledger/iota-core/pkg/protocol/engine/tipmanager/v1"
+	tipselectionv1 "github.com/iotaledger/iota-core/pkg/protocol/engine/tipselection/v1"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/upgrade/signalingupgradeorchestrator"
+	"github.com/iotaledger/iota-core/pkg/protocol/sybilprotection/sybilprotectionv1"
+	"github.com/iotaledger/iota-core/pkg/retainer/blockretainer"
+	"github.com/iotaledger/iota-core/pkg/retainer/txretainer"
+	"github.com/iotaledger/iota-core/pkg/storage"
+	"github.com/iotaledger/iota-core/pkg/storage/database"
+	"github.com/iotaledger/iota-core/pkg/storage/snapshot"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func snapshotExport(args []string) error {
+	fs := configuration.NewUnsortedFlagSet("", flag.ContinueOnError)
+	databasePathFlag := fs.String(FlagToolDatabasePath, DefaultValueDatabasePath, "the path to the database of the stopped node")
+	databaseEngineFlag := fs.String(FlagToolDatabaseEngine, string(hivedb.EngineRocksDB), "the engine of the database (values: rocksdb)")
+	outputPathFlag := fs.String(FlagToolOutputPath, DefaultValueSnapshotExportPath, "the path of the snapshot file to write ('-' writes a stream snapshot to stdout)")
+	targetSlotFlag := fs.Uint32(FlagToolSnapshotTargetSlot, 0, "the slot the snapshot is created for (0 = latest finalized slot)")
+	formatFlag := fs.String(FlagToolSnapshotFormat, SnapshotFormatLegacy, fmt.Sprintf("the format of the snapshot (values: %s, %s)", SnapshotFormatLegacy, SnapshotFormatStream))
+	compressionFlag := fs.String(FlagToolCompression, snapshot.CompressionZstd.String(), fmt.Sprintf("the compression of a stream snapshot (values: %s, %s)", snapshot.CompressionNone, snapshot.CompressionZstd))
+	overwriteFlag := fs.Bool(FlagToolOverwrite, false, "overwrite the snapshot file if it already exists")
+
+	fs.Usage = func() {
+		_, _ = fmt.Fprintf(os.Stderr, "Usage of %s:\n", ToolSnapshotExport)
+		fs.PrintDefaults()
+		println(fmt.Sprintf("\nexample: %s --%s %s --%s %s",
+			ToolSnapshotExport,
+			FlagToolDatabasePath,
+			DefaultValueDatabasePath,
+			FlagToolOutputPath,
+			DefaultValueSnapshotExportPath))
+		println(fmt.Sprintf("example: %s --%s %s --%s %s --%s - | aws s3 cp - s3://<bucket>/snapshot.snap",
+			ToolSnapshotExport,
+			FlagToolDatabasePath,
+			DefaultValueDatabasePath,
+			FlagToolSnapshotFormat,
+			SnapshotFormatStream,
+			FlagToolOutputPath))
+	}
+
+	if err := parseFlagSet(fs, args); err != nil {
+		return err
+	}
+
+	if len(*databasePathFlag) == 0 {
+		return ierrors.Errorf("'%s' not specified", FlagToolDatabasePath)
+	}
+	if len(*outputPathFlag) == 0 {
+		return ierrors.Errorf("'%s' not specified", FlagToolOutputPath)
+	}
+
+	if *formatFlag != SnapshotFormatLegacy && *formatFlag != SnapshotFormatStream {
+		return ierrors.Errorf("unknown snapshot format '%s'", *formatFlag)
+	}
+
+	compression, err := snapshot.CompressionFromString(*compressionFlag)
+	if err != nil {
+		return err
+	}
+
+	// when the snapshot is written to stdout, all status and log messages go to stderr.
+	toStdout := *outputPathFlag == "-"
+	statusOutput := os.Stdout
+	if toStdout {
+		if *formatFlag != SnapshotFormatStream {
+			return ierrors.Errorf("only %s snapshots can be written to stdout", SnapshotFormatStream)
+		}
+
+		statusOutput = os.Stderr
+	} else if _, err := os.Stat(*outputPathFlag); err == nil && !*overwriteFlag {
+		return ierrors.Errorf("snapshot file '%s' already exists (use --%s to overwrite it)", *outputPathFlag, FlagToolOverwrite)
+	} else if err != nil && !os.IsNotExist(err) {
+		return ierrors.Wrapf(err, "unable to check snapshot file '%s'", *outputPathFlag)
+	}
+
+	dbEngine, err := hivedb.EngineFromStringAllowed(*databaseEngineFlag, database.AllowedEnginesStorage)
+	if err != nil {
+		return err
+	}
+
+	engineDirectory, err := protocol.MainEngineDirectory(*databasePathFlag)
+	if err != nil {
+		return err
+	}
+
+	logger := log.NewLogger(log.WithName(ToolSnapshotExport), log.WithLevel(log.LevelWarning), log.WithOutput(statusOutput))
+
+	// the copy of the database is placed next to the snapshot to avoid filling up a small tmpfs.
+	copyParentDirectory := os.TempDir()
+	if !toStdout {
+		copyParentDirectory = filepath.Dir(*outputPathFlag)
+	}
+
+	copyDirectory, err := os.MkdirTemp(copyParentDirectory, "snapshot-export-")
+	if err != nil {
+		return ierrors.Wrap(err, "failed to create directory for the database copy")
+	}
+	defer os.RemoveAll(copyDirectory)
+
+	engineInstance, err := loadOfflineEngine(logger, engineDirectory, copyDirectory, dbEngine)
+	if err != nil {
+		return err
+	}
+	defer engineInstance.ShutdownEvent().Trigger()
+
+	targetSlot := iotago.SlotIndex(*targetSlotFlag)
+	if targetSlot == 0 {
+		targetSlot = engineInstance.Storage.Settings().LatestFinalizedSlot()
+	}
+
+	_, _ = fmt.Fprintf(statusOutput, "creating %s snapshot for slot %d (latest committed slot: %d, latest finalized slot: %d)...\n",
+		*formatFlag,
+		targetSlot,
+		engineInstance.Storage.Settings().LatestCommitment().Slot(),
+		engineInstance.Storage.Settings().LatestFinalizedSlot(),
+	)
+
+	ts := time.Now()
+	if err := writeSnapshot(engineInstance, *outputPathFlag, *formatFlag, compression, targetSlot); err != nil {
+		return ierrors.Wrapf(err, "failed to create snapshot for slot %d", targetSlot)
+	}
+
+	targetCommitment, err := engineInstance.Storage.Commitments().Load(targetSlot)
+	if err != nil {
+		return ierrors.Wrapf(err, "failed to load commitment for slot %d", targetSlot)
+	}
+
+	_, _ = fmt.Fprintf(statusOutput, "snapshot created, took %v\n", time.Since(ts).Truncate(time.Millisecond))
+	_, _ = fmt.Fprintf(statusOutput, `>
+	- File path: %s
+	- Slot: %d
+	- Commitment ID: %s
+`, *outputPathFlag, targetSlot, targetCommitment.ID().ToHex())
+
+	return nil
+}
+
+// writeSnapshot writes the snapshot for the target slot in the given format to the output path or to stdout.
+func writeSnapshot(engineInstance *engine.Engine, outputPath string, format string, compression snapshot.Compression, targetSlot iotago.SlotIndex) error {
+	if format == SnapshotFormatLegacy {
+		return engineInstance.WriteSnapshot(outputPath, targetSlot)
+	}
+
+	if outputPath == "-" {
+		return engineInstance.WriteSnapshotStream(os.Stdout, targetSlot, snapshot.WithCompression(compression))
+	}
+
+	fileHandle, err := os.Create(outputPath)
+	if err != nil {
+		return ierrors.Wrap(err, "failed to create snapshot file")
+	}
+
+	// the temporary files of large sections are placed next to the snapshot to avoid filling up a small tmpfs.
+	if err = engineInstance.WriteSnapshotStream(fileHandle, targetSlot, snapshot.WithCompression(compression), snapshot.WithTemporaryDirectory(filepath.Dir(outputPath))); err != nil {
+		_ = fileHandle.Close()
+
+		return err
+	}
+
+	if err = fileHandle.Close(); err != nil {
+		return ierrors.Wrap(err, "failed to close snapshot file")
+	}
+
+	return nil
+}
+
+// loadOfflineEngine constructs an engine on top of a copy of the database in the given engine directory
+// without starting the protocol or any networking, so that snapshots can be written from a stopped node.
+// Restoring an engine resets the storage to the latest commitment, so it must never run on the database of the node itself.
+func loadOfflineEngine(logger log.Logger, engineDirectory string, copyDirectory string, dbEngine hivedb.Engine) (*engine.Engine, error) {
+	if err := checkSnapshotImported(logger, engineDirectory, dbEngine); err != nil {
+		return nil, err
+	}
+
+	if err := copydir.Copy(engineDirectory, copyDirectory); err != nil {
+		return nil, ierrors.Wrapf(err, "failed to copy database from '%s' to '%s'", engineDirectory, copyDirectory)
+	}
+
+	var storageErr error
+	errorHandler := func(err error) {
+		storageErr = err
+	}
+
+	s, err := storage.Open(logger, copyDirectory, protocol.DatabaseVersion, errorHandler, storage.WithDBEngine(dbEngine))
+	if err != nil {
+		return nil, ierrors.Wrapf(err, "failed to open copy of database in '%s'", engineDirectory)
+	}
+
+	engineInstance, err := newOfflineEngine(logger, "SnapshotExport", s,
+		engine.WithSnapshotPath(""), // the snapshot was already imported, we restore the state from disk
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if storageErr != nil {
+		engineInstance.ShutdownEvent().Trigger()
+
+		return nil, ierrors.Wrap(storageErr, "storage error while loading engine")
+	}
+
+	return engineInstance, nil
+}
+
+// checkSnapshotImported opens the database in the given engine directory read-only and checks that it was initialized from a snapshot.
+func checkSnapshotImported(logger log.Logger, engineDirectory string, dbEngine hivedb.Engine) error {
+	var storageErr error
+	errorHandler := func(err error) {
+		storageErr = err
+	}
+
+	s, err := storage.Open(logger, engineDirectory, protocol.DatabaseVersion, errorHandler, storage.WithDBEngine(dbEngine), storage.WithReadOnly(true))
+	if err != nil {
+		return ierrors.Wrapf(err, "failed to open database in '%s'", engineDirectory)
+	}
+	defer s.Shutdown()
+
+	if storageErr != nil {
+		return ierrors.Wrap(storageErr, "storage error while opening database")
+	}
+
+	if !s.Settings().IsSnapshotImported() {
+		return ierrors.Errorf("database in '%s' was never initialized from a snapshot", engineDirectory)
+	}
+
+	return nil
+}
+
+// newOfflineEngine creates an engine with the default modules on top of the given storage.
+// Consensus is never driven by the engine, it is only used to access, import and export the stored state.
+func newOfflineEngine(logger log.Logger, name string, s *storage.Storage, opts ...options.Option[engine.Engine]) (engineInstance *engine.Engine, err error) {
+	// the engine panics if the state can't be restored or imported, so we turn that into an error instead.
+	defer func() {
+		if r := recover(); r != nil {
+			s.Shutdown()
+			engineInstance, err = nil, ierrors.Errorf("failed to load engine: %v", r)
+		}
+	}()
+
+	return engine.New(
+		logger,
+		workerpool.NewGroup(name),
+		s,
+		presolidblockfilter.NewProvider(),
+		postsolidblockfilter.NewProvider(),
+		inmemoryblockdag.NewProvider(),
+		inmemorybooker.NewProvider(),
+		blocktime.NewProvider(),
+		thresholdblockgadget.NewProvider(),
+		totalweightslotgadget.NewProvider(),
+		sybilprotectionv1.NewProvider(),
+		slotnotarization.NewProvider(),
+		trivialsyncmanager.NewProvider(),
+		slotattestation.NewProvider(),
+		ledger1.NewProvider(),
+		passthrough.NewProvider(),
+		tipmanagerv1.NewProvider(),
+		tipselectionv1.NewProvider(),
+		blockretainer.NewProvider(),
+		txretainer.NewProvider(),
+		signalingupgradeorchestrator.NewProvider(),
+		opts...,
+	), nil
+}
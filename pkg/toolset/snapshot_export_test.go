@@ -0,0 +1,72 @@
+package toolset
+
+import (
+	"path/filepath"
+	"testing"
+
+	copydir "github.com/otiai10/copy"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/log"
+	"github.com/iotaledger/iota-core/pkg/protocol"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine"
+	"github.com/iotaledger/iota-core/pkg/storage"
+)
+
+// exportedState returns the latest finalized commitment and the roots of the database in the given path, which are
+// the state a snapshot is exported for by default.
+func exportedState(t *testing.T, databasePath string) (commitmentID string, rootsID string) {
+	engineDirectory, err := protocol.MainEngineDirectory(databasePath)
+	require.NoError(t, err)
+
+	s, err := storage.Open(log.NewLogger(), engineDirectory, protocol.DatabaseVersion, func(err error) { require.NoError(t, err) }, storage.WithReadOnly(true))
+	require.NoError(t, err)
+	defer s.Shutdown()
+
+	commitment, err := s.Commitments().Load(s.Settings().LatestFinalizedSlot())
+	require.NoError(t, err)
+
+	return commitment.ID().ToHex(), commitment.RootsID().ToHex()
+}
+
+func TestSnapshotExport(t *testing.T) {
+	databasePath, _ := createTestDatabase(t)
+	commitmentID, rootsID := exportedState(t, databasePath)
+
+	// keep a copy of the database to check that the export doesn't modify it.
+	databaseCopyPath := filepath.Join(t.TempDir(), "database")
+	require.NoError(t, copydir.Copy(databasePath, databaseCopyPath))
+
+	for _, format := range []string{SnapshotFormatLegacy, SnapshotFormatStream} {
+		t.Run(format, func(t *testing.T) {
+			snapshotPath := filepath.Join(t.TempDir(), "snapshot.bin")
+			require.NoError(t, snapshotExport([]string{
+				"--" + FlagToolDatabasePath, databasePath,
+				"--" + FlagToolOutputPath, snapshotPath,
+				"--" + FlagToolSnapshotFormat, format,
+			}))
+			require.NoError(t, verifyFilesEqual(databaseCopyPath, databasePath))
+
+			// the snapshot file is never overwritten by accident.
+			require.Error(t, snapshotExport([]string{
+				"--" + FlagToolDatabasePath, databasePath,
+				"--" + FlagToolOutputPath, snapshotPath,
+				"--" + FlagToolSnapshotFormat, format,
+			}))
+
+			// import the snapshot into a new database.
+			s := storage.Create(log.NewLogger(), t.TempDir(), protocol.DatabaseVersion, func(err error) { require.NoError(t, err) })
+			engineInstance, err := newOfflineEngine(log.NewLogger(), "SnapshotImport", s,
+				engine.WithSnapshotPath(snapshotPath),
+				engine.WithCommitmentCheck(true),
+			)
+			require.NoError(t, err)
+			defer engineInstance.ShutdownEvent().Trigger()
+
+			latestCommitment := engineInstance.Storage.Settings().LatestCommitment()
+			require.Equal(t, commitmentID, latestCommitment.ID().ToHex())
+			require.Equal(t, rootsID, latestCommitment.RootsID().ToHex())
+			require.NoError(t, engineInstance.Storage.CheckCorrectnessCommitmentLedgerState())
+		})
+	}
+}
@@ -0,0 +1,1026 @@
+package toolset
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	flag "github.com/spf13/pflag"
+
+	"github.com/iotaledger/hive.go/app/configuration"
+	hivedb "github.com/iotaledger/hive.go/db"
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/log"
+	"github.com/iotaledger/hive.go/runtime/module"
+	"github.com/iotaledger/iota-core/pkg/core/account"
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/protocol"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/accounts"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/accounts/accountsledger"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/utxoledger"
+	"github.com/iotaledger/iota-core/pkg/storage"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+const (
+	snapshotSectionSettings    = "settings"
+	snapshotSectionCommitments = "commitments"
+	snapshotSectionLedger      = "ledger"
+	snapshotSectionAccounts    = "accounts"
+	snapshotSectionRootBlocks  = "root-blocks"
+	snapshotSectionCommittee   = "committee"
+	snapshotSectionRoots       = "roots"
+
+	snapshotSectionUpgradeOrchestrator = "upgrade-orchestrator"
+
+	// snapshotDiffMaxEntries is the maximum amount of differences that are listed per section.
+	snapshotDiffMaxEntries = 20
+)
+
+type snapshotProtocolVersion struct {
+	Version                iotago.Version    `json:"version"`
+	StartEpoch             iotago.EpochIndex `json:"startEpoch"`
+	ProtocolParametersHash string            `json:"protocolParametersHash"`
+}
+
+type snapshotSettingsInfo struct {
+	Slot                iotago.SlotIndex           `json:"slot"`
+	CommitmentID        string                     `json:"commitmentId"`
+	ProtocolVersion     iotago.Version             `json:"protocolVersion"`
+	LatestFinalizedSlot iotago.SlotIndex           `json:"latestFinalizedSlot"`
+	LatestNonEmptySlot  iotago.SlotIndex           `json:"latestNonEmptySlot"`
+	NetworkName         string                     `json:"networkName"`
+	TokenSupply         iotago.BaseToken           `json:"tokenSupply"`
+	ProtocolVersions    []*snapshotProtocolVersion `json:"protocolVersions"`
+}
+
+type snapshotCommitmentsInfo struct {
+	FirstSlot iotago.SlotIndex `json:"firstSlot"`
+	LastSlot  iotago.SlotIndex `json:"lastSlot"`
+	Count     int              `json:"count"`
+}
+
+type snapshotLedgerInfo struct {
+	UnspentOutputs  int              `json:"unspentOutputs"`
+	OutputsByType   map[string]int   `json:"outputsByType"`
+	TotalAmount     iotago.BaseToken `json:"totalAmount"`
+	StateTreeRoot   string           `json:"stateTreeRoot"`
+	StateConsistent bool             `json:"stateConsistent"`
+	StateError      string           `json:"stateError,omitempty"`
+}
+
+type snapshotAccountsInfo struct {
+	Count            int                         `json:"count"`
+	Validators       int                         `json:"validators"`
+	TotalCredits     iotago.BlockIssuanceCredits `json:"totalCredits"`
+	AccountsTreeRoot string                      `json:"accountsTreeRoot"`
+}
+
+type snapshotRootBlocksInfo struct {
+	Count            int    `json:"count"`
+	RootCommitmentID string `json:"rootCommitmentId"`
+}
+
+type snapshotCommitteeMember struct {
+	AccountID      string            `json:"accountId"`
+	Seat           account.SeatIndex `json:"seat"`
+	PoolStake      iotago.BaseToken  `json:"poolStake"`
+	ValidatorStake iotago.BaseToken  `json:"validatorStake"`
+	FixedCost      iotago.Mana       `json:"fixedCost"`
+}
+
+type snapshotCommitteeInfo struct {
+	Epoch               iotago.EpochIndex          `json:"epoch"`
+	Seats               int                        `json:"seats"`
+	TotalStake          iotago.BaseToken           `json:"totalStake"`
+	TotalValidatorStake iotago.BaseToken           `json:"totalValidatorStake"`
+	Members             []*snapshotCommitteeMember `json:"members"`
+}
+
+type snapshotRootsInfo struct {
+	TangleRoot             string `json:"tangleRoot"`
+	StateMutationRoot      string `json:"stateMutationRoot"`
+	StateRoot              string `json:"stateRoot"`
+	AccountRoot            string `json:"accountRoot"`
+	AttestationsRoot       string `json:"attestationsRoot"`
+	CommitteeRoot          string `json:"committeeRoot"`
+	RewardsRoot            string `json:"rewardsRoot"`
+	ProtocolParametersHash string `json:"protocolParametersHash"`
+}
+
+type snapshotUpgradeSignal struct {
+	Seat                    account.SeatIndex `json:"seat"`
+	BlockID                 string            `json:"blockId"`
+	HighestSupportedVersion iotago.Version    `json:"highestSupportedVersion"`
+	ProtocolParametersHash  string            `json:"protocolParametersHash"`
+}
+
+type snapshotDecidedUpgrade struct {
+	Epoch                  iotago.EpochIndex `json:"epoch"`
+	Version                iotago.Version    `json:"version"`
+	ProtocolParametersHash string            `json:"protocolParametersHash"`
+}
+
+type snapshotUpgradeOrchestratorInfo struct {
+	Slot            iotago.SlotIndex          `json:"slot"`
+	Signals         []*snapshotUpgradeSignal  `json:"signals"`
+	DecidedUpgrades []*snapshotDecidedUpgrade `json:"decidedUpgrades"`
+}
+
+// snapshotInfo is the summary of the sections of a snapshot file.
+type snapshotInfo struct {
+	FilePath    string                   `json:"filePath"`
+	FileSize    int64                    `json:"fileSize"`
+	Settings    *snapshotSettingsInfo    `json:"settings"`
+	Commitments *snapshotCommitmentsInfo `json:"commitments"`
+	Ledger      *snapshotLedgerInfo      `json:"ledger"`
+	Accounts    *snapshotAccountsInfo    `json:"accounts"`
+	RootBlocks  *snapshotRootBlocksInfo  `json:"rootBlocks"`
+	Committee   *snapshotCommitteeInfo   `json:"committee"`
+	Roots       *snapshotRootsInfo       `json:"roots,omitempty"`
+
+	UpgradeOrchestrator *snapshotUpgradeOrchestratorInfo `json:"upgradeOrchestrator"`
+
+	// contents holds the individual entries of the sections, it is only collected if a diff is requested.
+	contents *snapshotContents
+}
+
+// snapshotContents contains the individual entries of the snapshot sections that are compared by the diff.
+type snapshotContents struct {
+	commitmentIDs map[iotago.SlotIndex]iotago.CommitmentID
+	outputs       map[iotago.OutputID]iotago.Identifier
+	accounts      map[iotago.AccountID]*accounts.AccountData
+	rootBlocks    map[iotago.BlockID]iotago.CommitmentID
+	committee     map[iotago.AccountID]*account.Pool
+
+	upgradeSignals  map[account.SeatIndex]*model.SignaledBlock
+	decidedUpgrades map[iotago.EpochIndex]model.VersionAndHash
+}
+
+// snapshotSectionDiff is the result of the comparison of a single section of two snapshots.
+type snapshotSectionDiff struct {
+	Section     string   `json:"section"`
+	Equal       bool     `json:"equal"`
+	Differences []string `json:"differences,omitempty"`
+	// Omitted is the amount of differences that were not listed.
+	Omitted int `json:"omitted,omitempty"`
+}
+
+func (d *snapshotSectionDiff) addDifference(format string, args ...interface{}) {
+	d.Equal = false
+
+	if len(d.Differences) >= snapshotDiffMaxEntries {
+		d.Omitted++
+
+		return
+	}
+
+	d.Differences = append(d.Differences, fmt.Sprintf(format, args...))
+}
+
+func (d *snapshotSectionDiff) compare(name string, valueA interface{}, valueB interface{}) {
+	if valueA != valueB {
+		d.addDifference("%s: %v != %v", name, valueA, valueB)
+	}
+}
+
+// snapshotDiffReport is the result of the comparison of two snapshots.
+type snapshotDiffReport struct {
+	SnapshotA string                 `json:"snapshotA"`
+	SnapshotB string                 `json:"snapshotB"`
+	Equal     bool                   `json:"equal"`
+	Sections  []*snapshotSectionDiff `json:"sections"`
+}
+
+func snapshotInfoTool(args []string) error {
+	fs := configuration.NewUnsortedFlagSet("", flag.ContinueOnError)
+	snapshotPathFlag := fs.String(FlagToolSnapshotPath, DefaultValueSnapshotPath, "the path to the snapshot file")
+	databaseEngineFlag := fs.String(FlagToolDatabaseEngine, string(hivedb.EngineMapDB), "the engine of the temporary database the snapshot is loaded into (values: mapdb, rocksdb)")
+	outputJSONFlag := fs.Bool(FlagToolOutputJSON, false, FlagToolDescriptionOutputJSON)
+
+	fs.Usage = func() {
+		_, _ = fmt.Fprintf(os.Stderr, "Usage of %s:\n", ToolSnapshotInfo)
+		fs.PrintDefaults()
+		println(fmt.Sprintf("\nexample: %s --%s %s",
+			ToolSnapshotInfo,
+			FlagToolSnapshotPath,
+			DefaultValueSnapshotPath))
+	}
+
+	if err := parseFlagSet(fs, args); err != nil {
+		return err
+	}
+
+	if len(*snapshotPathFlag) == 0 {
+		return ierrors.Errorf("'%s' not specified", FlagToolSnapshotPath)
+	}
+
+	dbEngine, err := hivedb.EngineFromStringAllowed(*databaseEngineFlag, []hivedb.Engine{hivedb.EngineMapDB, hivedb.EngineRocksDB})
+	if err != nil {
+		return err
+	}
+
+	info, err := readSnapshotInfo(*snapshotPathFlag, dbEngine, false)
+	if err != nil {
+		return err
+	}
+
+	if *outputJSONFlag {
+		return printJSON(info)
+	}
+
+	printSnapshotInfo(info)
+
+	return nil
+}
+
+func snapshotDiffTool(args []string) error {
+	fs := configuration.NewUnsortedFlagSet("", flag.ContinueOnError)
+	snapshotPathAFlag := fs.String(FlagToolSnapshotPathA, "", "the path to the first snapshot file")
+	snapshotPathBFlag := fs.String(FlagToolSnapshotPathB, "", "the path to the second snapshot file")
+	databaseEngineFlag := fs.String(FlagToolDatabaseEngine, string(hivedb.EngineMapDB), "the engine of the temporary databases the snapshots are loaded into (values: mapdb, rocksdb)")
+	outputJSONFlag := fs.Bool(FlagToolOutputJSON, false, FlagToolDescriptionOutputJSON)
+
+	fs.Usage = func() {
+		_, _ = fmt.Fprintf(os.Stderr, "Usage of %s:\n", ToolSnapshotDiff)
+		fs.PrintDefaults()
+		println(fmt.Sprintf("\nexample: %s --%s %s --%s %s",
+			ToolSnapshotDiff,
+			FlagToolSnapshotPathA,
+			"snapshot_a.bin",
+			FlagToolSnapshotPathB,
+			"snapshot_b.bin"))
+	}
+
+	if err := parseFlagSet(fs, args); err != nil {
+		return err
+	}
+
+	if len(*snapshotPathAFlag) == 0 {
+		return ierrors.Errorf("'%s' not specified", FlagToolSnapshotPathA)
+	}
+	if len(*snapshotPathBFlag) == 0 {
+		return ierrors.Errorf("'%s' not specified", FlagToolSnapshotPathB)
+	}
+
+	dbEngine, err := hivedb.EngineFromStringAllowed(*databaseEngineFlag, []hivedb.Engine{hivedb.EngineMapDB, hivedb.EngineRocksDB})
+	if err != nil {
+		return err
+	}
+
+	infoA, err := readSnapshotInfo(*snapshotPathAFlag, dbEngine, true)
+	if err != nil {
+		return ierrors.Wrapf(err, "failed to read snapshot '%s'", *snapshotPathAFlag)
+	}
+
+	infoB, err := readSnapshotInfo(*snapshotPathBFlag, dbEngine, true)
+	if err != nil {
+		return ierrors.Wrapf(err, "failed to read snapshot '%s'", *snapshotPathBFlag)
+	}
+
+	report := diffSnapshots(infoA, infoB)
+
+	if *outputJSONFlag {
+		if err := printJSON(report); err != nil {
+			return err
+		}
+	} else {
+		printSnapshotDiffReport(report)
+	}
+
+	if !report.Equal {
+		return ierrors.New("snapshots differ")
+	}
+
+	return nil
+}
+
+// readSnapshotInfo imports the snapshot into a temporary engine and collects the summary of all its sections.
+func readSnapshotInfo(filePath string, dbEngine hivedb.Engine, collectContents bool) (*snapshotInfo, error) {
+	fileInfo, err := os.Stat(filePath)
+	if err != nil {
+		return nil, ierrors.Wrapf(err, "unable to open snapshot file '%s'", filePath)
+	}
+
+	tempDirectory, err := os.MkdirTemp("", "snapshot-info-*")
+	if err != nil {
+		return nil, ierrors.Wrap(err, "failed to create temporary directory")
+	}
+	defer os.RemoveAll(tempDirectory)
+
+	var storageErr error
+	errorHandler := func(err error) {
+		storageErr = err
+	}
+
+	logger := log.NewLogger(log.WithName(ToolSnapshotInfo), log.WithLevel(log.LevelWarning))
+	s := storage.Create(logger, tempDirectory, protocol.DatabaseVersion, errorHandler, storage.WithDBEngine(dbEngine))
+
+	engineInstance, err := newOfflineEngine(logger, "SnapshotInfo", s,
+		engine.WithSnapshotPath(filePath),
+		// the consistency of the ledger state is reported as part of the info instead.
+		engine.WithCommitmentCheck(false),
+	)
+	if err != nil {
+		return nil, ierrors.Wrapf(err, "failed to import snapshot '%s'", filePath)
+	}
+	defer engineInstance.ShutdownEvent().Trigger()
+
+	if storageErr != nil {
+		return nil, ierrors.Wrapf(storageErr, "storage error while importing snapshot '%s'", filePath)
+	}
+
+	info := &snapshotInfo{
+		FilePath: filePath,
+		FileSize: fileInfo.Size(),
+	}
+	if collectContents {
+		info.contents = &snapshotContents{
+			commitmentIDs: make(map[iotago.SlotIndex]iotago.CommitmentID),
+			outputs:       make(map[iotago.OutputID]iotago.Identifier),
+			accounts:      make(map[iotago.AccountID]*accounts.AccountData),
+			rootBlocks:    make(map[iotago.BlockID]iotago.CommitmentID),
+			committee:     make(map[iotago.AccountID]*account.Pool),
+
+			upgradeSignals:  make(map[account.SeatIndex]*model.SignaledBlock),
+			decidedUpgrades: make(map[iotago.EpochIndex]model.VersionAndHash),
+		}
+	}
+
+	for _, collectSection := range []func(*engine.Engine, *snapshotInfo) error{
+		collectSnapshotSettings,
+		collectSnapshotCommitments,
+		collectSnapshotLedger,
+		collectSnapshotAccounts,
+		collectSnapshotRootBlocks,
+		collectSnapshotCommittee,
+		collectSnapshotRoots,
+		collectSnapshotUpgradeOrchestrator,
+	} {
+		if err := collectSection(engineInstance, info); err != nil {
+			return nil, err
+		}
+	}
+
+	return info, nil
+}
+
+func collectSnapshotSettings(e *engine.Engine, info *snapshotInfo) error {
+	settings := e.Storage.Settings()
+	latestCommitment := settings.LatestCommitment()
+	apiProvider := settings.APIProvider()
+
+	info.Settings = &snapshotSettingsInfo{
+		Slot:                latestCommitment.Slot(),
+		CommitmentID:        latestCommitment.ID().ToHex(),
+		ProtocolVersion:     latestCommitment.Commitment().ProtocolVersion,
+		LatestFinalizedSlot: settings.LatestFinalizedSlot(),
+		LatestNonEmptySlot:  settings.LatestNonEmptySlot(),
+		NetworkName:         apiProvider.CommittedAPI().ProtocolParameters().NetworkName(),
+		TokenSupply:         apiProvider.CommittedAPI().ProtocolParameters().TokenSupply(),
+	}
+
+	for _, protocolEpochVersion := range apiProvider.ProtocolEpochVersions() {
+		info.Settings.ProtocolVersions = append(info.Settings.ProtocolVersions, &snapshotProtocolVersion{
+			Version:                protocolEpochVersion.Version,
+			StartEpoch:             protocolEpochVersion.StartEpoch,
+			ProtocolParametersHash: apiProvider.ProtocolParametersHash(protocolEpochVersion.Version).ToHex(),
+		})
+	}
+
+	return nil
+}
+
+func collectSnapshotCommitments(e *engine.Engine, info *snapshotInfo) error {
+	info.Commitments = &snapshotCommitmentsInfo{
+		FirstSlot: e.CommittedAPI().ProtocolParameters().GenesisSlot(),
+		LastSlot:  e.Storage.Settings().LatestCommitment().Slot(),
+	}
+
+	for slot := info.Commitments.FirstSlot; slot <= info.Commitments.LastSlot; slot++ {
+		commitment, err := e.Storage.Commitments().Load(slot)
+		if err != nil {
+			return ierrors.Wrapf(err, "failed to load commitment for slot %d", slot)
+		}
+
+		info.Commitments.Count++
+
+		if info.contents != nil {
+			info.contents.commitmentIDs[slot] = commitment.ID()
+		}
+	}
+
+	return nil
+}
+
+func collectSnapshotLedger(e *engine.Engine, info *snapshotInfo) error {
+	ledger := e.Storage.Ledger()
+
+	info.Ledger = &snapshotLedgerInfo{
+		OutputsByType:   make(map[string]int),
+		StateTreeRoot:   ledger.StateTreeRoot().ToHex(),
+		StateConsistent: true,
+	}
+
+	if err := ledger.ForEachUnspentOutput(func(output *utxoledger.Output) bool {
+		info.Ledger.UnspentOutputs++
+		info.Ledger.OutputsByType[output.OutputType().String()]++
+		info.Ledger.TotalAmount += output.BaseTokenAmount()
+
+		if info.contents != nil {
+			info.contents.outputs[output.OutputID()] = iotago.IdentifierFromData(output.SnapshotBytes())
+		}
+
+		return true
+	}); err != nil {
+		return ierrors.Wrap(err, "failed to iterate over unspent outputs")
+	}
+
+	if err := e.Storage.CheckCorrectnessCommitmentLedgerState(); err != nil {
+		info.Ledger.StateConsistent = false
+		info.Ledger.StateError = err.Error()
+	} else if err := ledger.CheckLedgerState(info.Settings.TokenSupply); err != nil {
+		info.Ledger.StateConsistent = false
+		info.Ledger.StateError = err.Error()
+	}
+
+	return nil
+}
+
+func collectSnapshotAccounts(e *engine.Engine, info *snapshotInfo) error {
+	accountsLedger := accountsledger.New(module.New(e.NewChildLogger("AccountsLedger")), e.Storage.Settings().APIProvider(), nil, e.Storage.AccountDiffs, e.Storage.Accounts())
+
+	info.Accounts = &snapshotAccountsInfo{
+		AccountsTreeRoot: accountsLedger.AccountsTreeRoot().ToHex(),
+	}
+
+	if err := accountsLedger.ForEachAccount(func(accountData *accounts.AccountData) error {
+		info.Accounts.Count++
+		info.Accounts.TotalCredits += accountData.Credits().Value()
+
+		if accountData.ValidatorStake() > 0 {
+			info.Accounts.Validators++
+		}
+
+		if info.contents != nil {
+			info.contents.accounts[accountData.ID()] = accountData
+		}
+
+		return nil
+	}); err != nil {
+		return ierrors.Wrap(err, "failed to iterate over accounts")
+	}
+
+	return nil
+}
+
+func collectSnapshotRootBlocks(e *engine.Engine, info *snapshotInfo) error {
+	info.RootBlocks = &snapshotRootBlocksInfo{
+		RootCommitmentID: e.RootCommitment.Get().ID().ToHex(),
+	}
+
+	// root blocks of pruned epochs are not part of the snapshot.
+	startSlot := e.CommittedAPI().ProtocolParameters().GenesisSlot()
+	if lastPrunedEpoch, hasPruned := e.Storage.LastPrunedEpoch(); hasPruned {
+		startSlot = e.APIForEpoch(lastPrunedEpoch + 1).TimeProvider().EpochStart(lastPrunedEpoch + 1)
+	}
+
+	for slot := startSlot; slot <= e.Storage.Settings().LatestCommitment().Slot(); slot++ {
+		rootBlocks, err := e.Storage.RootBlocks(slot)
+		if err != nil {
+			return ierrors.Wrapf(err, "failed to get root blocks of slot %d", slot)
+		}
+
+		if err := rootBlocks.Stream(func(blockID iotago.BlockID, commitmentID iotago.CommitmentID) error {
+			info.RootBlocks.Count++
+
+			if info.contents != nil {
+				info.contents.rootBlocks[blockID] = commitmentID
+			}
+
+			return nil
+		}); err != nil {
+			return ierrors.Wrapf(err, "failed to stream root blocks of slot %d", slot)
+		}
+	}
+
+	return nil
+}
+
+func collectSnapshotCommittee(e *engine.Engine, info *snapshotInfo) error {
+	latestCommittedSlot := e.Storage.Settings().LatestCommitment().Slot()
+
+	info.Committee = &snapshotCommitteeInfo{
+		Epoch:   e.APIForSlot(latestCommittedSlot).TimeProvider().EpochFromSlot(latestCommittedSlot),
+		Members: make([]*snapshotCommitteeMember, 0),
+	}
+
+	committee, exists := e.SybilProtection.SeatManager().CommitteeInSlot(latestCommittedSlot)
+	if !exists {
+		return nil
+	}
+
+	committeeAccounts, err := committee.Accounts()
+	if err != nil {
+		return ierrors.Wrap(err, "failed to get committee accounts")
+	}
+
+	info.Committee.Seats = committee.SeatCount()
+	info.Committee.TotalStake = committeeAccounts.TotalStake()
+	info.Committee.TotalValidatorStake = committeeAccounts.TotalValidatorStake()
+
+	committeeAccounts.ForEach(func(accountID iotago.AccountID, pool *account.Pool) bool {
+		seat, _ := committee.GetSeat(accountID)
+
+		info.Committee.Members = append(info.Committee.Members, &snapshotCommitteeMember{
+			AccountID:      accountID.ToHex(),
+			Seat:           seat,
+			PoolStake:      pool.PoolStake,
+			ValidatorStake: pool.ValidatorStake,
+			FixedCost:      pool.FixedCost,
+		})
+
+		if info.contents != nil {
+			info.contents.committee[accountID] = pool
+		}
+
+		return true
+	})
+
+	sort.Slice(info.Committee.Members, func(i int, j int) bool {
+		return info.Committee.Members[i].Seat < info.Committee.Members[j].Seat
+	})
+
+	return nil
+}
+
+func collectSnapshotRoots(e *engine.Engine, info *snapshotInfo) error {
+	latestCommitment := e.Storage.Settings().LatestCommitment()
+
+	// genesis snapshots don't contain any roots.
+	if latestCommitment.Slot() <= e.CommittedAPI().ProtocolParameters().GenesisSlot() {
+		return nil
+	}
+
+	rootsStorage, err := e.Storage.Roots(latestCommitment.Slot())
+	if err != nil {
+		return ierrors.Wrapf(err, "failed to get roots storage for slot %d", latestCommitment.Slot())
+	}
+
+	roots, exists, err := rootsStorage.Load(latestCommitment.ID())
+	if err != nil {
+		return ierrors.Wrapf(err, "failed to load roots for commitment %s", latestCommitment.ID())
+	} else if !exists {
+		return ierrors.Errorf("roots for commitment %s not found", latestCommitment.ID())
+	}
+
+	info.Roots = &snapshotRootsInfo{
+		TangleRoot:             roots.TangleRoot.ToHex(),
+		StateMutationRoot:      roots.StateMutationRoot.ToHex(),
+		StateRoot:              roots.StateRoot.ToHex(),
+		AccountRoot:            roots.AccountRoot.ToHex(),
+		AttestationsRoot:       roots.AttestationsRoot.ToHex(),
+		CommitteeRoot:          roots.CommitteeRoot.ToHex(),
+		RewardsRoot:            roots.RewardsRoot.ToHex(),
+		ProtocolParametersHash: roots.ProtocolParametersHash.ToHex(),
+	}
+
+	return nil
+}
+
+func collectSnapshotUpgradeOrchestrator(e *engine.Engine, info *snapshotInfo) error {
+	info.UpgradeOrchestrator = &snapshotUpgradeOrchestratorInfo{
+		Slot:            e.Storage.Settings().LatestCommitment().Slot(),
+		Signals:         make([]*snapshotUpgradeSignal, 0),
+		DecidedUpgrades: make([]*snapshotDecidedUpgrade, 0),
+	}
+
+	// the upgrade signals are rolled forward, so the snapshot only contains the ones of its slot.
+	upgradeSignals, err := e.Storage.UpgradeSignals(info.UpgradeOrchestrator.Slot)
+	if err != nil {
+		return ierrors.Wrapf(err, "failed to get upgrade signals for slot %d", info.UpgradeOrchestrator.Slot)
+	}
+
+	if err := upgradeSignals.Stream(func(seat account.SeatIndex, signaledBlock *model.SignaledBlock) error {
+		info.UpgradeOrchestrator.Signals = append(info.UpgradeOrchestrator.Signals, &snapshotUpgradeSignal{
+			Seat:                    seat,
+			BlockID:                 signaledBlock.ID.ToHex(),
+			HighestSupportedVersion: signaledBlock.HighestSupportedVersion,
+			ProtocolParametersHash:  signaledBlock.ProtocolParametersHash.ToHex(),
+		})
+
+		if info.contents != nil {
+			info.contents.upgradeSignals[seat] = signaledBlock
+		}
+
+		return nil
+	}); err != nil {
+		return ierrors.Wrapf(err, "failed to stream upgrade signals for slot %d", info.UpgradeOrchestrator.Slot)
+	}
+
+	if err := e.Storage.DecidedUpgradeSignals().Stream(func(epoch iotago.EpochIndex, versionAndHash model.VersionAndHash) error {
+		// epochs without a supermajority for an upgrade are not part of the snapshot.
+		if versionAndHash.Version == 0 {
+			return nil
+		}
+
+		info.UpgradeOrchestrator.DecidedUpgrades = append(info.UpgradeOrchestrator.DecidedUpgrades, &snapshotDecidedUpgrade{
+			Epoch:                  epoch,
+			Version:                versionAndHash.Version,
+			ProtocolParametersHash: versionAndHash.Hash.ToHex(),
+		})
+
+		if info.contents != nil {
+			info.contents.decidedUpgrades[epoch] = versionAndHash
+		}
+
+		return nil
+	}); err != nil {
+		return ierrors.Wrap(err, "failed to stream decided upgrade signals")
+	}
+
+	sort.Slice(info.UpgradeOrchestrator.Signals, func(i int, j int) bool {
+		return info.UpgradeOrchestrator.Signals[i].Seat < info.UpgradeOrchestrator.Signals[j].Seat
+	})
+	sort.Slice(info.UpgradeOrchestrator.DecidedUpgrades, func(i int, j int) bool {
+		return info.UpgradeOrchestrator.DecidedUpgrades[i].Epoch < info.UpgradeOrchestrator.DecidedUpgrades[j].Epoch
+	})
+
+	return nil
+}
+
+// diffSnapshots compares two snapshots section by section.
+func diffSnapshots(infoA *snapshotInfo, infoB *snapshotInfo) *snapshotDiffReport {
+	report := &snapshotDiffReport{
+		SnapshotA: infoA.FilePath,
+		SnapshotB: infoB.FilePath,
+		Equal:     true,
+	}
+
+	for _, diffSection := range []func(*snapshotInfo, *snapshotInfo) *snapshotSectionDiff{
+		diffSnapshotSettings,
+		diffSnapshotCommitments,
+		diffSnapshotLedger,
+		diffSnapshotAccounts,
+		diffSnapshotRootBlocks,
+		diffSnapshotCommittee,
+		diffSnapshotRoots,
+		diffSnapshotUpgradeOrchestrator,
+	} {
+		sectionDiff := diffSection(infoA, infoB)
+		report.Equal = report.Equal && sectionDiff.Equal
+		report.Sections = append(report.Sections, sectionDiff)
+	}
+
+	return report
+}
+
+func diffSnapshotSettings(infoA *snapshotInfo, infoB *snapshotInfo) *snapshotSectionDiff {
+	diff := &snapshotSectionDiff{Section: snapshotSectionSettings, Equal: true}
+	a, b := infoA.Settings, infoB.Settings
+
+	diff.compare("slot", a.Slot, b.Slot)
+	diff.compare("commitment ID", a.CommitmentID, b.CommitmentID)
+	diff.compare("protocol version", a.ProtocolVersion, b.ProtocolVersion)
+	diff.compare("latest finalized slot", a.LatestFinalizedSlot, b.LatestFinalizedSlot)
+	diff.compare("latest non-empty slot", a.LatestNonEmptySlot, b.LatestNonEmptySlot)
+	diff.compare("network name", a.NetworkName, b.NetworkName)
+	diff.compare("token supply", a.TokenSupply, b.TokenSupply)
+	diff.compare("protocol versions count", len(a.ProtocolVersions), len(b.ProtocolVersions))
+
+	for i := 0; i < len(a.ProtocolVersions) && i < len(b.ProtocolVersions); i++ {
+		diff.compare(fmt.Sprintf("protocol versions[%d] version", i), a.ProtocolVersions[i].Version, b.ProtocolVersions[i].Version)
+		diff.compare(fmt.Sprintf("protocol versions[%d] start epoch", i), a.ProtocolVersions[i].StartEpoch, b.ProtocolVersions[i].StartEpoch)
+		diff.compare(fmt.Sprintf("protocol versions[%d] parameters hash", i), a.ProtocolVersions[i].ProtocolParametersHash, b.ProtocolVersions[i].ProtocolParametersHash)
+	}
+
+	return diff
+}
+
+func diffSnapshotCommitments(infoA *snapshotInfo, infoB *snapshotInfo) *snapshotSectionDiff {
+	diff := &snapshotSectionDiff{Section: snapshotSectionCommitments, Equal: true}
+	a, b := infoA.Commitments, infoB.Commitments
+
+	diff.compare("first slot", a.FirstSlot, b.FirstSlot)
+	diff.compare("last slot", a.LastSlot, b.LastSlot)
+
+	// commitments of later slots build on the earlier ones, so we only report the first fork.
+	for slot := max(a.FirstSlot, b.FirstSlot); slot <= min(a.LastSlot, b.LastSlot); slot++ {
+		if commitmentIDA, commitmentIDB := infoA.contents.commitmentIDs[slot], infoB.contents.commitmentIDs[slot]; commitmentIDA != commitmentIDB {
+			diff.addDifference("commitments fork at slot %d: %s != %s", slot, commitmentIDA, commitmentIDB)
+
+			break
+		}
+	}
+
+	return diff
+}
+
+func diffSnapshotLedger(infoA *snapshotInfo, infoB *snapshotInfo) *snapshotSectionDiff {
+	diff := &snapshotSectionDiff{Section: snapshotSectionLedger, Equal: true}
+	a, b := infoA.Ledger, infoB.Ledger
+
+	diff.compare("unspent outputs", a.UnspentOutputs, b.UnspentOutputs)
+	diff.compare("total amount", a.TotalAmount, b.TotalAmount)
+	diff.compare("state tree root", a.StateTreeRoot, b.StateTreeRoot)
+	diff.compare("state consistent", a.StateConsistent, b.StateConsistent)
+
+	diffSnapshotEntries(diff, "output", infoA.contents.outputs, infoB.contents.outputs, iotago.OutputID.ToHex, func(hashA iotago.Identifier, hashB iotago.Identifier) bool {
+		return hashA == hashB
+	})
+
+	return diff
+}
+
+func diffSnapshotAccounts(infoA *snapshotInfo, infoB *snapshotInfo) *snapshotSectionDiff {
+	diff := &snapshotSectionDiff{Section: snapshotSectionAccounts, Equal: true}
+	a, b := infoA.Accounts, infoB.Accounts
+
+	diff.compare("accounts", a.Count, b.Count)
+	diff.compare("validators", a.Validators, b.Validators)
+	diff.compare("total credits", a.TotalCredits, b.TotalCredits)
+	diff.compare("accounts tree root", a.AccountsTreeRoot, b.AccountsTreeRoot)
+
+	diffSnapshotEntries(diff, "account", infoA.contents.accounts, infoB.contents.accounts, iotago.AccountID.ToHex, func(accountDataA *accounts.AccountData, accountDataB *accounts.AccountData) bool {
+		bytesA, errA := accountDataA.Bytes()
+		bytesB, errB := accountDataB.Bytes()
+
+		return errA == nil && errB == nil && string(bytesA) == string(bytesB)
+	})
+
+	return diff
+}
+
+func diffSnapshotRootBlocks(infoA *snapshotInfo, infoB *snapshotInfo) *snapshotSectionDiff {
+	diff := &snapshotSectionDiff{Section: snapshotSectionRootBlocks, Equal: true}
+	a, b := infoA.RootBlocks, infoB.RootBlocks
+
+	diff.compare("root blocks", a.Count, b.Count)
+	diff.compare("root commitment ID", a.RootCommitmentID, b.RootCommitmentID)
+
+	diffSnapshotEntries(diff, "root block", infoA.contents.rootBlocks, infoB.contents.rootBlocks, iotago.BlockID.ToHex, func(commitmentIDA iotago.CommitmentID, commitmentIDB iotago.CommitmentID) bool {
+		return commitmentIDA == commitmentIDB
+	})
+
+	return diff
+}
+
+func diffSnapshotCommittee(infoA *snapshotInfo, infoB *snapshotInfo) *snapshotSectionDiff {
+	diff := &snapshotSectionDiff{Section: snapshotSectionCommittee, Equal: true}
+	a, b := infoA.Committee, infoB.Committee
+
+	diff.compare("epoch", a.Epoch, b.Epoch)
+	diff.compare("seats", a.Seats, b.Seats)
+	diff.compare("total stake", a.TotalStake, b.TotalStake)
+	diff.compare("total validator stake", a.TotalValidatorStake, b.TotalValidatorStake)
+
+	diffSnapshotEntries(diff, "committee member", infoA.contents.committee, infoB.contents.committee, iotago.AccountID.ToHex, func(poolA *account.Pool, poolB *account.Pool) bool {
+		return *poolA == *poolB
+	})
+
+	return diff
+}
+
+func diffSnapshotRoots(infoA *snapshotInfo, infoB *snapshotInfo) *snapshotSectionDiff {
+	diff := &snapshotSectionDiff{Section: snapshotSectionRoots, Equal: true}
+	a, b := infoA.Roots, infoB.Roots
+
+	if a == nil || b == nil {
+		if a != b {
+			diff.addDifference("roots only exist in snapshot %s", lo.Cond(a != nil, "A", "B"))
+		}
+
+		return diff
+	}
+
+	diff.compare("tangle root", a.TangleRoot, b.TangleRoot)
+	diff.compare("state mutation root", a.StateMutationRoot, b.StateMutationRoot)
+	diff.compare("state root", a.StateRoot, b.StateRoot)
+	diff.compare("account root", a.AccountRoot, b.AccountRoot)
+	diff.compare("attestations root", a.AttestationsRoot, b.AttestationsRoot)
+	diff.compare("committee root", a.CommitteeRoot, b.CommitteeRoot)
+	diff.compare("rewards root", a.RewardsRoot, b.RewardsRoot)
+	diff.compare("protocol parameters hash", a.ProtocolParametersHash, b.ProtocolParametersHash)
+
+	return diff
+}
+
+func diffSnapshotUpgradeOrchestrator(infoA *snapshotInfo, infoB *snapshotInfo) *snapshotSectionDiff {
+	diff := &snapshotSectionDiff{Section: snapshotSectionUpgradeOrchestrator, Equal: true}
+	a, b := infoA.UpgradeOrchestrator, infoB.UpgradeOrchestrator
+
+	diff.compare("slot", a.Slot, b.Slot)
+	diff.compare("upgrade signals", len(a.Signals), len(b.Signals))
+	diff.compare("decided upgrades", len(a.DecidedUpgrades), len(b.DecidedUpgrades))
+
+	diffSnapshotEntries(diff, "upgrade signal of seat", infoA.contents.upgradeSignals, infoB.contents.upgradeSignals, func(seat account.SeatIndex) string {
+		return fmt.Sprintf("%d", seat)
+	}, func(signaledBlockA *model.SignaledBlock, signaledBlockB *model.SignaledBlock) bool {
+		return signaledBlockA.ID == signaledBlockB.ID &&
+			signaledBlockA.IssuingTime.Equal(signaledBlockB.IssuingTime) &&
+			signaledBlockA.HighestSupportedVersion == signaledBlockB.HighestSupportedVersion &&
+			signaledBlockA.ProtocolParametersHash == signaledBlockB.ProtocolParametersHash
+	})
+
+	diffSnapshotEntries(diff, "decided upgrade of epoch", infoA.contents.decidedUpgrades, infoB.contents.decidedUpgrades, func(epoch iotago.EpochIndex) string {
+		return fmt.Sprintf("%d", epoch)
+	}, func(versionAndHashA model.VersionAndHash, versionAndHashB model.VersionAndHash) bool {
+		return versionAndHashA == versionAndHashB
+	})
+
+	return diff
+}
+
+// diffSnapshotEntries adds the entries that only exist in one of the snapshots or that differ between them to the diff.
+func diffSnapshotEntries[K comparable, V any](diff *snapshotSectionDiff, entryName string, entriesA map[K]V, entriesB map[K]V, keyString func(K) string, equal func(V, V) bool) {
+	keys := make([]string, 0)
+	differences := make(map[string]string)
+
+	for key, valueA := range entriesA {
+		if valueB, exists := entriesB[key]; !exists {
+			differences[keyString(key)] = fmt.Sprintf("%s %s only exists in snapshot A", entryName, keyString(key))
+		} else if !equal(valueA, valueB) {
+			differences[keyString(key)] = fmt.Sprintf("%s %s differs", entryName, keyString(key))
+		}
+	}
+
+	for key := range entriesB {
+		if _, exists := entriesA[key]; !exists {
+			differences[keyString(key)] = fmt.Sprintf("%s %s only exists in snapshot B", entryName, keyString(key))
+		}
+	}
+
+	for key := range differences {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		diff.addDifference("%s", differences[key])
+	}
+}
+
+func printSnapshotInfo(info *snapshotInfo) {
+	fmt.Printf(`> Snapshot
+	- File path: %s
+	- File size: %d bytes
+
+> Settings
+	- Slot: %d
+	- Commitment ID: %s
+	- Protocol version: %d
+	- Latest finalized slot: %d
+	- Latest non-empty slot: %d
+	- Network name: %s
+	- Token supply: %d
+`,
+		info.FilePath,
+		info.FileSize,
+		info.Settings.Slot,
+		info.Settings.CommitmentID,
+		info.Settings.ProtocolVersion,
+		info.Settings.LatestFinalizedSlot,
+		info.Settings.LatestNonEmptySlot,
+		info.Settings.NetworkName,
+		info.Settings.TokenSupply,
+	)
+
+	for _, protocolVersion := range info.Settings.ProtocolVersions {
+		fmt.Printf("\t- Protocol version %d: start epoch %d, parameters hash %s\n", protocolVersion.Version, protocolVersion.StartEpoch, protocolVersion.ProtocolParametersHash)
+	}
+
+	fmt.Printf(`
+> Commitments
+	- Slots: %d - %d
+	- Count: %d
+
+> Ledger
+	- Unspent outputs: %d
+	- Total amount: %d
+	- State tree root: %s
+	- State consistent: %s
+`,
+		info.Commitments.FirstSlot,
+		info.Commitments.LastSlot,
+		info.Commitments.Count,
+		info.Ledger.UnspentOutputs,
+		info.Ledger.TotalAmount,
+		info.Ledger.StateTreeRoot,
+		yesOrNo(info.Ledger.StateConsistent),
+	)
+
+	if info.Ledger.StateError != "" {
+		fmt.Printf("\t- State error: %s\n", info.Ledger.StateError)
+	}
+
+	outputTypes := make([]string, 0, len(info.Ledger.OutputsByType))
+	for outputType := range info.Ledger.OutputsByType {
+		outputTypes = append(outputTypes, outputType)
+	}
+	sort.Strings(outputTypes)
+
+	for _, outputType := range outputTypes {
+		fmt.Printf("\t- %s: %d\n", outputType, info.Ledger.OutputsByType[outputType])
+	}
+
+	fmt.Printf(`
+> Accounts
+	- Count: %d
+	- Validators: %d
+	- Total credits: %d
+	- Accounts tree root: %s
+
+> Root blocks
+	- Count: %d
+	- Root commitment ID: %s
+
+> Committee
+	- Epoch: %d
+	- Seats: %d
+	- Total stake: %d
+	- Total validator stake: %d
+`,
+		info.Accounts.Count,
+		info.Accounts.Validators,
+		info.Accounts.TotalCredits,
+		info.Accounts.AccountsTreeRoot,
+		info.RootBlocks.Count,
+		info.RootBlocks.RootCommitmentID,
+		info.Committee.Epoch,
+		info.Committee.Seats,
+		info.Committee.TotalStake,
+		info.Committee.TotalValidatorStake,
+	)
+
+	for _, member := range info.Committee.Members {
+		fmt.Printf("\t- Seat %d: %s (pool stake: %d, validator stake: %d, fixed cost: %d)\n", member.Seat, member.AccountID, member.PoolStake, member.ValidatorStake, member.FixedCost)
+	}
+
+	fmt.Printf(`
+> Upgrade orchestrator
+	- Slot: %d
+	- Upgrade signals: %d
+	- Decided upgrades: %d
+`,
+		info.UpgradeOrchestrator.Slot,
+		len(info.UpgradeOrchestrator.Signals),
+		len(info.UpgradeOrchestrator.DecidedUpgrades),
+	)
+
+	for _, signal := range info.UpgradeOrchestrator.Signals {
+		fmt.Printf("\t- Seat %d: version %d, parameters hash %s (block %s)\n", signal.Seat, signal.HighestSupportedVersion, signal.ProtocolParametersHash, signal.BlockID)
+	}
+
+	for _, decidedUpgrade := range info.UpgradeOrchestrator.DecidedUpgrades {
+		fmt.Printf("\t- Epoch %d: version %d, parameters hash %s\n", decidedUpgrade.Epoch, decidedUpgrade.Version, decidedUpgrade.ProtocolParametersHash)
+	}
+
+	if info.Roots == nil {
+		return
+	}
+
+	fmt.Printf(`
+> Roots
+	- Tangle root: %s
+	- State mutation root: %s
+	- State root: %s
+	- Account root: %s
+	- Attestations root: %s
+	- Committee root: %s
+	- Rewards root: %s
+	- Protocol parameters hash: %s
+`,
+		info.Roots.TangleRoot,
+		info.Roots.StateMutationRoot,
+		info.Roots.StateRoot,
+		info.Roots.AccountRoot,
+		info.Roots.AttestationsRoot,
+		info.Roots.CommitteeRoot,
+		info.Roots.RewardsRoot,
+		info.Roots.ProtocolParametersHash,
+	)
+}
+
+func printSnapshotDiffReport(report *snapshotDiffReport) {
+	fmt.Printf("> Snapshot A: %s\n", report.SnapshotA)
+	fmt.Printf("> Snapshot B: %s\n\n", report.SnapshotB)
+
+	for _, section := range report.Sections {
+		if section.Equal {
+			fmt.Printf("%-20s EQUAL\n", section.Section)
+
+			continue
+		}
+
+		fmt.Printf("%-20s DIFFERENT\n", section.Section)
+		for _, difference := range section.Differences {
+			fmt.Printf("\t- %s\n", difference)
+		}
+
+		if section.Omitted > 0 {
+			fmt.Printf("\t- ... and %d more\n", section.Omitted)
+		}
+	}
+}
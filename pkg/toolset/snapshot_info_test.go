@@ -0,0 +1,127 @@
+package toolset
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	hivedb "github.com/iotaledger/hive.go/db"
+	"github.com/iotaledger/iota-core/pkg/core/account"
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota.go/v4/tpkg"
+)
+
+// sectionDiff returns the diff of the section with the given name.
+func sectionDiff(t *testing.T, report *snapshotDiffReport, section string) *snapshotSectionDiff {
+	for _, sectionDiff := range report.Sections {
+		if sectionDiff.Section == section {
+			return sectionDiff
+		}
+	}
+
+	require.FailNow(t, "section not found", section)
+
+	return nil
+}
+
+func TestSnapshotInfo(t *testing.T) {
+	databasePath, genesisSnapshotPath := createTestDatabase(t)
+
+	snapshotPath := filepath.Join(t.TempDir(), "snapshot.bin")
+	require.NoError(t, snapshotExport([]string{
+		"--" + FlagToolDatabasePath, databasePath,
+		"--" + FlagToolOutputPath, snapshotPath,
+	}))
+
+	genesisInfo, err := readSnapshotInfo(genesisSnapshotPath, hivedb.EngineMapDB, true)
+	require.NoError(t, err)
+
+	info, err := readSnapshotInfo(snapshotPath, hivedb.EngineMapDB, true)
+	require.NoError(t, err)
+
+	require.Greater(t, info.Settings.Slot, genesisInfo.Settings.Slot)
+	require.Equal(t, genesisInfo.Settings.TokenSupply, info.Settings.TokenSupply)
+	require.Equal(t, info.Settings.Slot, info.Commitments.LastSlot)
+	require.Equal(t, int(info.Commitments.LastSlot-info.Commitments.FirstSlot+1), info.Commitments.Count)
+	require.True(t, info.Ledger.StateConsistent, info.Ledger.StateError)
+	require.Equal(t, info.Settings.TokenSupply, info.Ledger.TotalAmount)
+	require.Positive(t, info.Accounts.Count)
+	require.Equal(t, 1, info.Committee.Seats)
+	require.NotNil(t, info.Roots)
+
+	require.Equal(t, info.Settings.Slot, info.UpgradeOrchestrator.Slot)
+	// the validator only signals the current protocol version, which is never tracked.
+	require.Empty(t, info.UpgradeOrchestrator.Signals)
+	require.Empty(t, info.UpgradeOrchestrator.DecidedUpgrades)
+
+	require.NoError(t, snapshotInfoTool([]string{"--" + FlagToolSnapshotPath, snapshotPath}))
+	require.NoError(t, snapshotInfoTool([]string{"--" + FlagToolSnapshotPath, snapshotPath, "--" + FlagToolOutputJSON}))
+
+	t.Run("equal snapshots", func(t *testing.T) {
+		report := diffSnapshots(info, info)
+		require.True(t, report.Equal)
+
+		for _, section := range report.Sections {
+			require.True(t, section.Equal, section.Section)
+		}
+
+		require.NoError(t, snapshotDiffTool([]string{
+			"--" + FlagToolSnapshotPathA, snapshotPath,
+			"--" + FlagToolSnapshotPathB, snapshotPath,
+		}))
+	})
+
+	t.Run("different snapshots", func(t *testing.T) {
+		report := diffSnapshots(genesisInfo, info)
+		require.False(t, report.Equal)
+
+		require.False(t, sectionDiff(t, report, snapshotSectionSettings).Equal)
+		require.False(t, sectionDiff(t, report, snapshotSectionCommitments).Equal)
+		require.Contains(t, sectionDiff(t, report, snapshotSectionRoots).Differences, "roots only exist in snapshot B")
+
+		require.False(t, sectionDiff(t, report, snapshotSectionUpgradeOrchestrator).Equal)
+
+		require.EqualError(t, snapshotDiffTool([]string{
+			"--" + FlagToolSnapshotPathA, genesisSnapshotPath,
+			"--" + FlagToolSnapshotPathB, snapshotPath,
+		}), "snapshots differ")
+	})
+
+	t.Run("different upgrade signals", func(t *testing.T) {
+		signaledBlock := &model.SignaledBlock{
+			ID:                      tpkg.RandBlockID(),
+			IssuingTime:             time.Unix(1700000000, 0),
+			HighestSupportedVersion: info.Settings.ProtocolVersion + 1,
+			ProtocolParametersHash:  tpkg.Rand32ByteArray(),
+		}
+
+		signaledInfo := *info
+		signaledInfo.UpgradeOrchestrator = &snapshotUpgradeOrchestratorInfo{
+			Slot: info.UpgradeOrchestrator.Slot,
+			Signals: []*snapshotUpgradeSignal{{
+				Seat:                    0,
+				BlockID:                 signaledBlock.ID.ToHex(),
+				HighestSupportedVersion: signaledBlock.HighestSupportedVersion,
+				ProtocolParametersHash:  signaledBlock.ProtocolParametersHash.ToHex(),
+			}},
+		}
+
+		signaledContents := *info.contents
+		signaledContents.upgradeSignals = map[account.SeatIndex]*model.SignaledBlock{0: signaledBlock}
+		signaledInfo.contents = &signaledContents
+
+		report := diffSnapshots(info, &signaledInfo)
+		require.False(t, report.Equal)
+
+		for _, section := range report.Sections {
+			require.Equal(t, section.Section != snapshotSectionUpgradeOrchestrator, section.Equal, section.Section)
+		}
+
+		require.Equal(t, []string{
+			"upgrade signals: 0 != 1",
+			"upgrade signal of seat 0 only exists in snapshot B",
+		}, sectionDiff(t, report, snapshotSectionUpgradeOrchestrator).Differences)
+	})
+}
@@ -25,9 +25,27 @@ const (
 	FlagToolMnemonic  = "mnemonic"
 	FlagToolPassword  = "password"
 	FlagToolSalt      = "salt"
+	FlagToolScopes    = "scopes"
+	FlagToolExpiry    = "expiry"
 
 	FlagToolNodeURL = "nodeURL"
 
+	FlagToolDatabasePath         = "databasePath"
+	FlagToolDatabaseEngine       = "databaseEngine"
+	FlagToolDatabasePathSource   = "sourceDatabasePath"
+	FlagToolDatabasePathTarget   = "targetDatabasePath"
+	FlagToolDatabaseEngineTarget = "targetDatabaseEngine"
+	FlagToolArchivePathSource    = "sourceArchivePath"
+	FlagToolArchivePathTarget    = "targetArchivePath"
+
+	FlagToolSnapshotPath       = "snapshotPath"
+	FlagToolSnapshotPathA      = "snapshotPathA"
+	FlagToolSnapshotPathB      = "snapshotPathB"
+	FlagToolSnapshotTargetSlot = "targetSlot"
+	FlagToolSnapshotFormat     = "format"
+	FlagToolCompression        = "compression"
+	FlagToolOverwrite          = "overwrite"
+
 	FlagToolOutputJSON            = "json"
 	FlagToolDescriptionOutputJSON = "format output as JSON"
 
@@ -47,11 +65,26 @@ const (
 	ToolBenchmarkIO        = "bench-io"
 	ToolBenchmarkCPU       = "bench-cpu"
 	ToolNodeInfo           = "node-info"
+	ToolDatabaseMigration  = "db-migration"
+	ToolDatabaseCheck      = "db-check"
+	ToolSnapshotExport     = "snapshot-export"
+	ToolSnapshotInfo       = "snapshot-info"
+	ToolSnapshotDiff       = "snapshot-diff"
 )
 
 const (
 	DefaultValueAPIJWTTokenSalt            = "IOTA"
 	DefaultValueIdentityPrivateKeyFilePath = "testnet/p2p/identity.key"
+	DefaultValueDatabasePath               = "testnet/database"
+	DefaultValueSnapshotExportPath         = "snapshot_export.bin"
+	DefaultValueSnapshotPath               = "testnet/snapshot.bin"
+)
+
+const (
+	// SnapshotFormatLegacy is the uncompressed snapshot format that requires a seekable file.
+	SnapshotFormatLegacy = "legacy"
+	// SnapshotFormatStream is the streaming snapshot container with compression and section checksums.
+	SnapshotFormatStream = "stream"
 )
 
 const (
@@ -93,6 +126,11 @@ func HandleTools() {
 		ToolBenchmarkIO:        benchmarkIO,
 		ToolBenchmarkCPU:       benchmarkCPU,
 		ToolNodeInfo:           nodeInfo,
+		ToolDatabaseMigration:  databaseMigration,
+		ToolDatabaseCheck:      databaseCheck,
+		ToolSnapshotExport:     snapshotExport,
+		ToolSnapshotInfo:       snapshotInfoTool,
+		ToolSnapshotDiff:       snapshotDiffTool,
 	}
 
 	tool, exists := tools[strings.ToLower(args[1])]
@@ -125,6 +163,11 @@ func listTools() {
 	fmt.Printf("%-20s benchmarks the IO throughput\n", fmt.Sprintf("%s:", ToolBenchmarkIO))
 	fmt.Printf("%-20s benchmarks the CPU performance\n", fmt.Sprintf("%s:", ToolBenchmarkCPU))
 	fmt.Printf("%-20s queries the info endpoint of a node\n", fmt.Sprintf("%s:", ToolNodeInfo))
+	fmt.Printf("%-20s migrates the database to another engine\n", fmt.Sprintf("%s:", ToolDatabaseMigration))
+	fmt.Printf("%-20s checks the integrity of the database of a stopped node\n", fmt.Sprintf("%s:", ToolDatabaseCheck))
+	fmt.Printf("%-20s creates a snapshot from the database of a stopped node\n", fmt.Sprintf("%s:", ToolSnapshotExport))
+	fmt.Printf("%-20s prints a summary of the sections of a snapshot file\n", fmt.Sprintf("%s:", ToolSnapshotInfo))
+	fmt.Printf("%-20s compares two snapshot files section by section\n", fmt.Sprintf("%s:", ToolSnapshotDiff))
 }
 
 func yesOrNo(value bool) string {
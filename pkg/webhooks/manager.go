@@ -0,0 +1,563 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/log"
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/hive.go/runtime/syncutils"
+)
+
+const (
+	storePrefixWebhooks byte = iota
+	storePrefixDeliveries
+)
+
+var (
+	// ErrWebhookNotFound is returned if a webhook does not exist.
+	ErrWebhookNotFound = ierrors.New("webhook not found")
+	// ErrTooManyWebhooks is returned if the maximum number of webhooks is reached.
+	ErrTooManyWebhooks = ierrors.New("too many webhooks")
+	// ErrTooManyPendingDeliveries is returned if the maximum number of pending deliveries is reached.
+	ErrTooManyPendingDeliveries = ierrors.New("too many pending deliveries")
+)
+
+// Manager keeps the registered webhooks and delivers the transaction events they are interested in.
+//
+// The webhooks and the pending deliveries are persisted in a store, so that no delivery gets lost if the node is
+// restarted. Failed deliveries are retried with an exponential backoff until they succeed or the maximum number of
+// attempts is reached.
+type Manager struct {
+	logger log.Logger
+	store  kvstore.KVStore
+	client *http.Client
+
+	webhooks   map[string]*Webhook
+	deliveries map[string]*Delivery
+	inFlight   map[string]struct{}
+	mutex      syncutils.RWMutex
+
+	// wakeUp signals the dispatcher that new deliveries were added.
+	wakeUp chan struct{}
+
+	optsMaxWebhooks           int
+	optsMaxPendingDeliveries  int
+	optsMaxAttempts           int
+	optsRetryInterval         time.Duration
+	optsMaxRetryInterval      time.Duration
+	optsRequestTimeout        time.Duration
+	optsWorkerCount           int
+	optsDispatchCheckInterval time.Duration
+}
+
+// NewManager creates a new Manager and loads the webhooks and pending deliveries from the given store.
+func NewManager(logger log.Logger, store kvstore.KVStore, opts ...options.Option[Manager]) (*Manager, error) {
+	m := options.Apply(&Manager{
+		logger:                    logger,
+		store:                     store,
+		webhooks:                  make(map[string]*Webhook),
+		deliveries:                make(map[string]*Delivery),
+		inFlight:                  make(map[string]struct{}),
+		wakeUp:                    make(chan struct{}, 1),
+		optsMaxWebhooks:           1000,
+		optsMaxPendingDeliveries:  10000,
+		optsMaxAttempts:           10,
+		optsRetryInterval:         5 * time.Second,
+		optsMaxRetryInterval:      10 * time.Minute,
+		optsRequestTimeout:        10 * time.Second,
+		optsWorkerCount:           4,
+		optsDispatchCheckInterval: time.Second,
+	}, opts)
+
+	// redirects are not followed, because the signature only proves the origin of the payload to the registered URL.
+	m.client = &http.Client{
+		Timeout: m.optsRequestTimeout,
+		CheckRedirect: func(_ *http.Request, _ []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	if err := loadEntries(store, storePrefixWebhooks, m.webhooks, func(webhook *Webhook) string { return webhook.ID }); err != nil {
+		return nil, ierrors.Wrap(err, "failed to load webhooks")
+	}
+
+	if err := loadEntries(store, storePrefixDeliveries, m.deliveries, func(delivery *Delivery) string { return delivery.ID }); err != nil {
+		return nil, ierrors.Wrap(err, "failed to load pending deliveries")
+	}
+
+	return m, nil
+}
+
+// AddWebhook validates and adds the given webhook. The ID and the creation time are assigned by the manager, and a
+// secret is generated if none was given.
+func (m *Manager) AddWebhook(webhook *Webhook) (*Webhook, error) {
+	if err := webhook.validate(); err != nil {
+		return nil, err
+	}
+
+	if webhook.Secret == "" {
+		secret := make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			return nil, ierrors.Wrap(err, "failed to generate webhook secret")
+		}
+		webhook.Secret = hex.EncodeToString(secret)
+	}
+
+	webhook.ID = uuid.NewString()
+	webhook.CreatedAt = time.Now()
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if m.optsMaxWebhooks > 0 && len(m.webhooks) >= m.optsMaxWebhooks {
+		return nil, ierrors.WithMessagef(ErrTooManyWebhooks, "the limit of %d webhooks is reached", m.optsMaxWebhooks)
+	}
+
+	if err := storeEntry(m.store, storePrefixWebhooks, webhook.ID, webhook); err != nil {
+		return nil, ierrors.Wrapf(err, "failed to store webhook %s", webhook.ID)
+	}
+	m.webhooks[webhook.ID] = webhook
+
+	return webhook, nil
+}
+
+// RemoveWebhook removes the webhook with the given ID and drops its pending deliveries.
+func (m *Manager) RemoveWebhook(webhookID string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if _, exists := m.webhooks[webhookID]; !exists {
+		return ierrors.WithMessagef(ErrWebhookNotFound, "webhook %s does not exist", webhookID)
+	}
+
+	if err := deleteEntry(m.store, storePrefixWebhooks, webhookID); err != nil {
+		return ierrors.Wrapf(err, "failed to delete webhook %s", webhookID)
+	}
+	delete(m.webhooks, webhookID)
+
+	for deliveryID, delivery := range m.deliveries {
+		if delivery.WebhookID != webhookID {
+			continue
+		}
+
+		if err := m.deleteDelivery(deliveryID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Webhook returns the webhook with the given ID.
+func (m *Manager) Webhook(webhookID string) (*Webhook, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	webhook, exists := m.webhooks[webhookID]
+	if !exists {
+		return nil, ierrors.WithMessagef(ErrWebhookNotFound, "webhook %s does not exist", webhookID)
+	}
+
+	return webhook, nil
+}
+
+// Webhooks returns all webhooks, sorted by the time they were added.
+func (m *Manager) Webhooks() []*Webhook {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	webhooks := make([]*Webhook, 0, len(m.webhooks))
+	for _, webhook := range m.webhooks {
+		webhooks = append(webhooks, webhook)
+	}
+
+	sort.Slice(webhooks, func(i, j int) bool {
+		if webhooks[i].CreatedAt.Equal(webhooks[j].CreatedAt) {
+			return webhooks[i].ID < webhooks[j].ID
+		}
+
+		return webhooks[i].CreatedAt.Before(webhooks[j].CreatedAt)
+	})
+
+	return webhooks
+}
+
+// PendingDeliveries returns the pending deliveries of the webhook with the given ID, sorted by the time they were created.
+func (m *Manager) PendingDeliveries(webhookID string) ([]*Delivery, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	if _, exists := m.webhooks[webhookID]; !exists {
+		return nil, ierrors.WithMessagef(ErrWebhookNotFound, "webhook %s does not exist", webhookID)
+	}
+
+	deliveries := make([]*Delivery, 0)
+	for _, delivery := range m.deliveries {
+		if delivery.WebhookID == webhookID {
+			deliveryCopy := *delivery
+			deliveries = append(deliveries, &deliveryCopy)
+		}
+	}
+
+	sort.Slice(deliveries, func(i, j int) bool {
+		if deliveries[i].CreatedAt.Equal(deliveries[j].CreatedAt) {
+			return deliveries[i].ID < deliveries[j].ID
+		}
+
+		return deliveries[i].CreatedAt.Before(deliveries[j].CreatedAt)
+	})
+
+	return deliveries, nil
+}
+
+// HasWebhooks returns true if at least one webhook is registered.
+func (m *Manager) HasWebhooks() bool {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return len(m.webhooks) > 0
+}
+
+// Notify creates a delivery of the event for every webhook that is interested in it.
+func (m *Manager) Notify(event *Event) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	now := time.Now()
+	deliveriesAdded := false
+
+	for _, webhook := range m.webhooks {
+		if !webhook.Matches(event) {
+			continue
+		}
+
+		if m.optsMaxPendingDeliveries > 0 && len(m.deliveries) >= m.optsMaxPendingDeliveries {
+			return ierrors.WithMessagef(ErrTooManyPendingDeliveries, "dropped %s event of transaction %s for webhook %s", event.State, event.TransactionID, webhook.ID)
+		}
+
+		delivery := &Delivery{
+			ID:            uuid.NewString(),
+			WebhookID:     webhook.ID,
+			NextAttemptAt: now,
+			CreatedAt:     now,
+		}
+
+		payload, err := json.Marshal(&DeliveryPayload{
+			WebhookID:     webhook.ID,
+			DeliveryID:    delivery.ID,
+			TransactionID: event.TransactionID,
+			State:         event.State,
+			Metadata:      event.Metadata,
+			CreatedAt:     now,
+		})
+		if err != nil {
+			return ierrors.Wrap(err, "failed to encode delivery payload")
+		}
+		delivery.Payload = payload
+
+		if err := storeEntry(m.store, storePrefixDeliveries, delivery.ID, delivery); err != nil {
+			return ierrors.Wrapf(err, "failed to store delivery %s", delivery.ID)
+		}
+		m.deliveries[delivery.ID] = delivery
+		deliveriesAdded = true
+	}
+
+	if deliveriesAdded {
+		select {
+		case m.wakeUp <- struct{}{}:
+		default:
+		}
+	}
+
+	return nil
+}
+
+// Run delivers the pending deliveries until the given context is done.
+func (m *Manager) Run(ctx context.Context) {
+	dueDeliveries := make(chan *Delivery)
+
+	var workers sync.WaitGroup
+	for range m.optsWorkerCount {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+
+			for delivery := range dueDeliveries {
+				m.deliver(ctx, delivery)
+			}
+		}()
+	}
+
+	defer func() {
+		close(dueDeliveries)
+		workers.Wait()
+	}()
+
+	// the check interval makes sure that retries are attempted without a new delivery waking up the dispatcher.
+	ticker := time.NewTicker(m.optsDispatchCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		for _, delivery := range m.takeDueDeliveries(time.Now()) {
+			select {
+			case dueDeliveries <- delivery:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-m.wakeUp:
+		}
+	}
+}
+
+// takeDueDeliveries returns the deliveries that are due and marks them as in flight.
+func (m *Manager) takeDueDeliveries(now time.Time) []*Delivery {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	var dueDeliveries []*Delivery
+	for deliveryID, delivery := range m.deliveries {
+		if _, inFlight := m.inFlight[deliveryID]; inFlight || delivery.NextAttemptAt.After(now) {
+			continue
+		}
+
+		m.inFlight[deliveryID] = struct{}{}
+		deliveryCopy := *delivery
+		dueDeliveries = append(dueDeliveries, &deliveryCopy)
+	}
+
+	// the oldest deliveries are attempted first.
+	sort.Slice(dueDeliveries, func(i, j int) bool {
+		return dueDeliveries[i].CreatedAt.Before(dueDeliveries[j].CreatedAt)
+	})
+
+	return dueDeliveries
+}
+
+// deliver attempts a delivery and either removes it or schedules the next attempt.
+func (m *Manager) deliver(ctx context.Context, delivery *Delivery) {
+	webhook, err := m.Webhook(delivery.WebhookID)
+	if err != nil {
+		// the webhook was removed in the meantime, so were its deliveries.
+		m.mutex.Lock()
+		delete(m.inFlight, delivery.ID)
+		m.mutex.Unlock()
+
+		return
+	}
+
+	deliveryErr := m.post(ctx, webhook, delivery)
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	delete(m.inFlight, delivery.ID)
+
+	// the delivery might have been dropped together with its webhook while it was in flight.
+	if _, exists := m.deliveries[delivery.ID]; !exists {
+		return
+	}
+
+	if deliveryErr == nil {
+		if err := m.deleteDelivery(delivery.ID); err != nil {
+			m.logger.LogErrorf("failed to delete delivery %s: %s", delivery.ID, err.Error())
+		}
+
+		return
+	}
+
+	// the attempt was aborted by the shutdown of the node, it is retried after a restart.
+	if ctx.Err() != nil {
+		return
+	}
+
+	delivery.Attempts++
+	delivery.LastError = deliveryErr.Error()
+
+	if m.optsMaxAttempts > 0 && delivery.Attempts >= m.optsMaxAttempts {
+		m.logger.LogWarnf("dropped delivery %s to webhook %s after %d failed attempts, last error: %s", delivery.ID, webhook.ID, delivery.Attempts, delivery.LastError)
+
+		if err := m.deleteDelivery(delivery.ID); err != nil {
+			m.logger.LogErrorf("failed to delete delivery %s: %s", delivery.ID, err.Error())
+		}
+
+		return
+	}
+
+	delivery.NextAttemptAt = time.Now().Add(m.retryInterval(delivery.Attempts))
+
+	if err := storeEntry(m.store, storePrefixDeliveries, delivery.ID, delivery); err != nil {
+		m.logger.LogErrorf("failed to store delivery %s: %s", delivery.ID, err.Error())
+	}
+	m.deliveries[delivery.ID] = delivery
+}
+
+// post sends the payload of the delivery to the URL of the webhook.
+func (m *Manager) post(ctx context.Context, webhook *Webhook, delivery *Delivery) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return ierrors.Wrap(err, "failed to create request")
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(HeaderWebhookID, webhook.ID)
+	request.Header.Set(HeaderDeliveryID, delivery.ID)
+	request.Header.Set(HeaderTimestamp, timestamp)
+	request.Header.Set(HeaderSignature, Signature(webhook.Secret, timestamp, delivery.Payload))
+
+	response, err := m.client.Do(request)
+	if err != nil {
+		return ierrors.Wrap(err, "request failed")
+	}
+	defer response.Body.Close()
+
+	// the body is drained, so that the connection can be reused.
+	_, _ = io.Copy(io.Discard, io.LimitReader(response.Body, 64*1024))
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return ierrors.Errorf("unexpected status code: %d", response.StatusCode)
+	}
+
+	return nil
+}
+
+// retryInterval returns the time to wait before the next attempt after the given number of failed attempts.
+func (m *Manager) retryInterval(attempts int) time.Duration {
+	interval := m.optsRetryInterval
+	for i := 1; i < attempts && interval < m.optsMaxRetryInterval; i++ {
+		interval *= 2
+	}
+
+	return min(interval, m.optsMaxRetryInterval)
+}
+
+// deleteDelivery removes the delivery from the store (it needs to be called with the mutex locked).
+func (m *Manager) deleteDelivery(deliveryID string) error {
+	if err := deleteEntry(m.store, storePrefixDeliveries, deliveryID); err != nil {
+		return ierrors.Wrapf(err, "failed to delete delivery %s", deliveryID)
+	}
+	delete(m.deliveries, deliveryID)
+
+	return nil
+}
+
+func storeKey(prefix byte, id string) []byte {
+	return append([]byte{prefix}, []byte(id)...)
+}
+
+func storeEntry(store kvstore.KVStore, prefix byte, id string, entry any) error {
+	entryBytes, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	if err := store.Set(storeKey(prefix, id), entryBytes); err != nil {
+		return err
+	}
+
+	return store.Flush()
+}
+
+func deleteEntry(store kvstore.KVStore, prefix byte, id string) error {
+	if err := store.Delete(storeKey(prefix, id)); err != nil {
+		return err
+	}
+
+	return store.Flush()
+}
+
+func loadEntries[T any](store kvstore.KVStore, prefix byte, entries map[string]*T, idFunc func(*T) string) error {
+	var innerErr error
+	if err := store.Iterate(kvstore.KeyPrefix{prefix}, func(key kvstore.Key, value kvstore.Value) bool {
+		entry := new(T)
+		if err := json.Unmarshal(value, entry); err != nil {
+			innerErr = ierrors.Wrapf(err, "failed to decode entry %s", key[1:])
+
+			return false
+		}
+		entries[idFunc(entry)] = entry
+
+		return true
+	}); err != nil {
+		return err
+	}
+
+	return innerErr
+}
+
+// WithMaxWebhooks sets the maximum number of webhooks (0 means unlimited).
+func WithMaxWebhooks(maxWebhooks int) options.Option[Manager] {
+	return func(m *Manager) {
+		m.optsMaxWebhooks = maxWebhooks
+	}
+}
+
+// WithMaxPendingDeliveries sets the maximum number of pending deliveries, new events are dropped if it is reached (0 means unlimited).
+func WithMaxPendingDeliveries(maxPendingDeliveries int) options.Option[Manager] {
+	return func(m *Manager) {
+		m.optsMaxPendingDeliveries = maxPendingDeliveries
+	}
+}
+
+// WithMaxAttempts sets the maximum number of attempts of a delivery before it is dropped (0 means unlimited).
+func WithMaxAttempts(maxAttempts int) options.Option[Manager] {
+	return func(m *Manager) {
+		m.optsMaxAttempts = maxAttempts
+	}
+}
+
+// WithRetryInterval sets the time to wait before the first retry of a delivery, it doubles with every failed attempt.
+func WithRetryInterval(retryInterval time.Duration) options.Option[Manager] {
+	return func(m *Manager) {
+		m.optsRetryInterval = retryInterval
+	}
+}
+
+// WithMaxRetryInterval sets the maximum time to wait between two attempts of a delivery.
+func WithMaxRetryInterval(maxRetryInterval time.Duration) options.Option[Manager] {
+	return func(m *Manager) {
+		m.optsMaxRetryInterval = maxRetryInterval
+	}
+}
+
+// WithRequestTimeout sets the timeout of a single delivery attempt.
+func WithRequestTimeout(requestTimeout time.Duration) options.Option[Manager] {
+	return func(m *Manager) {
+		m.optsRequestTimeout = requestTimeout
+	}
+}
+
+// WithWorkerCount sets the number of deliveries that are attempted in parallel.
+func WithWorkerCount(workerCount int) options.Option[Manager] {
+	return func(m *Manager) {
+		m.optsWorkerCount = workerCount
+	}
+}
+
+// WithDispatchCheckInterval sets the interval in which the manager checks for deliveries that are due to be retried.
+func WithDispatchCheckInterval(dispatchCheckInterval time.Duration) options.Option[Manager] {
+	return func(m *Manager) {
+		m.optsDispatchCheckInterval = dispatchCheckInterval
+	}
+}
@@ -0,0 +1,250 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/iotaledger/hive.go/log"
+)
+
+type receivedDelivery struct {
+	header  http.Header
+	payload *DeliveryPayload
+	body    []byte
+}
+
+func newTestServer(t *testing.T, failedAttempts int32) (*httptest.Server, chan *receivedDelivery) {
+	t.Helper()
+
+	received := make(chan *receivedDelivery, 10)
+	var attempts atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) <= failedAttempts {
+			w.WriteHeader(http.StatusInternalServerError)
+
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		payload := new(DeliveryPayload)
+		require.NoError(t, json.Unmarshal(body, payload))
+
+		received <- &receivedDelivery{header: r.Header, payload: payload, body: body}
+	}))
+	t.Cleanup(server.Close)
+
+	return server, received
+}
+
+func runManager(t *testing.T, manager *Manager) {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		manager.Run(ctx)
+	}()
+
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+}
+
+func requireNoPendingDeliveries(t *testing.T, manager *Manager, webhookID string) {
+	t.Helper()
+
+	require.Eventually(t, func() bool {
+		deliveries, err := manager.PendingDeliveries(webhookID)
+		require.NoError(t, err)
+
+		return len(deliveries) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestManager_AddWebhook(t *testing.T) {
+	manager, err := NewManager(log.NewLogger(), mapdb.NewMapDB(), WithMaxWebhooks(2))
+	require.NoError(t, err)
+
+	_, err = manager.AddWebhook(&Webhook{URL: "ftp://example.com", Addresses: []string{"rms1"}})
+	require.ErrorIs(t, err, ErrInvalidWebhook)
+
+	_, err = manager.AddWebhook(&Webhook{URL: "https://example.com"})
+	require.ErrorIs(t, err, ErrInvalidWebhook)
+
+	webhook1, err := manager.AddWebhook(&Webhook{URL: "https://example.com", Addresses: []string{"rms1"}})
+	require.NoError(t, err)
+	require.NotEmpty(t, webhook1.ID)
+	require.Len(t, webhook1.Secret, 64)
+
+	webhook2, err := manager.AddWebhook(&Webhook{URL: "http://example.com", TransactionIDs: []string{"0x01"}, Secret: "secret"})
+	require.NoError(t, err)
+	require.Equal(t, "secret", webhook2.Secret)
+
+	_, err = manager.AddWebhook(&Webhook{URL: "http://example.com", TransactionIDs: []string{"0x02"}})
+	require.ErrorIs(t, err, ErrTooManyWebhooks)
+
+	require.Equal(t, []*Webhook{webhook1, webhook2}, manager.Webhooks())
+
+	require.NoError(t, manager.RemoveWebhook(webhook1.ID))
+	require.ErrorIs(t, manager.RemoveWebhook(webhook1.ID), ErrWebhookNotFound)
+
+	_, err = manager.Webhook(webhook1.ID)
+	require.ErrorIs(t, err, ErrWebhookNotFound)
+	require.Equal(t, []*Webhook{webhook2}, manager.Webhooks())
+}
+
+func TestManager_Matches(t *testing.T) {
+	webhook := &Webhook{
+		TransactionIDs: []string{"0x01"},
+		Addresses:      []string{"rms1a", "rms1b"},
+		States:         []string{"accepted", "failed"},
+	}
+
+	require.True(t, webhook.Matches(&Event{TransactionID: "0x01", State: "accepted"}))
+	require.True(t, webhook.Matches(&Event{TransactionID: "0x02", Addresses: []string{"rms1c", "rms1b"}, State: "failed"}))
+	require.False(t, webhook.Matches(&Event{TransactionID: "0x01", State: "finalized"}))
+	require.False(t, webhook.Matches(&Event{TransactionID: "0x02", Addresses: []string{"rms1c"}, State: "accepted"}))
+
+	// a webhook without states is interested in all of them
+	webhook.States = nil
+	require.True(t, webhook.Matches(&Event{TransactionID: "0x01", State: "finalized"}))
+}
+
+func TestManager_Deliver(t *testing.T) {
+	server, received := newTestServer(t, 0)
+
+	manager, err := NewManager(log.NewLogger(), mapdb.NewMapDB())
+	require.NoError(t, err)
+
+	webhook, err := manager.AddWebhook(&Webhook{URL: server.URL, TransactionIDs: []string{"0x01"}})
+	require.NoError(t, err)
+
+	runManager(t, manager)
+
+	require.NoError(t, manager.Notify(&Event{TransactionID: "0x02", State: "accepted", Metadata: json.RawMessage(`{}`)}))
+	require.NoError(t, manager.Notify(&Event{TransactionID: "0x01", State: "accepted", Metadata: json.RawMessage(`{"transactionState":"accepted"}`)}))
+
+	delivery := <-received
+	require.Equal(t, webhook.ID, delivery.payload.WebhookID)
+	require.Equal(t, "0x01", delivery.payload.TransactionID)
+	require.Equal(t, "accepted", delivery.payload.State)
+	require.JSONEq(t, `{"transactionState":"accepted"}`, string(delivery.payload.Metadata))
+
+	require.Equal(t, webhook.ID, delivery.header.Get(HeaderWebhookID))
+	require.Equal(t, delivery.payload.DeliveryID, delivery.header.Get(HeaderDeliveryID))
+	require.Equal(t, Signature(webhook.Secret, delivery.header.Get(HeaderTimestamp), delivery.body), delivery.header.Get(HeaderSignature))
+	require.NotEqual(t, Signature("wrong secret", delivery.header.Get(HeaderTimestamp), delivery.body), delivery.header.Get(HeaderSignature))
+
+	requireNoPendingDeliveries(t, manager, webhook.ID)
+	require.Empty(t, received)
+}
+
+func TestManager_Retry(t *testing.T) {
+	server, received := newTestServer(t, 2)
+
+	manager, err := NewManager(log.NewLogger(), mapdb.NewMapDB(),
+		WithRetryInterval(10*time.Millisecond),
+		WithDispatchCheckInterval(5*time.Millisecond),
+	)
+	require.NoError(t, err)
+
+	webhook, err := manager.AddWebhook(&Webhook{URL: server.URL, Addresses: []string{"rms1a"}})
+	require.NoError(t, err)
+
+	runManager(t, manager)
+
+	require.NoError(t, manager.Notify(&Event{TransactionID: "0x01", Addresses: []string{"rms1a"}, State: "failed"}))
+
+	delivery := <-received
+	require.Equal(t, "failed", delivery.payload.State)
+
+	requireNoPendingDeliveries(t, manager, webhook.ID)
+}
+
+func TestManager_DropAfterMaxAttempts(t *testing.T) {
+	server, received := newTestServer(t, 100)
+
+	manager, err := NewManager(log.NewLogger(), mapdb.NewMapDB(),
+		WithMaxAttempts(3),
+		WithRetryInterval(time.Millisecond),
+		WithDispatchCheckInterval(time.Millisecond),
+	)
+	require.NoError(t, err)
+
+	webhook, err := manager.AddWebhook(&Webhook{URL: server.URL, TransactionIDs: []string{"0x01"}})
+	require.NoError(t, err)
+
+	runManager(t, manager)
+
+	require.NoError(t, manager.Notify(&Event{TransactionID: "0x01", State: "failed"}))
+
+	requireNoPendingDeliveries(t, manager, webhook.ID)
+	require.Empty(t, received)
+}
+
+func TestManager_PersistedDeliveries(t *testing.T) {
+	store := mapdb.NewMapDB()
+
+	manager, err := NewManager(log.NewLogger(), store, WithMaxPendingDeliveries(1))
+	require.NoError(t, err)
+
+	webhook, err := manager.AddWebhook(&Webhook{URL: "http://example.com", TransactionIDs: []string{"0x01"}})
+	require.NoError(t, err)
+
+	// the manager is not running, so the delivery stays pending
+	require.NoError(t, manager.Notify(&Event{TransactionID: "0x01", State: "accepted"}))
+	require.ErrorIs(t, manager.Notify(&Event{TransactionID: "0x01", State: "committed"}), ErrTooManyPendingDeliveries)
+
+	// a restarted manager loads the webhooks and the pending deliveries from the store
+	server, received := newTestServer(t, 0)
+
+	restartedManager, err := NewManager(log.NewLogger(), store)
+	require.NoError(t, err)
+	require.Equal(t, manager.Webhooks()[0].ID, restartedManager.Webhooks()[0].ID)
+
+	deliveries, err := restartedManager.PendingDeliveries(webhook.ID)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+
+	restartedManager.webhooks[webhook.ID].URL = server.URL
+	runManager(t, restartedManager)
+
+	delivery := <-received
+	require.Equal(t, deliveries[0].ID, delivery.payload.DeliveryID)
+
+	requireNoPendingDeliveries(t, restartedManager, webhook.ID)
+
+	require.NoError(t, restartedManager.RemoveWebhook(webhook.ID))
+
+	_, err = restartedManager.PendingDeliveries(webhook.ID)
+	require.ErrorIs(t, err, ErrWebhookNotFound)
+}
+
+func TestManager_RetryInterval(t *testing.T) {
+	manager, err := NewManager(log.NewLogger(), mapdb.NewMapDB(),
+		WithRetryInterval(time.Second),
+		WithMaxRetryInterval(5*time.Second),
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, time.Second, manager.retryInterval(1))
+	require.Equal(t, 2*time.Second, manager.retryInterval(2))
+	require.Equal(t, 4*time.Second, manager.retryInterval(3))
+	require.Equal(t, 5*time.Second, manager.retryInterval(4))
+	require.Equal(t, 5*time.Second, manager.retryInterval(20))
+}
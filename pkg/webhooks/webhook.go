@@ -0,0 +1,148 @@
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/iotaledger/hive.go/ierrors"
+)
+
+const (
+	// HeaderWebhookID contains the ID of the webhook a delivery belongs to.
+	HeaderWebhookID = "X-Webhook-ID"
+	// HeaderDeliveryID contains the ID of the delivery, which stays the same for all attempts of the delivery.
+	HeaderDeliveryID = "X-Webhook-Delivery"
+	// HeaderTimestamp contains the unix timestamp (in seconds) of the delivery attempt that is part of the signature.
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	// HeaderSignature contains the HMAC-SHA256 signature of the delivery attempt.
+	HeaderSignature = "X-Webhook-Signature"
+
+	// signaturePrefix is the prefix of the signature that names the used algorithm.
+	signaturePrefix = "sha256="
+)
+
+// ErrInvalidWebhook is returned if a webhook that should be added is invalid.
+var ErrInvalidWebhook = ierrors.New("invalid webhook")
+
+// Webhook is an HTTP endpoint that receives the state changes of the transactions it is interested in.
+type Webhook struct {
+	// ID is the unique identifier of the webhook.
+	ID string `json:"id"`
+	// URL is the HTTP endpoint the deliveries are posted to.
+	URL string `json:"url"`
+	// TransactionIDs are the hex encoded IDs of the transactions the webhook is interested in.
+	TransactionIDs []string `json:"transactionIds,omitempty"`
+	// Addresses are the bech32 encoded addresses the webhook is interested in.
+	// A transaction matches if it consumes or creates an output that is owned by one of the addresses.
+	Addresses []string `json:"addresses,omitempty"`
+	// States are the transaction states the webhook is interested in (all states if empty).
+	States []string `json:"states,omitempty"`
+	// Secret is the key that is used to sign the deliveries.
+	Secret string `json:"secret"`
+	// CreatedAt is the time the webhook was added.
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// Matches returns true if the webhook is interested in the given event.
+func (w *Webhook) Matches(event *Event) bool {
+	if len(w.States) > 0 && !slices.Contains(w.States, event.State) {
+		return false
+	}
+
+	if slices.Contains(w.TransactionIDs, event.TransactionID) {
+		return true
+	}
+
+	for _, address := range event.Addresses {
+		if slices.Contains(w.Addresses, address) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validate checks the fields of the webhook that can be set by the user.
+func (w *Webhook) validate() error {
+	parsedURL, err := url.Parse(w.URL)
+	if err != nil {
+		return ierrors.WithMessagef(ErrInvalidWebhook, "failed to parse URL: %s", err.Error())
+	}
+
+	if parsedURL.Scheme != "http" && parsedURL.Scheme != "https" {
+		return ierrors.WithMessagef(ErrInvalidWebhook, "URL scheme needs to be http or https, got: %s", parsedURL.Scheme)
+	}
+
+	if parsedURL.Host == "" {
+		return ierrors.WithMessage(ErrInvalidWebhook, "URL has no host")
+	}
+
+	if len(w.TransactionIDs) == 0 && len(w.Addresses) == 0 {
+		return ierrors.WithMessage(ErrInvalidWebhook, "at least one transaction ID or address is needed")
+	}
+
+	return nil
+}
+
+// Event is a state change of a transaction that is delivered to the webhooks that are interested in it.
+type Event struct {
+	// TransactionID is the hex encoded ID of the transaction.
+	TransactionID string
+	// Addresses are the bech32 encoded addresses that own the inputs and outputs of the transaction.
+	Addresses []string
+	// State is the new state of the transaction.
+	State string
+	// Metadata is the JSON encoded metadata of the transaction.
+	Metadata json.RawMessage
+}
+
+// DeliveryPayload is the body that is posted to the URL of a webhook.
+type DeliveryPayload struct {
+	// WebhookID is the ID of the webhook the delivery belongs to.
+	WebhookID string `json:"webhookId"`
+	// DeliveryID is the ID of the delivery.
+	DeliveryID string `json:"deliveryId"`
+	// TransactionID is the hex encoded ID of the transaction.
+	TransactionID string `json:"transactionId"`
+	// State is the new state of the transaction.
+	State string `json:"state"`
+	// Metadata is the metadata of the transaction, as it is returned by the transaction metadata endpoint of the core API.
+	Metadata json.RawMessage `json:"metadata"`
+	// CreatedAt is the time the state change was detected.
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// Delivery is a pending delivery of a payload to a webhook.
+type Delivery struct {
+	// ID is the unique identifier of the delivery.
+	ID string `json:"id"`
+	// WebhookID is the ID of the webhook the payload is delivered to.
+	WebhookID string `json:"webhookId"`
+	// Payload is the encoded DeliveryPayload (it is stored encoded, so that every attempt signs the same body).
+	Payload json.RawMessage `json:"payload"`
+	// Attempts is the number of failed attempts of the delivery.
+	Attempts int `json:"attempts"`
+	// NextAttemptAt is the earliest time of the next attempt.
+	NextAttemptAt time.Time `json:"nextAttemptAt"`
+	// LastError is the reason the last attempt failed.
+	LastError string `json:"lastError,omitempty"`
+	// CreatedAt is the time the delivery was created.
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// Signature returns the signature of a delivery attempt, which is the hex encoded HMAC-SHA256 of the timestamp and
+// the body joined by a dot, using the secret of the webhook as key.
+// Receivers should compare it to the HeaderSignature in constant time and reject old timestamps to prevent replays.
+func Signature(secret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
@@ -67,6 +67,7 @@ func main() {
 	replaceTopicNames["jwtAuth"] = "JWT Auth"
 	replaceTopicNames["api"] = "API"
 	replaceTopicNames["inx"] = "INX"
+	replaceTopicNames["grpcAPI"] = "gRPC API"
 
 	application := coreApp.App()
 